@@ -2,21 +2,41 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/influenzanet/user-management-service/internal/config"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
 	gc "github.com/influenzanet/user-management-service/pkg/grpc/clients"
 	"github.com/influenzanet/user-management-service/pkg/grpc/service"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/sms"
 	"github.com/influenzanet/user-management-service/pkg/timer_event"
 )
 
 const userManagementTimerEventFrequency = 90 * 60 // seconds
 
 func main() {
-	conf := config.InitConfig()
+	configFile := flag.String("config", os.Getenv(config.ENV_CONFIG_FILE), "path of the YAML configuration file, env variables override its values")
+	checkConfig := flag.Bool("check-config", false, "validate the configuration, list all problems and exit")
+	flag.Parse()
+
+	conf, err := config.LoadConfig(*configFile)
+	if *checkConfig {
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println("configuration ok")
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	clients := &models.APIClients{}
 
@@ -28,21 +48,48 @@ func main() {
 	defer close()
 	clients.LoggingService = loggingClient
 
+	smsSender, close, err := sms.NewSenderFromConfig(conf.SMSSender)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer close()
+	clients.SMSSender = smsSender
+
 	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
 	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
 
+	instances, err := globalDBService.GetAllInstances()
+	if err != nil {
+		log.Printf("unexpected error when fetching instances: %v", err)
+	}
+	for _, instance := range instances {
+		if err := userDBService.CreateIndexes(instance.InstanceID); err != nil {
+			log.Printf("unexpected error when creating indexes for %s: %v", instance.InstanceID, err)
+		}
+	}
+
+	ctx := context.Background()
+
+	instanceConfigs := instanceconfig.NewProvider(globalDBService, instanceconfig.Config{
+		Intervals:                   conf.Intervals,
+		NewUserCountLimit:           conf.NewUserCountLimit,
+		CleanUpUnverifiedUsersAfter: conf.CleanUpUnverifiedUsersAfter,
+	})
+	if err := instanceConfigs.Reload(); err != nil {
+		log.Printf("unexpected error when reading instance settings: %v", err)
+	}
+	instanceConfigs.Run(ctx, conf.InstanceConfigReload)
+
 	// Start timer thread
 	userTimerService := timer_event.NewUserManagmentTimerService(
 		userManagementTimerEventFrequency,
 		globalDBService,
 		userDBService,
 		clients,
-		conf.CleanUpUnverifiedUsersAfter,
+		instanceConfigs,
 	)
 
 	// Start server thread
-	ctx := context.Background()
-
 	userTimerService.Run(ctx)
 
 	if err := service.RunServer(
@@ -51,8 +98,9 @@ func main() {
 		clients,
 		userDBService,
 		globalDBService,
-		conf.Intervals,
-		conf.NewUserCountLimit,
+		instanceConfigs,
+		conf.DoubleOptInInstances,
+		conf.RequireAppToken,
 	); err != nil {
 		log.Fatal(err)
 	}
@@ -2,12 +2,15 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/sms"
 )
 
 // Config is the structure that holds all global configuration data
@@ -17,131 +20,274 @@ type Config struct {
 		MessagingService string
 		LoggingService   string
 	}
+	SMSSender                   string
 	UserDBConfig                models.DBConfig
 	GlobalDBConfig              models.DBConfig
 	Intervals                   models.Intervals
 	NewUserCountLimit           int64
 	CleanUpUnverifiedUsersAfter int64
+	InstanceConfigReload        time.Duration // how often the settings of the instances are read again
+	DoubleOptInInstances        []string      // instances where newsletter subscriptions must be confirmed
+	RequireAppToken             bool          // public endpoints check the app token and its instances
 }
 
-func InitConfig() Config {
-	conf := Config{}
-	conf.Port = os.Getenv("USER_MANAGEMENT_LISTEN_PORT")
-	conf.ServiceURLs.MessagingService = os.Getenv("ADDR_MESSAGING_SERVICE")
-	conf.ServiceURLs.LoggingService = os.Getenv("ADDR_LOGGING_SERVICE")
+// ValidationError lists all problems found in the configuration
+type ValidationError struct {
+	Problems []string
+}
 
-	conf.UserDBConfig = getUserDBConfig()
-	conf.GlobalDBConfig = getGlobalDBConfig()
-	conf.Intervals = getIntervalsConfig()
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
 
-	rl, err := strconv.Atoi(os.Getenv("NEW_USER_RATE_LIMIT"))
+// InitConfig reads the configuration file given by CONFIG_FILE, if any, and the env variables. Exits listing all
+// problems if the configuration is not valid.
+func InitConfig() Config {
+	conf, err := LoadConfig(os.Getenv(ENV_CONFIG_FILE))
 	if err != nil {
-		log.Fatal("NEW_USER_RATE_LIMIT: " + err.Error())
+		log.Fatal(err)
+	}
+	return conf
+}
+
+// LoadConfig reads the configuration file (none if path is empty), lets the env variables that are set and not empty
+// override its values and validates the result. The error is a *ValidationError with all problems found.
+func LoadConfig(path string) (Config, error) {
+	l := &loader{
+		getEnv:   os.Getenv,
+		readFile: ioutil.ReadFile,
 	}
-	conf.NewUserCountLimit = int64(rl)
+	return l.load(path)
+}
 
-	cleanUpThreshold, err := strconv.Atoi(os.Getenv("CLEAN_UP_UNVERIFIED_USERS_AFTER"))
+// InitDBConfig is InitConfig for the tools that only connect to the databases: the port and the addresses of the
+// other services are not required.
+func InitDBConfig() Config {
+	conf, err := LoadDBConfig(os.Getenv(ENV_CONFIG_FILE))
 	if err != nil {
-		log.Fatal("CLEAN_UP_UNVERIFIED_USERS_AFTER: " + err.Error())
+		log.Fatal(err)
 	}
-	conf.CleanUpUnverifiedUsersAfter = int64(cleanUpThreshold)
 	return conf
 }
 
-func getIntervalsConfig() models.Intervals {
-	intervals := models.Intervals{
-		TokenExpiryInterval:      time.Minute * time.Duration(defaultTokenExpirationMin),
-		VerificationCodeLifetime: defaultVerificationCodeLifetime,
+// LoadDBConfig is LoadConfig without the keys only the service needs
+func LoadDBConfig(path string) (Config, error) {
+	l := &loader{
+		getEnv:   os.Getenv,
+		readFile: ioutil.ReadFile,
+		dbOnly:   true,
 	}
+	return l.load(path)
+}
 
-	accessTokenExpiration, err := strconv.Atoi(os.Getenv(ENV_TOKEN_EXPIRATION_MIN))
-	if err != nil {
-		log.Println("using default token expiration")
-	} else {
-		intervals.TokenExpiryInterval = time.Minute * time.Duration(accessTokenExpiration)
+// loader collects the problems of the configuration instead of stopping at the first one
+type loader struct {
+	getEnv   func(key string) string
+	readFile func(path string) ([]byte, error)
+	dbOnly   bool // the port and the service addresses are optional
+	problems []string
+}
+
+func (l *loader) problem(format string, args ...interface{}) {
+	l.problems = append(l.problems, fmt.Sprintf(format, args...))
+}
+
+func (l *loader) load(path string) (Config, error) {
+	fc := defaultFileConfig()
+	if path != "" {
+		content, err := l.readFile(path)
+		if err != nil {
+			l.problem("config file: %v", err)
+		} else {
+			for _, p := range decodeFileConfig(content, &fc) {
+				l.problem("config file: %s", p)
+			}
+		}
 	}
+	l.applyEnv(&fc)
+	conf := l.validate(fc)
 
-	newVerificationCodeLifetime, err := strconv.Atoi(os.Getenv(ENV_VERIFICATION_CODE_LIFETIME))
-	if err != nil {
-		log.Println("using default verification code lifetime")
-	} else {
-		intervals.VerificationCodeLifetime = int64(newVerificationCodeLifetime)
+	if len(l.problems) > 0 {
+		return conf, &ValidationError{Problems: l.problems}
 	}
+	return conf, nil
+}
+
+func (l *loader) applyEnv(fc *fileConfig) {
+	l.envString(&fc.Port, ENV_LISTEN_PORT)
+	l.envString(&fc.ServiceURLs.MessagingService, ENV_ADDR_MESSAGING_SERVICE)
+	l.envString(&fc.ServiceURLs.LoggingService, ENV_ADDR_LOGGING_SERVICE)
+	l.envString(&fc.SMSSender, ENV_SMS_SENDER)
+
+	l.envDBConnection(&fc.UserDB, ENV_USER_DB_CONNECTION_STR, ENV_USER_DB_CONNECTION_PREFIX, ENV_USER_DB_USERNAME, ENV_USER_DB_PASSWORD)
+	l.envDBConnection(&fc.GlobalDB, ENV_GLOBAL_DB_CONNECTION_STR, ENV_GLOBAL_DB_CONNECTION_PREFIX, ENV_GLOBAL_DB_USERNAME, ENV_GLOBAL_DB_PASSWORD)
+	l.envInt(&fc.DBClient.Timeout, ENV_DB_TIMEOUT)
+	l.envInt(&fc.DBClient.IdleConnTimeout, ENV_DB_IDLE_CONN_TIMEOUT)
+	l.envInt(&fc.DBClient.MaxPoolSize, ENV_DB_MAX_POOL_SIZE)
+	l.envString(&fc.DBClient.DBNamePrefix, ENV_DB_NAME_PREFIX)
+	l.envBool(&fc.DBClient.NoCursorTimeout, ENV_USE_NO_CURSOR_TIMEOUT)
+
+	l.envInt(&fc.Intervals.TokenExpirationMin, ENV_TOKEN_EXPIRATION_MIN)
+	l.envInt(&fc.Intervals.VerificationCodeLifetime, ENV_VERIFICATION_CODE_LIFETIME)
+	l.envInt(&fc.Intervals.InvitationLifetime, ENV_INVITATION_LIFETIME)
+	l.envInt(&fc.NewUserRateLimit, ENV_NEW_USER_RATE_LIMIT)
+	l.envInt(&fc.CleanUpUnverifiedUsersAfter, ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER)
+	l.envInt(&fc.InstanceConfigReloadInterval, ENV_INSTANCE_CONFIG_RELOAD_INTERVAL)
 
-	return intervals
+	if v := l.getEnv(ENV_NEWSLETTER_DOUBLE_OPT_IN_INSTANCES); v != "" {
+		fc.NewsletterDoubleOptInInstances = splitList(v)
+	}
+	l.envBool(&fc.RequireAppToken, ENV_REQUIRE_APP_TOKEN)
+}
+
+// envDBConnection applies the env variables of a database connection, credentials are read from the file given with
+// the _FILE variant of their variable if it is set
+func (l *loader) envDBConnection(c *dbConnection, connStrKey, prefixKey, usernameKey, passwordKey string) {
+	l.envString(&c.ConnectionStr, connStrKey)
+	l.envString(&c.ConnectionPrefix, prefixKey)
+	l.envSecret(&c.Username, &c.UsernameFile, usernameKey)
+	l.envSecret(&c.Password, &c.PasswordFile, passwordKey)
 }
 
-func getUserDBConfig() models.DBConfig {
-	connStr := os.Getenv("USER_DB_CONNECTION_STR")
-	username := os.Getenv("USER_DB_USERNAME")
-	password := os.Getenv("USER_DB_PASSWORD")
-	prefix := os.Getenv("USER_DB_CONNECTION_PREFIX") // Used in test mode
-	if connStr == "" || username == "" || password == "" {
-		log.Fatal("Couldn't read DB credentials.")
+func (l *loader) envString(dst *string, key string) {
+	if v := l.getEnv(key); v != "" {
+		*dst = v
 	}
-	URI := fmt.Sprintf(`mongodb%s://%s:%s@%s`, prefix, username, password, connStr)
+}
 
-	var err error
-	Timeout, err := strconv.Atoi(os.Getenv("DB_TIMEOUT"))
-	if err != nil {
-		log.Fatal("DB_TIMEOUT: " + err.Error())
+func (l *loader) envInt(dst *int, key string) {
+	v := l.getEnv(key)
+	if v == "" {
+		return
 	}
-	IdleConnTimeout, err := strconv.Atoi(os.Getenv("DB_IDLE_CONN_TIMEOUT"))
+	i, err := strconv.Atoi(v)
 	if err != nil {
-		log.Fatal("DB_IDLE_CONN_TIMEOUT" + err.Error())
+		l.problem("%s: not a number: %s", key, v)
+		return
+	}
+	*dst = i
+}
+
+func (l *loader) envBool(dst *bool, key string) {
+	v := l.getEnv(key)
+	if v == "" {
+		return
 	}
-	mps, err := strconv.Atoi(os.Getenv("DB_MAX_POOL_SIZE"))
-	MaxPoolSize := uint64(mps)
+	b, err := strconv.ParseBool(v)
 	if err != nil {
-		log.Fatal("DB_MAX_POOL_SIZE: " + err.Error())
+		l.problem("%s: not a boolean: %s", key, v)
+		return
 	}
+	*dst = b
+}
 
-	noCursorTimeout := os.Getenv(ENV_USE_NO_CURSOR_TIMEOUT) == "true"
-
-	DBNamePrefix := os.Getenv("DB_DB_NAME_PREFIX")
+// envSecret applies the env variable of a secret or, with lower priority, the one giving the path of its file. Either
+// way, it replaces both the value and the file of the configuration file.
+func (l *loader) envSecret(value *string, file *string, key string) {
+	if v := l.getEnv(key); v != "" {
+		*value = v
+		*file = ""
+		return
+	}
+	if path := l.getEnv(key + envFileSuffix); path != "" {
+		*value = ""
+		*file = path
+	}
+}
 
-	return models.DBConfig{
-		URI:             URI,
-		Timeout:         Timeout,
-		IdleConnTimeout: IdleConnTimeout,
-		NoCursorTimeout: noCursorTimeout,
-		MaxPoolSize:     MaxPoolSize,
-		DBNamePrefix:    DBNamePrefix,
+// requiredSecret returns the value or the content of the file, problems are reported with the env variable's name
+func (l *loader) requiredSecret(value string, file string, key string) string {
+	if file == "" {
+		return l.required(value, key)
 	}
+	if value != "" {
+		l.problem("%s: set either the value or the file", key)
+		return value
+	}
+	content, err := l.readFile(file)
+	if err != nil {
+		l.problem("%s: %v", key+envFileSuffix, err)
+		return ""
+	}
+	return l.required(strings.TrimSpace(string(content)), key)
 }
 
-func getGlobalDBConfig() models.DBConfig {
-	connStr := os.Getenv("GLOBAL_DB_CONNECTION_STR")
-	username := os.Getenv("GLOBAL_DB_USERNAME")
-	password := os.Getenv("GLOBAL_DB_PASSWORD")
-	prefix := os.Getenv("GLOBAL_DB_CONNECTION_PREFIX") // Used in test mode
-	if connStr == "" || username == "" || password == "" {
-		log.Fatal("Couldn't read DB credentials.")
+func (l *loader) validate(fc fileConfig) Config {
+	conf := Config{}
+	conf.Port = fc.Port
+	conf.ServiceURLs.MessagingService = fc.ServiceURLs.MessagingService
+	conf.ServiceURLs.LoggingService = fc.ServiceURLs.LoggingService
+	if !l.dbOnly {
+		l.required(fc.Port, ENV_LISTEN_PORT)
+		l.required(fc.ServiceURLs.MessagingService, ENV_ADDR_MESSAGING_SERVICE)
+		l.required(fc.ServiceURLs.LoggingService, ENV_ADDR_LOGGING_SERVICE)
+	}
+	conf.SMSSender = fc.SMSSender
+	if err := sms.CheckConfig(fc.SMSSender); err != nil {
+		l.problem("%s: %v", ENV_SMS_SENDER, err)
 	}
-	URI := fmt.Sprintf(`mongodb%s://%s:%s@%s`, prefix, username, password, connStr)
 
-	var err error
-	Timeout, err := strconv.Atoi(os.Getenv("DB_TIMEOUT"))
-	if err != nil {
-		log.Fatal("DB_TIMEOUT: " + err.Error())
+	dbClient := models.DBConfig{
+		Timeout:         l.positive(fc.DBClient.Timeout, ENV_DB_TIMEOUT),
+		IdleConnTimeout: fc.DBClient.IdleConnTimeout,
+		MaxPoolSize:     uint64(l.positive(fc.DBClient.MaxPoolSize, ENV_DB_MAX_POOL_SIZE)),
+		DBNamePrefix:    fc.DBClient.DBNamePrefix,
 	}
-	IdleConnTimeout, err := strconv.Atoi(os.Getenv("DB_IDLE_CONN_TIMEOUT"))
-	if err != nil {
-		log.Fatal("DB_IDLE_CONN_TIMEOUT" + err.Error())
+	if fc.DBClient.IdleConnTimeout < 0 {
+		l.problem("%s: must not be negative", ENV_DB_IDLE_CONN_TIMEOUT)
 	}
-	mps, err := strconv.Atoi(os.Getenv("DB_MAX_POOL_SIZE"))
-	MaxPoolSize := uint64(mps)
-	if err != nil {
-		log.Fatal("DB_MAX_POOL_SIZE: " + err.Error())
+	conf.UserDBConfig = dbClient
+	conf.UserDBConfig.URI = l.dbURI(fc.UserDB, ENV_USER_DB_CONNECTION_STR, ENV_USER_DB_USERNAME, ENV_USER_DB_PASSWORD)
+	conf.UserDBConfig.NoCursorTimeout = fc.DBClient.NoCursorTimeout
+	conf.GlobalDBConfig = dbClient
+	conf.GlobalDBConfig.URI = l.dbURI(fc.GlobalDB, ENV_GLOBAL_DB_CONNECTION_STR, ENV_GLOBAL_DB_USERNAME, ENV_GLOBAL_DB_PASSWORD)
+
+	conf.Intervals = models.Intervals{
+		TokenExpiryInterval:      time.Minute * time.Duration(l.positive(fc.Intervals.TokenExpirationMin, ENV_TOKEN_EXPIRATION_MIN)),
+		VerificationCodeLifetime: int64(l.positive(fc.Intervals.VerificationCodeLifetime, ENV_VERIFICATION_CODE_LIFETIME)),
+		InvitationLifetime:       time.Second * time.Duration(l.positive(fc.Intervals.InvitationLifetime, ENV_INVITATION_LIFETIME)),
 	}
+	conf.NewUserCountLimit = int64(l.positive(fc.NewUserRateLimit, ENV_NEW_USER_RATE_LIMIT))
+	conf.CleanUpUnverifiedUsersAfter = int64(l.positive(fc.CleanUpUnverifiedUsersAfter, ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER))
+	conf.InstanceConfigReload = time.Second * time.Duration(l.positive(fc.InstanceConfigReloadInterval, ENV_INSTANCE_CONFIG_RELOAD_INTERVAL))
 
-	DBNamePrefix := os.Getenv("DB_DB_NAME_PREFIX")
+	conf.DoubleOptInInstances = fc.NewsletterDoubleOptInInstances
+	if conf.DoubleOptInInstances == nil {
+		conf.DoubleOptInInstances = []string{}
+	}
+	conf.RequireAppToken = fc.RequireAppToken
+	return conf
+}
+
+func (l *loader) dbURI(c dbConnection, connStrKey, usernameKey, passwordKey string) string {
+	connStr := l.required(c.ConnectionStr, connStrKey)
+	username := l.requiredSecret(c.Username, c.UsernameFile, usernameKey)
+	password := l.requiredSecret(c.Password, c.PasswordFile, passwordKey)
+	return fmt.Sprintf(`mongodb%s://%s:%s@%s`, c.ConnectionPrefix, username, password, connStr)
+}
+
+func (l *loader) required(value string, key string) string {
+	if value == "" {
+		l.problem("%s: missing", key)
+	}
+	return value
+}
+
+func (l *loader) positive(value int, key string) int {
+	if value <= 0 {
+		l.problem("%s: must be a positive number", key)
+	}
+	return value
+}
 
-	return models.DBConfig{
-		URI:             URI,
-		Timeout:         Timeout,
-		IdleConnTimeout: IdleConnTimeout,
-		MaxPoolSize:     MaxPoolSize,
-		DBNamePrefix:    DBNamePrefix,
+// splitList reads a comma separated list
+func splitList(v string) []string {
+	list := []string{}
+	for _, item := range strings.Split(v, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
 	}
+	return list
 }
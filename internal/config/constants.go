@@ -1,13 +1,50 @@
 package config
 
 const (
+	ENV_CONFIG_FILE = "CONFIG_FILE"
+
+	ENV_LISTEN_PORT            = "USER_MANAGEMENT_LISTEN_PORT"
+	ENV_ADDR_MESSAGING_SERVICE = "ADDR_MESSAGING_SERVICE"
+	ENV_ADDR_LOGGING_SERVICE   = "ADDR_LOGGING_SERVICE"
+
+	ENV_USER_DB_CONNECTION_STR    = "USER_DB_CONNECTION_STR"
+	ENV_USER_DB_CONNECTION_PREFIX = "USER_DB_CONNECTION_PREFIX"
+	ENV_USER_DB_USERNAME          = "USER_DB_USERNAME"
+	ENV_USER_DB_PASSWORD          = "USER_DB_PASSWORD"
+
+	ENV_GLOBAL_DB_CONNECTION_STR    = "GLOBAL_DB_CONNECTION_STR"
+	ENV_GLOBAL_DB_CONNECTION_PREFIX = "GLOBAL_DB_CONNECTION_PREFIX"
+	ENV_GLOBAL_DB_USERNAME          = "GLOBAL_DB_USERNAME"
+	ENV_GLOBAL_DB_PASSWORD          = "GLOBAL_DB_PASSWORD"
+
+	ENV_DB_TIMEOUT           = "DB_TIMEOUT"
+	ENV_DB_IDLE_CONN_TIMEOUT = "DB_IDLE_CONN_TIMEOUT"
+	ENV_DB_MAX_POOL_SIZE     = "DB_MAX_POOL_SIZE"
+	ENV_DB_NAME_PREFIX       = "DB_DB_NAME_PREFIX"
+
 	ENV_VERIFICATION_CODE_LIFETIME = "VERIFICATION_CODE_LIFETIME"
 	ENV_TOKEN_EXPIRATION_MIN       = "TOKEN_EXPIRATION_MIN"
+	ENV_INVITATION_LIFETIME        = "INVITATION_LIFETIME"
+
+	ENV_NEW_USER_RATE_LIMIT             = "NEW_USER_RATE_LIMIT"
+	ENV_CLEAN_UP_UNVERIFIED_USERS_AFTER = "CLEAN_UP_UNVERIFIED_USERS_AFTER"
+	ENV_INSTANCE_CONFIG_RELOAD_INTERVAL = "INSTANCE_CONFIG_RELOAD_INTERVAL"
 
 	ENV_USE_NO_CURSOR_TIMEOUT = "USE_NO_CURSOR_TIMEOUT"
+
+	ENV_SMS_SENDER = "SMS_SENDER"
+
+	ENV_NEWSLETTER_DOUBLE_OPT_IN_INSTANCES = "NEWSLETTER_DOUBLE_OPT_IN_INSTANCES"
+
+	ENV_REQUIRE_APP_TOKEN = "REQUIRE_APP_TOKEN"
+
+	// suffix of the env variables holding the path of a file with the secret, e.g. USER_DB_PASSWORD_FILE
+	envFileSuffix = "_FILE"
 )
 
 const (
 	defaultVerificationCodeLifetime = 15 * 60 // for 2FA 6 digit code
 	defaultTokenExpirationMin       = 55
+	defaultInvitationLifetime       = 7 * 24 * 3600 // for invitations of users created by an admin, seconds
+	defaultInstanceConfigReload     = 60            // seconds
 )
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig is the layout of the configuration file (YAML). Each value can be overridden with the env variable named
+// in its comment.
+type fileConfig struct {
+	Port        string `yaml:"port"` // USER_MANAGEMENT_LISTEN_PORT
+	ServiceURLs struct {
+		MessagingService string `yaml:"messagingService"` // ADDR_MESSAGING_SERVICE
+		LoggingService   string `yaml:"loggingService"`   // ADDR_LOGGING_SERVICE
+	} `yaml:"serviceURLs"`
+	SMSSender string `yaml:"smsSender"` // SMS_SENDER
+
+	UserDB   dbConnection `yaml:"userDB"`   // USER_DB_...
+	GlobalDB dbConnection `yaml:"globalDB"` // GLOBAL_DB_...
+	DBClient struct {
+		Timeout         int    `yaml:"timeout"`         // DB_TIMEOUT, seconds
+		IdleConnTimeout int    `yaml:"idleConnTimeout"` // DB_IDLE_CONN_TIMEOUT, seconds
+		MaxPoolSize     int    `yaml:"maxPoolSize"`     // DB_MAX_POOL_SIZE
+		DBNamePrefix    string `yaml:"dbNamePrefix"`    // DB_DB_NAME_PREFIX
+		NoCursorTimeout bool   `yaml:"noCursorTimeout"` // USE_NO_CURSOR_TIMEOUT, user DB only
+	} `yaml:"dbClient"`
+
+	Intervals struct {
+		TokenExpirationMin       int `yaml:"tokenExpirationMin"`       // TOKEN_EXPIRATION_MIN
+		VerificationCodeLifetime int `yaml:"verificationCodeLifetime"` // VERIFICATION_CODE_LIFETIME, seconds
+		InvitationLifetime       int `yaml:"invitationLifetime"`       // INVITATION_LIFETIME, seconds
+	} `yaml:"intervals"`
+	NewUserRateLimit             int `yaml:"newUserRateLimit"`             // NEW_USER_RATE_LIMIT
+	CleanUpUnverifiedUsersAfter  int `yaml:"cleanUpUnverifiedUsersAfter"`  // CLEAN_UP_UNVERIFIED_USERS_AFTER, seconds
+	InstanceConfigReloadInterval int `yaml:"instanceConfigReloadInterval"` // INSTANCE_CONFIG_RELOAD_INTERVAL, seconds
+
+	NewsletterDoubleOptInInstances []string `yaml:"newsletterDoubleOptInInstances"` // NEWSLETTER_DOUBLE_OPT_IN_INSTANCES, comma separated
+	RequireAppToken                bool     `yaml:"requireAppToken"`                // REQUIRE_APP_TOKEN
+}
+
+// dbConnection holds the connection of a database, credentials can be given directly or as path of a file to read them
+// from (e.g. Docker or Kubernetes secrets)
+type dbConnection struct {
+	ConnectionStr    string `yaml:"connectionStr"`
+	ConnectionPrefix string `yaml:"connectionPrefix"` // used in test mode, "+srv" for atlas
+	Username         string `yaml:"username"`
+	UsernameFile     string `yaml:"usernameFile"`
+	Password         string `yaml:"password"`
+	PasswordFile     string `yaml:"passwordFile"`
+}
+
+func defaultFileConfig() fileConfig {
+	fc := fileConfig{}
+	fc.Intervals.TokenExpirationMin = defaultTokenExpirationMin
+	fc.Intervals.VerificationCodeLifetime = defaultVerificationCodeLifetime
+	fc.Intervals.InvitationLifetime = defaultInvitationLifetime
+	fc.InstanceConfigReloadInterval = defaultInstanceConfigReload
+	return fc
+}
+
+// decodeFileConfig reads the configuration file over the defaults. Unknown keys and values of the wrong type are
+// reported all together.
+func decodeFileConfig(content []byte, fc *fileConfig) []string {
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	dec.KnownFields(true)
+	err := dec.Decode(fc)
+	if err == nil || err == io.EOF {
+		return nil
+	}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		return typeErr.Errors
+	}
+	return []string{err.Error()}
+}
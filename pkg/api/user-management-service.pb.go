@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.22.0-devel
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.11.4
 // source: user_management/user-management-service.proto
 
@@ -8,14 +8,13 @@ package api
 
 import (
 	context "context"
-	proto "github.com/golang/protobuf/proto"
-	empty "github.com/golang/protobuf/ptypes/empty"
 	api_types "github.com/influenzanet/go-utils/pkg/api_types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -27,10 +26,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type ServiceStatus_StatusValue int32
 
 const (
@@ -77,7 +72,6 @@ func (ServiceStatus_StatusValue) EnumDescriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
-//
 // Status is typically used as a return value indicating if the method was
 // performed normally, or the system has any internal error e.g. checking system
 // status of a service
@@ -321,6 +315,7 @@ type LoginWithExternalIDPMsg struct {
 	Customer   string `protobuf:"bytes,4,opt,name=customer,proto3" json:"customer,omitempty"`
 	Idp        string `protobuf:"bytes,5,opt,name=idp,proto3" json:"idp,omitempty"`
 	GroupInfo  string `protobuf:"bytes,6,opt,name=group_info,json=groupInfo,proto3" json:"group_info,omitempty"`
+	Subject    string `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"` // user ID at the IdP, to log in with a linked identity
 }
 
 func (x *LoginWithExternalIDPMsg) Reset() {
@@ -397,6 +392,84 @@ func (x *LoginWithExternalIDPMsg) GetGroupInfo() string {
 	return ""
 }
 
+func (x *LoginWithExternalIDPMsg) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type LinkedIdentityMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password string                `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Idp      string                `protobuf:"bytes,3,opt,name=idp,proto3" json:"idp,omitempty"`
+	Subject  string                `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *LinkedIdentityMsg) Reset() {
+	*x = LinkedIdentityMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkedIdentityMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedIdentityMsg) ProtoMessage() {}
+
+func (x *LinkedIdentityMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedIdentityMsg.ProtoReflect.Descriptor instead.
+func (*LinkedIdentityMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LinkedIdentityMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *LinkedIdentityMsg) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LinkedIdentityMsg) GetIdp() string {
+	if x != nil {
+		return x.Idp
+	}
+	return ""
+}
+
+func (x *LinkedIdentityMsg) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
 type AutoValidateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -409,7 +482,7 @@ type AutoValidateReq struct {
 func (x *AutoValidateReq) Reset() {
 	*x = AutoValidateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[4]
+		mi := &file_user_management_user_management_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +495,7 @@ func (x *AutoValidateReq) String() string {
 func (*AutoValidateReq) ProtoMessage() {}
 
 func (x *AutoValidateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[4]
+	mi := &file_user_management_user_management_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +508,7 @@ func (x *AutoValidateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoValidateReq.ProtoReflect.Descriptor instead.
 func (*AutoValidateReq) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{4}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AutoValidateReq) GetTempToken() string {
@@ -466,7 +539,7 @@ type AutoValidateResponse struct {
 func (x *AutoValidateResponse) Reset() {
 	*x = AutoValidateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[5]
+		mi := &file_user_management_user_management_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +552,7 @@ func (x *AutoValidateResponse) String() string {
 func (*AutoValidateResponse) ProtoMessage() {}
 
 func (x *AutoValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[5]
+	mi := &file_user_management_user_management_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +565,7 @@ func (x *AutoValidateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoValidateResponse.ProtoReflect.Descriptor instead.
 func (*AutoValidateResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{5}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AutoValidateResponse) GetAccountId() string {
@@ -536,7 +609,7 @@ type SendVerificationCodeReq struct {
 func (x *SendVerificationCodeReq) Reset() {
 	*x = SendVerificationCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[6]
+		mi := &file_user_management_user_management_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -549,7 +622,7 @@ func (x *SendVerificationCodeReq) String() string {
 func (*SendVerificationCodeReq) ProtoMessage() {}
 
 func (x *SendVerificationCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[6]
+	mi := &file_user_management_user_management_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,7 +635,7 @@ func (x *SendVerificationCodeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendVerificationCodeReq.ProtoReflect.Descriptor instead.
 func (*SendVerificationCodeReq) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{6}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SendVerificationCodeReq) GetEmail() string {
@@ -591,15 +664,16 @@ type LoginResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token              *TokenResponse `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	User               *User          `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	SecondFactorNeeded bool           `protobuf:"varint,3,opt,name=second_factor_needed,json=secondFactorNeeded,proto3" json:"second_factor_needed,omitempty"`
+	Token                   *TokenResponse `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	User                    *User          `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	SecondFactorNeeded      bool           `protobuf:"varint,3,opt,name=second_factor_needed,json=secondFactorNeeded,proto3" json:"second_factor_needed,omitempty"`
+	UndeliverableContactIds []string       `protobuf:"bytes,4,rep,name=undeliverable_contact_ids,json=undeliverableContactIds,proto3" json:"undeliverable_contact_ids,omitempty"` // contact infos the user should fix
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[7]
+		mi := &file_user_management_user_management_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -612,7 +686,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[7]
+	mi := &file_user_management_user_management_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +699,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{7}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LoginResponse) GetToken() *TokenResponse {
@@ -649,6 +723,13 @@ func (x *LoginResponse) GetSecondFactorNeeded() bool {
 	return false
 }
 
+func (x *LoginResponse) GetUndeliverableContactIds() []string {
+	if x != nil {
+		return x.UndeliverableContactIds
+	}
+	return nil
+}
+
 type UserReference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -662,7 +743,7 @@ type UserReference struct {
 func (x *UserReference) Reset() {
 	*x = UserReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[8]
+		mi := &file_user_management_user_management_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -675,7 +756,7 @@ func (x *UserReference) String() string {
 func (*UserReference) ProtoMessage() {}
 
 func (x *UserReference) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[8]
+	mi := &file_user_management_user_management_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +769,7 @@ func (x *UserReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserReference.ProtoReflect.Descriptor instead.
 func (*UserReference) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{8}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserReference) GetToken() *api_types.TokenInfos {
@@ -723,7 +804,7 @@ type RevokeRefreshTokensReq struct {
 func (x *RevokeRefreshTokensReq) Reset() {
 	*x = RevokeRefreshTokensReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[9]
+		mi := &file_user_management_user_management_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -736,7 +817,7 @@ func (x *RevokeRefreshTokensReq) String() string {
 func (*RevokeRefreshTokensReq) ProtoMessage() {}
 
 func (x *RevokeRefreshTokensReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[9]
+	mi := &file_user_management_user_management_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,7 +830,7 @@ func (x *RevokeRefreshTokensReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeRefreshTokensReq.ProtoReflect.Descriptor instead.
 func (*RevokeRefreshTokensReq) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{9}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RevokeRefreshTokensReq) GetToken() *api_types.TokenInfos {
@@ -772,7 +853,7 @@ type RefreshTokenRequest struct {
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[10]
+		mi := &file_user_management_user_management_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -785,7 +866,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[10]
+	mi := &file_user_management_user_management_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +879,7 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{10}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RefreshTokenRequest) GetUserId() string {
@@ -833,7 +914,7 @@ type AppTokenRequest struct {
 func (x *AppTokenRequest) Reset() {
 	*x = AppTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[11]
+		mi := &file_user_management_user_management_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +927,7 @@ func (x *AppTokenRequest) String() string {
 func (*AppTokenRequest) ProtoMessage() {}
 
 func (x *AppTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[11]
+	mi := &file_user_management_user_management_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +940,7 @@ func (x *AppTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppTokenRequest.ProtoReflect.Descriptor instead.
 func (*AppTokenRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{11}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AppTokenRequest) GetToken() string {
@@ -875,12 +956,13 @@ type AppTokenValidation struct {
 	unknownFields protoimpl.UnknownFields
 
 	Instances []string `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	AppName   string   `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
 }
 
 func (x *AppTokenValidation) Reset() {
 	*x = AppTokenValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[12]
+		mi := &file_user_management_user_management_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -893,7 +975,7 @@ func (x *AppTokenValidation) String() string {
 func (*AppTokenValidation) ProtoMessage() {}
 
 func (x *AppTokenValidation) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[12]
+	mi := &file_user_management_user_management_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +988,7 @@ func (x *AppTokenValidation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppTokenValidation.ProtoReflect.Descriptor instead.
 func (*AppTokenValidation) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{12}
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AppTokenValidation) GetInstances() []string {
@@ -916,78 +998,30 @@ func (x *AppTokenValidation) GetInstances() []string {
 	return nil
 }
 
-type ProfileRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Token   *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Profile *Profile              `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-}
-
-func (x *ProfileRequest) Reset() {
-	*x = ProfileRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ProfileRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ProfileRequest) ProtoMessage() {}
-
-func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
-func (*ProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ProfileRequest) GetToken() *api_types.TokenInfos {
-	if x != nil {
-		return x.Token
-	}
-	return nil
-}
-
-func (x *ProfileRequest) GetProfile() *Profile {
+func (x *AppTokenValidation) GetAppName() string {
 	if x != nil {
-		return x.Profile
+		return x.AppName
 	}
-	return nil
+	return ""
 }
 
-type UserAuthInfo struct {
+type AppToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            string     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Roles             []string   `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
-	InstanceId        string     `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	AccountId         string     `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // e.g. email address
-	Profiles          []*Profile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	SelectedProfile   *Profile   `protobuf:"bytes,6,opt,name=selected_profile,json=selectedProfile,proto3" json:"selected_profile,omitempty"`
-	AccountConfirmed  bool       `protobuf:"varint,7,opt,name=account_confirmed,json=accountConfirmed,proto3" json:"account_confirmed,omitempty"`
-	PreferredLanguage string     `protobuf:"bytes,8,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppName    string   `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Instances  []string `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
+	CreatedAt  int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  int64    `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt int64    `protobuf:"varint,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RotatedAt  int64    `protobuf:"varint,7,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	RevokedAt  int64    `protobuf:"varint,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
 }
 
-func (x *UserAuthInfo) Reset() {
-	*x = UserAuthInfo{}
+func (x *AppToken) Reset() {
+	*x = AppToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,13 +1029,13 @@ func (x *UserAuthInfo) Reset() {
 	}
 }
 
-func (x *UserAuthInfo) String() string {
+func (x *AppToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAuthInfo) ProtoMessage() {}
+func (*AppToken) ProtoMessage() {}
 
-func (x *UserAuthInfo) ProtoReflect() protoreflect.Message {
+func (x *AppToken) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,79 +1047,79 @@ func (x *UserAuthInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAuthInfo.ProtoReflect.Descriptor instead.
-func (*UserAuthInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppToken.ProtoReflect.Descriptor instead.
+func (*AppToken) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserAuthInfo) GetUserId() string {
+func (x *AppToken) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UserAuthInfo) GetRoles() []string {
+func (x *AppToken) GetAppName() string {
 	if x != nil {
-		return x.Roles
+		return x.AppName
 	}
-	return nil
+	return ""
 }
 
-func (x *UserAuthInfo) GetInstanceId() string {
+func (x *AppToken) GetInstances() []string {
 	if x != nil {
-		return x.InstanceId
+		return x.Instances
 	}
-	return ""
+	return nil
 }
 
-func (x *UserAuthInfo) GetAccountId() string {
+func (x *AppToken) GetCreatedAt() int64 {
 	if x != nil {
-		return x.AccountId
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *UserAuthInfo) GetProfiles() []*Profile {
+func (x *AppToken) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Profiles
+		return x.ExpiresAt
 	}
-	return nil
+	return 0
 }
 
-func (x *UserAuthInfo) GetSelectedProfile() *Profile {
+func (x *AppToken) GetLastUsedAt() int64 {
 	if x != nil {
-		return x.SelectedProfile
+		return x.LastUsedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *UserAuthInfo) GetAccountConfirmed() bool {
+func (x *AppToken) GetRotatedAt() int64 {
 	if x != nil {
-		return x.AccountConfirmed
+		return x.RotatedAt
 	}
-	return false
+	return 0
 }
 
-func (x *UserAuthInfo) GetPreferredLanguage() string {
+func (x *AppToken) GetRevokedAt() int64 {
 	if x != nil {
-		return x.PreferredLanguage
+		return x.RevokedAt
 	}
-	return ""
+	return 0
 }
 
-type ResendContactVerificationReq struct {
+type CreateAppTokenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token   *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Type    string                `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Address string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AppName   string                `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	ExpiresAt int64                 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // optional
 }
 
-func (x *ResendContactVerificationReq) Reset() {
-	*x = ResendContactVerificationReq{}
+func (x *CreateAppTokenReq) Reset() {
+	*x = CreateAppTokenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1093,13 +1127,13 @@ func (x *ResendContactVerificationReq) Reset() {
 	}
 }
 
-func (x *ResendContactVerificationReq) String() string {
+func (x *CreateAppTokenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResendContactVerificationReq) ProtoMessage() {}
+func (*CreateAppTokenReq) ProtoMessage() {}
 
-func (x *ResendContactVerificationReq) ProtoReflect() protoreflect.Message {
+func (x *CreateAppTokenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1111,44 +1145,43 @@ func (x *ResendContactVerificationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResendContactVerificationReq.ProtoReflect.Descriptor instead.
-func (*ResendContactVerificationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAppTokenReq.ProtoReflect.Descriptor instead.
+func (*CreateAppTokenReq) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResendContactVerificationReq) GetToken() *api_types.TokenInfos {
+func (x *CreateAppTokenReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *ResendContactVerificationReq) GetType() string {
+func (x *CreateAppTokenReq) GetAppName() string {
 	if x != nil {
-		return x.Type
+		return x.AppName
 	}
 	return ""
 }
 
-func (x *ResendContactVerificationReq) GetAddress() string {
+func (x *CreateAppTokenReq) GetExpiresAt() int64 {
 	if x != nil {
-		return x.Address
+		return x.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-type PasswordChangeMsg struct {
+type AppTokenSecret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token       *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	OldPassword string                `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword string                `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	AppToken *AppToken `protobuf:"bytes,1,opt,name=app_token,json=appToken,proto3" json:"app_token,omitempty"`
+	Secret   string    `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"` // only returned here, the service only keeps its hash
 }
 
-func (x *PasswordChangeMsg) Reset() {
-	*x = PasswordChangeMsg{}
+func (x *AppTokenSecret) Reset() {
+	*x = AppTokenSecret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,13 +1189,13 @@ func (x *PasswordChangeMsg) Reset() {
 	}
 }
 
-func (x *PasswordChangeMsg) String() string {
+func (x *AppTokenSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordChangeMsg) ProtoMessage() {}
+func (*AppTokenSecret) ProtoMessage() {}
 
-func (x *PasswordChangeMsg) ProtoReflect() protoreflect.Message {
+func (x *AppTokenSecret) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1174,43 +1207,36 @@ func (x *PasswordChangeMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordChangeMsg.ProtoReflect.Descriptor instead.
-func (*PasswordChangeMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTokenSecret.ProtoReflect.Descriptor instead.
+func (*AppTokenSecret) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PasswordChangeMsg) GetToken() *api_types.TokenInfos {
+func (x *AppTokenSecret) GetAppToken() *AppToken {
 	if x != nil {
-		return x.Token
+		return x.AppToken
 	}
 	return nil
 }
 
-func (x *PasswordChangeMsg) GetOldPassword() string {
+func (x *AppTokenSecret) GetSecret() string {
 	if x != nil {
-		return x.OldPassword
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *PasswordChangeMsg) GetNewPassword() string {
-	if x != nil {
-		return x.NewPassword
-	}
-	return ""
-}
-
-type InitiateResetPasswordMsg struct {
+type GetAppTokensReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	AccountId  string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Token          *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	IncludeRevoked bool                  `protobuf:"varint,2,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
 }
 
-func (x *InitiateResetPasswordMsg) Reset() {
-	*x = InitiateResetPasswordMsg{}
+func (x *GetAppTokensReq) Reset() {
+	*x = GetAppTokensReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1218,13 +1244,13 @@ func (x *InitiateResetPasswordMsg) Reset() {
 	}
 }
 
-func (x *InitiateResetPasswordMsg) String() string {
+func (x *GetAppTokensReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiateResetPasswordMsg) ProtoMessage() {}
+func (*GetAppTokensReq) ProtoMessage() {}
 
-func (x *InitiateResetPasswordMsg) ProtoReflect() protoreflect.Message {
+func (x *GetAppTokensReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1236,35 +1262,35 @@ func (x *InitiateResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiateResetPasswordMsg.ProtoReflect.Descriptor instead.
-func (*InitiateResetPasswordMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAppTokensReq.ProtoReflect.Descriptor instead.
+func (*GetAppTokensReq) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *InitiateResetPasswordMsg) GetInstanceId() string {
+func (x *GetAppTokensReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.InstanceId
+		return x.Token
 	}
-	return ""
+	return nil
 }
 
-func (x *InitiateResetPasswordMsg) GetAccountId() string {
+func (x *GetAppTokensReq) GetIncludeRevoked() bool {
 	if x != nil {
-		return x.AccountId
+		return x.IncludeRevoked
 	}
-	return ""
+	return false
 }
 
-type GetInfosForResetPasswordMsg struct {
+type AppTokenList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AppTokens []*AppToken `protobuf:"bytes,1,rep,name=app_tokens,json=appTokens,proto3" json:"app_tokens,omitempty"`
 }
 
-func (x *GetInfosForResetPasswordMsg) Reset() {
-	*x = GetInfosForResetPasswordMsg{}
+func (x *AppTokenList) Reset() {
+	*x = AppTokenList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1272,13 +1298,13 @@ func (x *GetInfosForResetPasswordMsg) Reset() {
 	}
 }
 
-func (x *GetInfosForResetPasswordMsg) String() string {
+func (x *AppTokenList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfosForResetPasswordMsg) ProtoMessage() {}
+func (*AppTokenList) ProtoMessage() {}
 
-func (x *GetInfosForResetPasswordMsg) ProtoReflect() protoreflect.Message {
+func (x *AppTokenList) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1290,28 +1316,30 @@ func (x *GetInfosForResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfosForResetPasswordMsg.ProtoReflect.Descriptor instead.
-func (*GetInfosForResetPasswordMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTokenList.ProtoReflect.Descriptor instead.
+func (*AppTokenList) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetInfosForResetPasswordMsg) GetToken() string {
+func (x *AppTokenList) GetAppTokens() []*AppToken {
 	if x != nil {
-		return x.Token
+		return x.AppTokens
 	}
-	return ""
+	return nil
 }
 
-type UserInfoForPWReset struct {
+type AppTokenMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id        string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresAt int64                 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // rotation: new expiration time, optional
 }
 
-func (x *UserInfoForPWReset) Reset() {
-	*x = UserInfoForPWReset{}
+func (x *AppTokenMsg) Reset() {
+	*x = AppTokenMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1319,13 +1347,13 @@ func (x *UserInfoForPWReset) Reset() {
 	}
 }
 
-func (x *UserInfoForPWReset) String() string {
+func (x *AppTokenMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoForPWReset) ProtoMessage() {}
+func (*AppTokenMsg) ProtoMessage() {}
 
-func (x *UserInfoForPWReset) ProtoReflect() protoreflect.Message {
+func (x *AppTokenMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1337,29 +1365,43 @@ func (x *UserInfoForPWReset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoForPWReset.ProtoReflect.Descriptor instead.
-func (*UserInfoForPWReset) Descriptor() ([]byte, []int) {
+// Deprecated: Use AppTokenMsg.ProtoReflect.Descriptor instead.
+func (*AppTokenMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UserInfoForPWReset) GetAccountId() string {
+func (x *AppTokenMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.AccountId
+		return x.Token
+	}
+	return nil
+}
+
+func (x *AppTokenMsg) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type ResetPasswordMsg struct {
+func (x *AppTokenMsg) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Token   *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Profile *Profile              `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
 }
 
-func (x *ResetPasswordMsg) Reset() {
-	*x = ResetPasswordMsg{}
+func (x *ProfileRequest) Reset() {
+	*x = ProfileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,13 +1409,13 @@ func (x *ResetPasswordMsg) Reset() {
 	}
 }
 
-func (x *ResetPasswordMsg) String() string {
+func (x *ProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordMsg) ProtoMessage() {}
+func (*ProfileRequest) ProtoMessage() {}
 
-func (x *ResetPasswordMsg) ProtoReflect() protoreflect.Message {
+func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,38 +1427,42 @@ func (x *ResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordMsg.ProtoReflect.Descriptor instead.
-func (*ResetPasswordMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
+func (*ProfileRequest) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ResetPasswordMsg) GetToken() string {
+func (x *ProfileRequest) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
-	return ""
+	return nil
 }
 
-func (x *ResetPasswordMsg) GetNewPassword() string {
+func (x *ProfileRequest) GetProfile() *Profile {
 	if x != nil {
-		return x.NewPassword
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-type EmailChangeMsg struct {
+type UserAuthInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token        *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	NewEmail     string                `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
-	KeepOldEmail bool                  `protobuf:"varint,3,opt,name=keep_old_email,json=keepOldEmail,proto3" json:"keep_old_email,omitempty"`
-	Password     string                `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	UserId            string     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles             []string   `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	InstanceId        string     `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId         string     `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // e.g. email address
+	Profiles          []*Profile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	SelectedProfile   *Profile   `protobuf:"bytes,6,opt,name=selected_profile,json=selectedProfile,proto3" json:"selected_profile,omitempty"`
+	AccountConfirmed  bool       `protobuf:"varint,7,opt,name=account_confirmed,json=accountConfirmed,proto3" json:"account_confirmed,omitempty"`
+	PreferredLanguage string     `protobuf:"bytes,8,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
 }
 
-func (x *EmailChangeMsg) Reset() {
-	*x = EmailChangeMsg{}
+func (x *UserAuthInfo) Reset() {
+	*x = UserAuthInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1424,13 +1470,13 @@ func (x *EmailChangeMsg) Reset() {
 	}
 }
 
-func (x *EmailChangeMsg) String() string {
+func (x *UserAuthInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailChangeMsg) ProtoMessage() {}
+func (*UserAuthInfo) ProtoMessage() {}
 
-func (x *EmailChangeMsg) ProtoReflect() protoreflect.Message {
+func (x *UserAuthInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1442,50 +1488,79 @@ func (x *EmailChangeMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailChangeMsg.ProtoReflect.Descriptor instead.
-func (*EmailChangeMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserAuthInfo.ProtoReflect.Descriptor instead.
+func (*UserAuthInfo) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EmailChangeMsg) GetToken() *api_types.TokenInfos {
+func (x *UserAuthInfo) GetUserId() string {
 	if x != nil {
-		return x.Token
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetRoles() []string {
+	if x != nil {
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *EmailChangeMsg) GetNewEmail() string {
+func (x *UserAuthInfo) GetInstanceId() string {
 	if x != nil {
-		return x.NewEmail
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *EmailChangeMsg) GetKeepOldEmail() bool {
+func (x *UserAuthInfo) GetAccountId() string {
 	if x != nil {
-		return x.KeepOldEmail
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *UserAuthInfo) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *UserAuthInfo) GetSelectedProfile() *Profile {
+	if x != nil {
+		return x.SelectedProfile
+	}
+	return nil
+}
+
+func (x *UserAuthInfo) GetAccountConfirmed() bool {
+	if x != nil {
+		return x.AccountConfirmed
 	}
 	return false
 }
 
-func (x *EmailChangeMsg) GetPassword() string {
+func (x *UserAuthInfo) GetPreferredLanguage() string {
 	if x != nil {
-		return x.Password
+		return x.PreferredLanguage
 	}
 	return ""
 }
 
-type LanguageChangeMsg struct {
+type ResendContactVerificationReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token        *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	LanguageCode string                `protobuf:"bytes,2,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
+	Token   *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Type    string                `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Address string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *LanguageChangeMsg) Reset() {
-	*x = LanguageChangeMsg{}
+func (x *ResendContactVerificationReq) Reset() {
+	*x = ResendContactVerificationReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1493,13 +1568,13 @@ func (x *LanguageChangeMsg) Reset() {
 	}
 }
 
-func (x *LanguageChangeMsg) String() string {
+func (x *ResendContactVerificationReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LanguageChangeMsg) ProtoMessage() {}
+func (*ResendContactVerificationReq) ProtoMessage() {}
 
-func (x *LanguageChangeMsg) ProtoReflect() protoreflect.Message {
+func (x *ResendContactVerificationReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1511,36 +1586,44 @@ func (x *LanguageChangeMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LanguageChangeMsg.ProtoReflect.Descriptor instead.
-func (*LanguageChangeMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResendContactVerificationReq.ProtoReflect.Descriptor instead.
+func (*ResendContactVerificationReq) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *LanguageChangeMsg) GetToken() *api_types.TokenInfos {
+func (x *ResendContactVerificationReq) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *LanguageChangeMsg) GetLanguageCode() string {
+func (x *ResendContactVerificationReq) GetType() string {
 	if x != nil {
-		return x.LanguageCode
+		return x.Type
 	}
 	return ""
 }
 
-type ContactPreferencesMsg struct {
+func (x *ResendContactVerificationReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type PasswordChangeMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token              *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ContactPreferences *ContactPreferences   `protobuf:"bytes,2,opt,name=contact_preferences,json=contactPreferences,proto3" json:"contact_preferences,omitempty"`
+	Token       *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	OldPassword string                `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword string                `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 }
 
-func (x *ContactPreferencesMsg) Reset() {
-	*x = ContactPreferencesMsg{}
+func (x *PasswordChangeMsg) Reset() {
+	*x = PasswordChangeMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1548,13 +1631,13 @@ func (x *ContactPreferencesMsg) Reset() {
 	}
 }
 
-func (x *ContactPreferencesMsg) String() string {
+func (x *PasswordChangeMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactPreferencesMsg) ProtoMessage() {}
+func (*PasswordChangeMsg) ProtoMessage() {}
 
-func (x *ContactPreferencesMsg) ProtoReflect() protoreflect.Message {
+func (x *PasswordChangeMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1566,36 +1649,43 @@ func (x *ContactPreferencesMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactPreferencesMsg.ProtoReflect.Descriptor instead.
-func (*ContactPreferencesMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use PasswordChangeMsg.ProtoReflect.Descriptor instead.
+func (*PasswordChangeMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ContactPreferencesMsg) GetToken() *api_types.TokenInfos {
+func (x *PasswordChangeMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *ContactPreferencesMsg) GetContactPreferences() *ContactPreferences {
+func (x *PasswordChangeMsg) GetOldPassword() string {
 	if x != nil {
-		return x.ContactPreferences
+		return x.OldPassword
 	}
-	return nil
+	return ""
 }
 
-type ContactInfoMsg struct {
+func (x *PasswordChangeMsg) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type InitiateResetPasswordMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token       *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ContactInfo *ContactInfo          `protobuf:"bytes,2,opt,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	AccountId  string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
-func (x *ContactInfoMsg) Reset() {
-	*x = ContactInfoMsg{}
+func (x *InitiateResetPasswordMsg) Reset() {
+	*x = InitiateResetPasswordMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,13 +1693,13 @@ func (x *ContactInfoMsg) Reset() {
 	}
 }
 
-func (x *ContactInfoMsg) String() string {
+func (x *InitiateResetPasswordMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactInfoMsg) ProtoMessage() {}
+func (*InitiateResetPasswordMsg) ProtoMessage() {}
 
-func (x *ContactInfoMsg) ProtoReflect() protoreflect.Message {
+func (x *InitiateResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,26 +1711,26 @@ func (x *ContactInfoMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactInfoMsg.ProtoReflect.Descriptor instead.
-func (*ContactInfoMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use InitiateResetPasswordMsg.ProtoReflect.Descriptor instead.
+func (*InitiateResetPasswordMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ContactInfoMsg) GetToken() *api_types.TokenInfos {
+func (x *InitiateResetPasswordMsg) GetInstanceId() string {
 	if x != nil {
-		return x.Token
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ContactInfoMsg) GetContactInfo() *ContactInfo {
+func (x *InitiateResetPasswordMsg) GetAccountId() string {
 	if x != nil {
-		return x.ContactInfo
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-type JWTRequest struct {
+type GetInfosForResetPasswordMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1648,8 +1738,8 @@ type JWTRequest struct {
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *JWTRequest) Reset() {
-	*x = JWTRequest{}
+func (x *GetInfosForResetPasswordMsg) Reset() {
+	*x = GetInfosForResetPasswordMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1657,13 +1747,13 @@ func (x *JWTRequest) Reset() {
 	}
 }
 
-func (x *JWTRequest) String() string {
+func (x *GetInfosForResetPasswordMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JWTRequest) ProtoMessage() {}
+func (*GetInfosForResetPasswordMsg) ProtoMessage() {}
 
-func (x *JWTRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInfosForResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1675,29 +1765,28 @@ func (x *JWTRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JWTRequest.ProtoReflect.Descriptor instead.
-func (*JWTRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInfosForResetPasswordMsg.ProtoReflect.Descriptor instead.
+func (*GetInfosForResetPasswordMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *JWTRequest) GetToken() string {
+func (x *GetInfosForResetPasswordMsg) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type RefreshJWTRequest struct {
+type UserInfoForPWReset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	AccessToken  string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
-func (x *RefreshJWTRequest) Reset() {
-	*x = RefreshJWTRequest{}
+func (x *UserInfoForPWReset) Reset() {
+	*x = UserInfoForPWReset{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1705,13 +1794,13 @@ func (x *RefreshJWTRequest) Reset() {
 	}
 }
 
-func (x *RefreshJWTRequest) String() string {
+func (x *UserInfoForPWReset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshJWTRequest) ProtoMessage() {}
+func (*UserInfoForPWReset) ProtoMessage() {}
 
-func (x *RefreshJWTRequest) ProtoReflect() protoreflect.Message {
+func (x *UserInfoForPWReset) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1723,44 +1812,29 @@ func (x *RefreshJWTRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshJWTRequest.ProtoReflect.Descriptor instead.
-func (*RefreshJWTRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserInfoForPWReset.ProtoReflect.Descriptor instead.
+func (*UserInfoForPWReset) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RefreshJWTRequest) GetRefreshToken() string {
-	if x != nil {
-		return x.RefreshToken
-	}
-	return ""
-}
-
-func (x *RefreshJWTRequest) GetAccessToken() string {
+func (x *UserInfoForPWReset) GetAccountId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.AccountId
 	}
 	return ""
 }
 
-type CreateUserReq struct {
+type ResetPasswordMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token             *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	AccountId         string                `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	InitialPassword   string                `protobuf:"bytes,3,opt,name=initial_password,json=initialPassword,proto3" json:"initial_password,omitempty"`
-	PreferredLanguage string                `protobuf:"bytes,4,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
-	Roles             []string              `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
-	Use_2Fa           bool                  `protobuf:"varint,6,opt,name=use_2fa,json=use2fa,proto3" json:"use_2fa,omitempty"`
-	ProfileNames      []string              `protobuf:"bytes,7,rep,name=profile_names,json=profileNames,proto3" json:"profile_names,omitempty"` // Optional list of profiles that should be added for the user right at creation. First entry will be the main profiles name.
-	// When migrating previous account, that should not be confirmed
-	AccountConfirmedAt int64 `protobuf:"varint,8,opt,name=account_confirmed_at,json=accountConfirmedAt,proto3" json:"account_confirmed_at,omitempty"`
-	CreatedAt          int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
 }
 
-func (x *CreateUserReq) Reset() {
-	*x = CreateUserReq{}
+func (x *ResetPasswordMsg) Reset() {
+	*x = ResetPasswordMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1768,13 +1842,13 @@ func (x *CreateUserReq) Reset() {
 	}
 }
 
-func (x *CreateUserReq) String() string {
+func (x *ResetPasswordMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserReq) ProtoMessage() {}
+func (*ResetPasswordMsg) ProtoMessage() {}
 
-func (x *CreateUserReq) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,86 +1860,38 @@ func (x *CreateUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserReq.ProtoReflect.Descriptor instead.
-func (*CreateUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordMsg.ProtoReflect.Descriptor instead.
+func (*ResetPasswordMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateUserReq) GetToken() *api_types.TokenInfos {
+func (x *ResetPasswordMsg) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
-	return nil
-}
-
-func (x *CreateUserReq) GetAccountId() string {
-	if x != nil {
-		return x.AccountId
-	}
-	return ""
-}
-
-func (x *CreateUserReq) GetInitialPassword() string {
-	if x != nil {
-		return x.InitialPassword
-	}
 	return ""
 }
 
-func (x *CreateUserReq) GetPreferredLanguage() string {
+func (x *ResetPasswordMsg) GetNewPassword() string {
 	if x != nil {
-		return x.PreferredLanguage
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *CreateUserReq) GetRoles() []string {
-	if x != nil {
-		return x.Roles
-	}
-	return nil
-}
-
-func (x *CreateUserReq) GetUse_2Fa() bool {
-	if x != nil {
-		return x.Use_2Fa
-	}
-	return false
-}
-
-func (x *CreateUserReq) GetProfileNames() []string {
-	if x != nil {
-		return x.ProfileNames
-	}
-	return nil
-}
-
-func (x *CreateUserReq) GetAccountConfirmedAt() int64 {
-	if x != nil {
-		return x.AccountConfirmedAt
-	}
-	return 0
-}
-
-func (x *CreateUserReq) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-type RoleMsg struct {
+type EmailChangeMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	AccountId string                `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Role      string                `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Token        *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewEmail     string                `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	KeepOldEmail bool                  `protobuf:"varint,3,opt,name=keep_old_email,json=keepOldEmail,proto3" json:"keep_old_email,omitempty"`
+	Password     string                `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *RoleMsg) Reset() {
-	*x = RoleMsg{}
+func (x *EmailChangeMsg) Reset() {
+	*x = EmailChangeMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,13 +1899,13 @@ func (x *RoleMsg) Reset() {
 	}
 }
 
-func (x *RoleMsg) String() string {
+func (x *EmailChangeMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleMsg) ProtoMessage() {}
+func (*EmailChangeMsg) ProtoMessage() {}
 
-func (x *RoleMsg) ProtoReflect() protoreflect.Message {
+func (x *EmailChangeMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,43 +1917,52 @@ func (x *RoleMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleMsg.ProtoReflect.Descriptor instead.
-func (*RoleMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmailChangeMsg.ProtoReflect.Descriptor instead.
+func (*EmailChangeMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RoleMsg) GetToken() *api_types.TokenInfos {
+func (x *EmailChangeMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-func (x *RoleMsg) GetAccountId() string {
+func (x *EmailChangeMsg) GetNewEmail() string {
 	if x != nil {
-		return x.AccountId
+		return x.NewEmail
 	}
 	return ""
 }
 
-func (x *RoleMsg) GetRole() string {
+func (x *EmailChangeMsg) GetKeepOldEmail() bool {
 	if x != nil {
-		return x.Role
+		return x.KeepOldEmail
+	}
+	return false
+}
+
+func (x *EmailChangeMsg) GetPassword() string {
+	if x != nil {
+		return x.Password
 	}
 	return ""
 }
 
-type StreamUsersMsg struct {
+type PromoteContactInfoMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InstanceId string                  `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	Filters    *StreamUsersMsg_Filters `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	Token         *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ContactInfoId string                `protobuf:"bytes,2,opt,name=contact_info_id,json=contactInfoId,proto3" json:"contact_info_id,omitempty"`
+	Password      string                `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	KeepOldEmail  bool                  `protobuf:"varint,4,opt,name=keep_old_email,json=keepOldEmail,proto3" json:"keep_old_email,omitempty"`
 }
 
-func (x *StreamUsersMsg) Reset() {
-	*x = StreamUsersMsg{}
+func (x *PromoteContactInfoMsg) Reset() {
+	*x = PromoteContactInfoMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1935,13 +1970,13 @@ func (x *StreamUsersMsg) Reset() {
 	}
 }
 
-func (x *StreamUsersMsg) String() string {
+func (x *PromoteContactInfoMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamUsersMsg) ProtoMessage() {}
+func (*PromoteContactInfoMsg) ProtoMessage() {}
 
-func (x *StreamUsersMsg) ProtoReflect() protoreflect.Message {
+func (x *PromoteContactInfoMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,35 +1988,50 @@ func (x *StreamUsersMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamUsersMsg.ProtoReflect.Descriptor instead.
-func (*StreamUsersMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromoteContactInfoMsg.ProtoReflect.Descriptor instead.
+func (*PromoteContactInfoMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *StreamUsersMsg) GetInstanceId() string {
+func (x *PromoteContactInfoMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.InstanceId
+		return x.Token
+	}
+	return nil
+}
+
+func (x *PromoteContactInfoMsg) GetContactInfoId() string {
+	if x != nil {
+		return x.ContactInfoId
 	}
 	return ""
 }
 
-func (x *StreamUsersMsg) GetFilters() *StreamUsersMsg_Filters {
+func (x *PromoteContactInfoMsg) GetPassword() string {
 	if x != nil {
-		return x.Filters
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type FindNonParticipantUsersMsg struct {
+func (x *PromoteContactInfoMsg) GetKeepOldEmail() bool {
+	if x != nil {
+		return x.KeepOldEmail
+	}
+	return false
+}
+
+type LanguageChangeMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token        *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	LanguageCode string                `protobuf:"bytes,2,opt,name=language_code,json=languageCode,proto3" json:"language_code,omitempty"`
 }
 
-func (x *FindNonParticipantUsersMsg) Reset() {
-	*x = FindNonParticipantUsersMsg{}
+func (x *LanguageChangeMsg) Reset() {
+	*x = LanguageChangeMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1989,13 +2039,13 @@ func (x *FindNonParticipantUsersMsg) Reset() {
 	}
 }
 
-func (x *FindNonParticipantUsersMsg) String() string {
+func (x *LanguageChangeMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindNonParticipantUsersMsg) ProtoMessage() {}
+func (*LanguageChangeMsg) ProtoMessage() {}
 
-func (x *FindNonParticipantUsersMsg) ProtoReflect() protoreflect.Message {
+func (x *LanguageChangeMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2007,28 +2057,36 @@ func (x *FindNonParticipantUsersMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindNonParticipantUsersMsg.ProtoReflect.Descriptor instead.
-func (*FindNonParticipantUsersMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use LanguageChangeMsg.ProtoReflect.Descriptor instead.
+func (*LanguageChangeMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *FindNonParticipantUsersMsg) GetToken() *api_types.TokenInfos {
+func (x *LanguageChangeMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
 	return nil
 }
 
-type UserListMsg struct {
+func (x *LanguageChangeMsg) GetLanguageCode() string {
+	if x != nil {
+		return x.LanguageCode
+	}
+	return ""
+}
+
+type ContactPreferencesMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Token              *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ContactPreferences *ContactPreferences   `protobuf:"bytes,2,opt,name=contact_preferences,json=contactPreferences,proto3" json:"contact_preferences,omitempty"`
 }
 
-func (x *UserListMsg) Reset() {
-	*x = UserListMsg{}
+func (x *ContactPreferencesMsg) Reset() {
+	*x = ContactPreferencesMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2036,13 +2094,13 @@ func (x *UserListMsg) Reset() {
 	}
 }
 
-func (x *UserListMsg) String() string {
+func (x *ContactPreferencesMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserListMsg) ProtoMessage() {}
+func (*ContactPreferencesMsg) ProtoMessage() {}
 
-func (x *UserListMsg) ProtoReflect() protoreflect.Message {
+func (x *ContactPreferencesMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2054,28 +2112,36 @@ func (x *UserListMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserListMsg.ProtoReflect.Descriptor instead.
-func (*UserListMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContactPreferencesMsg.ProtoReflect.Descriptor instead.
+func (*ContactPreferencesMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserListMsg) GetUsers() []*User {
+func (x *ContactPreferencesMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.Users
+		return x.Token
 	}
 	return nil
 }
 
-type TempToken struct {
+func (x *ContactPreferencesMsg) GetContactPreferences() *ContactPreferences {
+	if x != nil {
+		return x.ContactPreferences
+	}
+	return nil
+}
+
+type ContactInfoMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token       *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ContactInfo *ContactInfo          `protobuf:"bytes,2,opt,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
 }
 
-func (x *TempToken) Reset() {
-	*x = TempToken{}
+func (x *ContactInfoMsg) Reset() {
+	*x = ContactInfoMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2083,13 +2149,13 @@ func (x *TempToken) Reset() {
 	}
 }
 
-func (x *TempToken) String() string {
+func (x *ContactInfoMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TempToken) ProtoMessage() {}
+func (*ContactInfoMsg) ProtoMessage() {}
 
-func (x *TempToken) ProtoReflect() protoreflect.Message {
+func (x *ContactInfoMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2101,33 +2167,37 @@ func (x *TempToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TempToken.ProtoReflect.Descriptor instead.
-func (*TempToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContactInfoMsg.ProtoReflect.Descriptor instead.
+func (*ContactInfoMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *TempToken) GetToken() string {
+func (x *ContactInfoMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
 		return x.Token
 	}
-	return ""
+	return nil
 }
 
-type TokenResponse struct {
+func (x *ContactInfoMsg) GetContactInfo() *ContactInfo {
+	if x != nil {
+		return x.ContactInfo
+	}
+	return nil
+}
+
+type PhoneVerificationMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken       string     `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken      string     `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	ExpiresIn         int32      `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	Profiles          []*Profile `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	SelectedProfileId string     `protobuf:"bytes,5,opt,name=selected_profile_id,json=selectedProfileId,proto3" json:"selected_profile_id,omitempty"`
-	PreferredLanguage string     `protobuf:"bytes,6,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+	Token            *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ContactInfoId    string                `protobuf:"bytes,2,opt,name=contact_info_id,json=contactInfoId,proto3" json:"contact_info_id,omitempty"`
+	VerificationCode string                `protobuf:"bytes,3,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"`
 }
 
-func (x *TokenResponse) Reset() {
-	*x = TokenResponse{}
+func (x *PhoneVerificationMsg) Reset() {
+	*x = PhoneVerificationMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_user_management_user_management_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2135,13 +2205,13 @@ func (x *TokenResponse) Reset() {
 	}
 }
 
-func (x *TokenResponse) String() string {
+func (x *PhoneVerificationMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenResponse) ProtoMessage() {}
+func (*PhoneVerificationMsg) ProtoMessage() {}
 
-func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+func (x *PhoneVerificationMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_user_management_user_management_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2153,80 +2223,251 @@ func (x *TokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
-func (*TokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PhoneVerificationMsg.ProtoReflect.Descriptor instead.
+func (*PhoneVerificationMsg) Descriptor() ([]byte, []int) {
 	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TokenResponse) GetAccessToken() string {
+func (x *PhoneVerificationMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.AccessToken
+		return x.Token
+	}
+	return nil
+}
+
+func (x *PhoneVerificationMsg) GetContactInfoId() string {
+	if x != nil {
+		return x.ContactInfoId
 	}
 	return ""
 }
 
-func (x *TokenResponse) GetRefreshToken() string {
+func (x *PhoneVerificationMsg) GetVerificationCode() string {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return ""
+}
+
+type JWTRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *JWTRequest) Reset() {
+	*x = JWTRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTRequest) ProtoMessage() {}
+
+func (x *JWTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTRequest.ProtoReflect.Descriptor instead.
+func (*JWTRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *JWTRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RefreshJWTRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	AccessToken  string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+}
+
+func (x *RefreshJWTRequest) Reset() {
+	*x = RefreshJWTRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshJWTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshJWTRequest) ProtoMessage() {}
+
+func (x *RefreshJWTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshJWTRequest.ProtoReflect.Descriptor instead.
+func (*RefreshJWTRequest) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RefreshJWTRequest) GetRefreshToken() string {
 	if x != nil {
 		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *TokenResponse) GetExpiresIn() int32 {
+func (x *RefreshJWTRequest) GetAccessToken() string {
 	if x != nil {
-		return x.ExpiresIn
+		return x.AccessToken
 	}
-	return 0
+	return ""
 }
 
-func (x *TokenResponse) GetProfiles() []*Profile {
+type ImportUserRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row               int32    `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // position in the source file, used in the report
+	Email             string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	PreferredLanguage string   `protobuf:"bytes,3,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+	Roles             []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	ProfileNames      []string `protobuf:"bytes,5,rep,name=profile_names,json=profileNames,proto3" json:"profile_names,omitempty"`
+	CreatedAt         int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ImportUserRow) Reset() {
+	*x = ImportUserRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUserRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUserRow) ProtoMessage() {}
+
+func (x *ImportUserRow) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUserRow.ProtoReflect.Descriptor instead.
+func (*ImportUserRow) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportUserRow) GetRow() int32 {
 	if x != nil {
-		return x.Profiles
+		return x.Row
 	}
-	return nil
+	return 0
 }
 
-func (x *TokenResponse) GetSelectedProfileId() string {
+func (x *ImportUserRow) GetEmail() string {
 	if x != nil {
-		return x.SelectedProfileId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *TokenResponse) GetPreferredLanguage() string {
+func (x *ImportUserRow) GetPreferredLanguage() string {
 	if x != nil {
 		return x.PreferredLanguage
 	}
 	return ""
 }
 
-type StreamUsersMsg_Filters struct {
+func (x *ImportUserRow) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ImportUserRow) GetProfileNames() []string {
+	if x != nil {
+		return x.ProfileNames
+	}
+	return nil
+}
+
+func (x *ImportUserRow) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ImportUsersMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UseReminderWeekdayFilter bool  `protobuf:"varint,1,opt,name=use_reminder_weekday_filter,json=useReminderWeekdayFilter,proto3" json:"use_reminder_weekday_filter,omitempty"`
-	ReminderWeekday          int32 `protobuf:"varint,2,opt,name=reminder_weekday,json=reminderWeekday,proto3" json:"reminder_weekday,omitempty"`
-	OnlyConfirmedAccounts    bool  `protobuf:"varint,3,opt,name=only_confirmed_accounts,json=onlyConfirmedAccounts,proto3" json:"only_confirmed_accounts,omitempty"`
+	// token and options are read from the first message, rows can be sent in any message
+	Token              *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DryRun             bool                  `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	SendInvitations    bool                  `protobuf:"varint,3,opt,name=send_invitations,json=sendInvitations,proto3" json:"send_invitations,omitempty"`
+	InvitationInterval int32                 `protobuf:"varint,4,opt,name=invitation_interval,json=invitationInterval,proto3" json:"invitation_interval,omitempty"` // seconds between two invitation emails
+	Rows               []*ImportUserRow      `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (x *StreamUsersMsg_Filters) Reset() {
-	*x = StreamUsersMsg_Filters{}
+func (x *ImportUsersMsg) Reset() {
+	*x = ImportUsersMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_management_user_management_service_proto_msgTypes[34]
+		mi := &file_user_management_user_management_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamUsersMsg_Filters) String() string {
+func (x *ImportUsersMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamUsersMsg_Filters) ProtoMessage() {}
+func (*ImportUsersMsg) ProtoMessage() {}
 
-func (x *StreamUsersMsg_Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_user_management_user_management_service_proto_msgTypes[34]
+func (x *ImportUsersMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2237,2425 +2478,9225 @@ func (x *StreamUsersMsg_Filters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamUsersMsg_Filters.ProtoReflect.Descriptor instead.
-func (*StreamUsersMsg_Filters) Descriptor() ([]byte, []int) {
-	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{29, 0}
+// Deprecated: Use ImportUsersMsg.ProtoReflect.Descriptor instead.
+func (*ImportUsersMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *StreamUsersMsg_Filters) GetUseReminderWeekdayFilter() bool {
+func (x *ImportUsersMsg) GetToken() *api_types.TokenInfos {
 	if x != nil {
-		return x.UseReminderWeekdayFilter
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ImportUsersMsg) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *StreamUsersMsg_Filters) GetReminderWeekday() int32 {
+func (x *ImportUsersMsg) GetSendInvitations() bool {
 	if x != nil {
-		return x.ReminderWeekday
+		return x.SendInvitations
+	}
+	return false
+}
+
+func (x *ImportUsersMsg) GetInvitationInterval() int32 {
+	if x != nil {
+		return x.InvitationInterval
 	}
 	return 0
 }
 
-func (x *StreamUsersMsg_Filters) GetOnlyConfirmedAccounts() bool {
+func (x *ImportUsersMsg) GetRows() []*ImportUserRow {
 	if x != nil {
-		return x.OnlyConfirmedAccounts
+		return x.Rows
 	}
-	return false
+	return nil
 }
 
-var File_user_management_user_management_service_proto protoreflect.FileDescriptor
+type ImportUsersReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_user_management_user_management_service_proto_rawDesc = []byte{
-	0x0a, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x20, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
-	0x69, 0x1a, 0x1a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x01, 0x22, 0xda, 0x01,
-	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6e, 0x74, 0x73, 0x5f,
-	0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x77, 0x61, 0x6e, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x32, 0x66, 0x61, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x73, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x50,
-	0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x53, 0x0a, 0x0f, 0x41,
-	0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a,
-	0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0xa5, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x6d, 0x65,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x53,
-	0x61, 0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x66, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e,
-	0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x13, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x27, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x0e,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x12, 0x3c, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e,
-	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0f,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12,
-	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1c,
-	0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
-	0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x5a, 0x0a, 0x18, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
-	0x22, 0x33, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x46, 0x6f, 0x72, 0x50, 0x57, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24,
-	0x0a, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x6f, 0x0a, 0x11, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x12, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x22, 0x0a, 0x0a, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x66,
-	0x72, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x02, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x32, 0x66, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x73, 0x0a,
-	0x07, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f,
-	0x6c, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0xab, 0x01, 0x0a, 0x07, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x5f, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x75, 0x73, 0x65,
-	0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
-	0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
-	0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x15, 0x6f, 0x6e, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64,
-	0x4e, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e,
-	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x21, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x2d,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a,
-	0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a,
-	0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75,
-	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x32, 0x82, 0x1e, 0x0a,
-	0x11, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41,
-	0x70, 0x69, 0x12, 0x51, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
-	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x41,
-	0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x76, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x50,
-	0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x50, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f,
-	0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4a, 0x57, 0x54, 0x12, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x73, 0x12, 0x70, 0x0a, 0x08, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4a, 0x57, 0x54,
-	0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
-	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x12, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x0d,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x19, 0x52,
-	0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65,
-	0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7b, 0x0a, 0x10, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x64, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12,
-	0x6f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
-	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
-	0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x6a, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x6d,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65,
-	0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x2f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x76, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a,
-	0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x58, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x44, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x2f, 0x2e, 0x69,
-	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a,
-	0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
-	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e,
-	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x84, 0x01,
-	0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x3a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
-	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x73, 0x46, 0x6f, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65,
-	0x74, 0x12, 0x3d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x46, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67,
-	0x1a, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x50,
-	0x57, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x74, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0b,
-	0x53, 0x61, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x69, 0x6e,
-	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
-	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x51, 0x0a,
-	0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30,
-	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x73, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
-	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08, 0x41, 0x64,
-	0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
-	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
-	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52,
-	0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66,
-	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f,
-	0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x3c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x1a,
-	0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x51,
-	0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x2e,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x1a,
-	0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30,
-	0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x75, 0x73, 0x65,
-	0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	DryRun               bool                           `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total                int32                          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Created              int32                          `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Existing             int32                          `protobuf:"varint,4,opt,name=existing,proto3" json:"existing,omitempty"`
+	Invalid              int32                          `protobuf:"varint,5,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	InvitationsScheduled int32                          `protobuf:"varint,6,opt,name=invitations_scheduled,json=invitationsScheduled,proto3" json:"invitations_scheduled,omitempty"`
+	Results              []*ImportUsersReport_RowResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-var (
-	file_user_management_user_management_service_proto_rawDescOnce sync.Once
-	file_user_management_user_management_service_proto_rawDescData = file_user_management_user_management_service_proto_rawDesc
-)
+func (x *ImportUsersReport) Reset() {
+	*x = ImportUsersReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_user_management_user_management_service_proto_rawDescGZIP() []byte {
-	file_user_management_user_management_service_proto_rawDescOnce.Do(func() {
-		file_user_management_user_management_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_user_management_user_management_service_proto_rawDescData)
-	})
-	return file_user_management_user_management_service_proto_rawDescData
+func (x *ImportUsersReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_user_management_user_management_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_management_user_management_service_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
-var file_user_management_user_management_service_proto_goTypes = []interface{}{
-	(ServiceStatus_StatusValue)(0),       // 0: influenzanet.user_management_api.ServiceStatus.StatusValue
-	(*ServiceStatus)(nil),                // 1: influenzanet.user_management_api.ServiceStatus
-	(*SignupWithEmailMsg)(nil),           // 2: influenzanet.user_management_api.SignupWithEmailMsg
-	(*LoginWithEmailMsg)(nil),            // 3: influenzanet.user_management_api.LoginWithEmailMsg
-	(*LoginWithExternalIDPMsg)(nil),      // 4: influenzanet.user_management_api.LoginWithExternalIDPMsg
-	(*AutoValidateReq)(nil),              // 5: influenzanet.user_management_api.AutoValidateReq
-	(*AutoValidateResponse)(nil),         // 6: influenzanet.user_management_api.AutoValidateResponse
-	(*SendVerificationCodeReq)(nil),      // 7: influenzanet.user_management_api.SendVerificationCodeReq
-	(*LoginResponse)(nil),                // 8: influenzanet.user_management_api.LoginResponse
-	(*UserReference)(nil),                // 9: influenzanet.user_management_api.UserReference
-	(*RevokeRefreshTokensReq)(nil),       // 10: influenzanet.user_management_api.RevokeRefreshTokensReq
-	(*RefreshTokenRequest)(nil),          // 11: influenzanet.user_management_api.RefreshTokenRequest
-	(*AppTokenRequest)(nil),              // 12: influenzanet.user_management_api.AppTokenRequest
-	(*AppTokenValidation)(nil),           // 13: influenzanet.user_management_api.AppTokenValidation
-	(*ProfileRequest)(nil),               // 14: influenzanet.user_management_api.ProfileRequest
-	(*UserAuthInfo)(nil),                 // 15: influenzanet.user_management_api.UserAuthInfo
-	(*ResendContactVerificationReq)(nil), // 16: influenzanet.user_management_api.ResendContactVerificationReq
-	(*PasswordChangeMsg)(nil),            // 17: influenzanet.user_management_api.PasswordChangeMsg
-	(*InitiateResetPasswordMsg)(nil),     // 18: influenzanet.user_management_api.InitiateResetPasswordMsg
-	(*GetInfosForResetPasswordMsg)(nil),  // 19: influenzanet.user_management_api.GetInfosForResetPasswordMsg
-	(*UserInfoForPWReset)(nil),           // 20: influenzanet.user_management_api.UserInfoForPWReset
-	(*ResetPasswordMsg)(nil),             // 21: influenzanet.user_management_api.ResetPasswordMsg
-	(*EmailChangeMsg)(nil),               // 22: influenzanet.user_management_api.EmailChangeMsg
-	(*LanguageChangeMsg)(nil),            // 23: influenzanet.user_management_api.LanguageChangeMsg
-	(*ContactPreferencesMsg)(nil),        // 24: influenzanet.user_management_api.ContactPreferencesMsg
-	(*ContactInfoMsg)(nil),               // 25: influenzanet.user_management_api.ContactInfoMsg
-	(*JWTRequest)(nil),                   // 26: influenzanet.user_management_api.JWTRequest
-	(*RefreshJWTRequest)(nil),            // 27: influenzanet.user_management_api.RefreshJWTRequest
-	(*CreateUserReq)(nil),                // 28: influenzanet.user_management_api.CreateUserReq
-	(*RoleMsg)(nil),                      // 29: influenzanet.user_management_api.RoleMsg
-	(*StreamUsersMsg)(nil),               // 30: influenzanet.user_management_api.StreamUsersMsg
-	(*FindNonParticipantUsersMsg)(nil),   // 31: influenzanet.user_management_api.FindNonParticipantUsersMsg
-	(*UserListMsg)(nil),                  // 32: influenzanet.user_management_api.UserListMsg
-	(*TempToken)(nil),                    // 33: influenzanet.user_management_api.TempToken
-	(*TokenResponse)(nil),                // 34: influenzanet.user_management_api.TokenResponse
-	(*StreamUsersMsg_Filters)(nil),       // 35: influenzanet.user_management_api.StreamUsersMsg.Filters
-	(*User)(nil),                         // 36: inf.user.User
-	(*api_types.TokenInfos)(nil),         // 37: influenzanet.shared.TokenInfos
-	(*Profile)(nil),                      // 38: inf.user.Profile
-	(*ContactPreferences)(nil),           // 39: inf.user.ContactPreferences
-	(*ContactInfo)(nil),                  // 40: inf.user.ContactInfo
-	(*empty.Empty)(nil),                  // 41: google.protobuf.Empty
-	(*api_types.TempTokenInfo)(nil),      // 42: influenzanet.shared.TempTokenInfo
-	(*api_types.TempTokenInfos)(nil),     // 43: influenzanet.shared.TempTokenInfos
+func (*ImportUsersReport) ProtoMessage() {}
+
+func (x *ImportUsersReport) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_user_management_user_management_service_proto_depIdxs = []int32{
-	0,  // 0: influenzanet.user_management_api.ServiceStatus.status:type_name -> influenzanet.user_management_api.ServiceStatus.StatusValue
-	34, // 1: influenzanet.user_management_api.LoginResponse.token:type_name -> influenzanet.user_management_api.TokenResponse
-	36, // 2: influenzanet.user_management_api.LoginResponse.user:type_name -> inf.user.User
-	37, // 3: influenzanet.user_management_api.UserReference.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 4: influenzanet.user_management_api.RevokeRefreshTokensReq.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 5: influenzanet.user_management_api.ProfileRequest.token:type_name -> influenzanet.shared.TokenInfos
-	38, // 6: influenzanet.user_management_api.ProfileRequest.profile:type_name -> inf.user.Profile
-	38, // 7: influenzanet.user_management_api.UserAuthInfo.profiles:type_name -> inf.user.Profile
-	38, // 8: influenzanet.user_management_api.UserAuthInfo.selected_profile:type_name -> inf.user.Profile
-	37, // 9: influenzanet.user_management_api.ResendContactVerificationReq.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 10: influenzanet.user_management_api.PasswordChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 11: influenzanet.user_management_api.EmailChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 12: influenzanet.user_management_api.LanguageChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 13: influenzanet.user_management_api.ContactPreferencesMsg.token:type_name -> influenzanet.shared.TokenInfos
-	39, // 14: influenzanet.user_management_api.ContactPreferencesMsg.contact_preferences:type_name -> inf.user.ContactPreferences
-	37, // 15: influenzanet.user_management_api.ContactInfoMsg.token:type_name -> influenzanet.shared.TokenInfos
-	40, // 16: influenzanet.user_management_api.ContactInfoMsg.contact_info:type_name -> inf.user.ContactInfo
-	37, // 17: influenzanet.user_management_api.CreateUserReq.token:type_name -> influenzanet.shared.TokenInfos
-	37, // 18: influenzanet.user_management_api.RoleMsg.token:type_name -> influenzanet.shared.TokenInfos
-	35, // 19: influenzanet.user_management_api.StreamUsersMsg.filters:type_name -> influenzanet.user_management_api.StreamUsersMsg.Filters
-	37, // 20: influenzanet.user_management_api.FindNonParticipantUsersMsg.token:type_name -> influenzanet.shared.TokenInfos
-	36, // 21: influenzanet.user_management_api.UserListMsg.users:type_name -> inf.user.User
-	38, // 22: influenzanet.user_management_api.TokenResponse.profiles:type_name -> inf.user.Profile
-	41, // 23: influenzanet.user_management_api.UserManagementApi.Status:input_type -> google.protobuf.Empty
-	7,  // 24: influenzanet.user_management_api.UserManagementApi.SendVerificationCode:input_type -> influenzanet.user_management_api.SendVerificationCodeReq
-	5,  // 25: influenzanet.user_management_api.UserManagementApi.AutoValidateTempToken:input_type -> influenzanet.user_management_api.AutoValidateReq
-	3,  // 26: influenzanet.user_management_api.UserManagementApi.LoginWithEmail:input_type -> influenzanet.user_management_api.LoginWithEmailMsg
-	4,  // 27: influenzanet.user_management_api.UserManagementApi.LoginWithExternalIDP:input_type -> influenzanet.user_management_api.LoginWithExternalIDPMsg
-	2,  // 28: influenzanet.user_management_api.UserManagementApi.SignupWithEmail:input_type -> influenzanet.user_management_api.SignupWithEmailMsg
-	26, // 29: influenzanet.user_management_api.UserManagementApi.ValidateJWT:input_type -> influenzanet.user_management_api.JWTRequest
-	27, // 30: influenzanet.user_management_api.UserManagementApi.RenewJWT:input_type -> influenzanet.user_management_api.RefreshJWTRequest
-	10, // 31: influenzanet.user_management_api.UserManagementApi.RevokeAllRefreshTokens:input_type -> influenzanet.user_management_api.RevokeRefreshTokensReq
-	33, // 32: influenzanet.user_management_api.UserManagementApi.VerifyContact:input_type -> influenzanet.user_management_api.TempToken
-	16, // 33: influenzanet.user_management_api.UserManagementApi.ResendContactVerification:input_type -> influenzanet.user_management_api.ResendContactVerificationReq
-	12, // 34: influenzanet.user_management_api.UserManagementApi.ValidateAppToken:input_type -> influenzanet.user_management_api.AppTokenRequest
-	42, // 35: influenzanet.user_management_api.UserManagementApi.GetOrCreateTemptoken:input_type -> influenzanet.shared.TempTokenInfo
-	42, // 36: influenzanet.user_management_api.UserManagementApi.GenerateTempToken:input_type -> influenzanet.shared.TempTokenInfo
-	42, // 37: influenzanet.user_management_api.UserManagementApi.GetTempTokens:input_type -> influenzanet.shared.TempTokenInfo
-	33, // 38: influenzanet.user_management_api.UserManagementApi.DeleteTempToken:input_type -> influenzanet.user_management_api.TempToken
-	42, // 39: influenzanet.user_management_api.UserManagementApi.PurgeUserTempTokens:input_type -> influenzanet.shared.TempTokenInfo
-	9,  // 40: influenzanet.user_management_api.UserManagementApi.GetUser:input_type -> influenzanet.user_management_api.UserReference
-	17, // 41: influenzanet.user_management_api.UserManagementApi.ChangePassword:input_type -> influenzanet.user_management_api.PasswordChangeMsg
-	22, // 42: influenzanet.user_management_api.UserManagementApi.ChangeAccountIDEmail:input_type -> influenzanet.user_management_api.EmailChangeMsg
-	9,  // 43: influenzanet.user_management_api.UserManagementApi.DeleteAccount:input_type -> influenzanet.user_management_api.UserReference
-	23, // 44: influenzanet.user_management_api.UserManagementApi.ChangePreferredLanguage:input_type -> influenzanet.user_management_api.LanguageChangeMsg
-	18, // 45: influenzanet.user_management_api.UserManagementApi.InitiatePasswordReset:input_type -> influenzanet.user_management_api.InitiateResetPasswordMsg
-	19, // 46: influenzanet.user_management_api.UserManagementApi.GetInfosForPasswordReset:input_type -> influenzanet.user_management_api.GetInfosForResetPasswordMsg
-	21, // 47: influenzanet.user_management_api.UserManagementApi.ResetPassword:input_type -> influenzanet.user_management_api.ResetPasswordMsg
-	14, // 48: influenzanet.user_management_api.UserManagementApi.SaveProfile:input_type -> influenzanet.user_management_api.ProfileRequest
-	14, // 49: influenzanet.user_management_api.UserManagementApi.RemoveProfile:input_type -> influenzanet.user_management_api.ProfileRequest
-	33, // 50: influenzanet.user_management_api.UserManagementApi.UseUnsubscribeToken:input_type -> influenzanet.user_management_api.TempToken
-	24, // 51: influenzanet.user_management_api.UserManagementApi.UpdateContactPreferences:input_type -> influenzanet.user_management_api.ContactPreferencesMsg
-	25, // 52: influenzanet.user_management_api.UserManagementApi.AddEmail:input_type -> influenzanet.user_management_api.ContactInfoMsg
-	25, // 53: influenzanet.user_management_api.UserManagementApi.RemoveEmail:input_type -> influenzanet.user_management_api.ContactInfoMsg
-	28, // 54: influenzanet.user_management_api.UserManagementApi.CreateUser:input_type -> influenzanet.user_management_api.CreateUserReq
-	29, // 55: influenzanet.user_management_api.UserManagementApi.AddRoleForUser:input_type -> influenzanet.user_management_api.RoleMsg
-	29, // 56: influenzanet.user_management_api.UserManagementApi.RemoveRoleForUser:input_type -> influenzanet.user_management_api.RoleMsg
-	31, // 57: influenzanet.user_management_api.UserManagementApi.FindNonParticipantUsers:input_type -> influenzanet.user_management_api.FindNonParticipantUsersMsg
-	30, // 58: influenzanet.user_management_api.UserManagementApi.StreamUsers:input_type -> influenzanet.user_management_api.StreamUsersMsg
-	1,  // 59: influenzanet.user_management_api.UserManagementApi.Status:output_type -> influenzanet.user_management_api.ServiceStatus
-	1,  // 60: influenzanet.user_management_api.UserManagementApi.SendVerificationCode:output_type -> influenzanet.user_management_api.ServiceStatus
-	6,  // 61: influenzanet.user_management_api.UserManagementApi.AutoValidateTempToken:output_type -> influenzanet.user_management_api.AutoValidateResponse
-	8,  // 62: influenzanet.user_management_api.UserManagementApi.LoginWithEmail:output_type -> influenzanet.user_management_api.LoginResponse
-	8,  // 63: influenzanet.user_management_api.UserManagementApi.LoginWithExternalIDP:output_type -> influenzanet.user_management_api.LoginResponse
-	34, // 64: influenzanet.user_management_api.UserManagementApi.SignupWithEmail:output_type -> influenzanet.user_management_api.TokenResponse
-	37, // 65: influenzanet.user_management_api.UserManagementApi.ValidateJWT:output_type -> influenzanet.shared.TokenInfos
-	34, // 66: influenzanet.user_management_api.UserManagementApi.RenewJWT:output_type -> influenzanet.user_management_api.TokenResponse
-	1,  // 67: influenzanet.user_management_api.UserManagementApi.RevokeAllRefreshTokens:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 68: influenzanet.user_management_api.UserManagementApi.VerifyContact:output_type -> inf.user.User
-	1,  // 69: influenzanet.user_management_api.UserManagementApi.ResendContactVerification:output_type -> influenzanet.user_management_api.ServiceStatus
-	13, // 70: influenzanet.user_management_api.UserManagementApi.ValidateAppToken:output_type -> influenzanet.user_management_api.AppTokenValidation
-	33, // 71: influenzanet.user_management_api.UserManagementApi.GetOrCreateTemptoken:output_type -> influenzanet.user_management_api.TempToken
-	33, // 72: influenzanet.user_management_api.UserManagementApi.GenerateTempToken:output_type -> influenzanet.user_management_api.TempToken
-	43, // 73: influenzanet.user_management_api.UserManagementApi.GetTempTokens:output_type -> influenzanet.shared.TempTokenInfos
-	1,  // 74: influenzanet.user_management_api.UserManagementApi.DeleteTempToken:output_type -> influenzanet.user_management_api.ServiceStatus
-	1,  // 75: influenzanet.user_management_api.UserManagementApi.PurgeUserTempTokens:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 76: influenzanet.user_management_api.UserManagementApi.GetUser:output_type -> inf.user.User
-	1,  // 77: influenzanet.user_management_api.UserManagementApi.ChangePassword:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 78: influenzanet.user_management_api.UserManagementApi.ChangeAccountIDEmail:output_type -> inf.user.User
-	1,  // 79: influenzanet.user_management_api.UserManagementApi.DeleteAccount:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 80: influenzanet.user_management_api.UserManagementApi.ChangePreferredLanguage:output_type -> inf.user.User
-	1,  // 81: influenzanet.user_management_api.UserManagementApi.InitiatePasswordReset:output_type -> influenzanet.user_management_api.ServiceStatus
-	20, // 82: influenzanet.user_management_api.UserManagementApi.GetInfosForPasswordReset:output_type -> influenzanet.user_management_api.UserInfoForPWReset
-	1,  // 83: influenzanet.user_management_api.UserManagementApi.ResetPassword:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 84: influenzanet.user_management_api.UserManagementApi.SaveProfile:output_type -> inf.user.User
-	36, // 85: influenzanet.user_management_api.UserManagementApi.RemoveProfile:output_type -> inf.user.User
-	1,  // 86: influenzanet.user_management_api.UserManagementApi.UseUnsubscribeToken:output_type -> influenzanet.user_management_api.ServiceStatus
-	36, // 87: influenzanet.user_management_api.UserManagementApi.UpdateContactPreferences:output_type -> inf.user.User
-	36, // 88: influenzanet.user_management_api.UserManagementApi.AddEmail:output_type -> inf.user.User
-	36, // 89: influenzanet.user_management_api.UserManagementApi.RemoveEmail:output_type -> inf.user.User
-	36, // 90: influenzanet.user_management_api.UserManagementApi.CreateUser:output_type -> inf.user.User
-	36, // 91: influenzanet.user_management_api.UserManagementApi.AddRoleForUser:output_type -> inf.user.User
-	36, // 92: influenzanet.user_management_api.UserManagementApi.RemoveRoleForUser:output_type -> inf.user.User
-	32, // 93: influenzanet.user_management_api.UserManagementApi.FindNonParticipantUsers:output_type -> influenzanet.user_management_api.UserListMsg
-	36, // 94: influenzanet.user_management_api.UserManagementApi.StreamUsers:output_type -> inf.user.User
-	59, // [59:95] is the sub-list for method output_type
-	23, // [23:59] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+// Deprecated: Use ImportUsersReport.ProtoReflect.Descriptor instead.
+func (*ImportUsersReport) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{38}
 }
 
-func init() { file_user_management_user_management_service_proto_init() }
-func file_user_management_user_management_service_proto_init() {
-	if File_user_management_user_management_service_proto != nil {
-		return
+func (x *ImportUsersReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
-	file_user_management_user_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_user_management_user_management_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceStatus); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignupWithEmailMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginWithEmailMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginWithExternalIDPMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoValidateReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return false
+}
+
+func (x *ImportUsersReport) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportUsersReport) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportUsersReport) GetExisting() int32 {
+	if x != nil {
+		return x.Existing
+	}
+	return 0
+}
+
+func (x *ImportUsersReport) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportUsersReport) GetInvitationsScheduled() int32 {
+	if x != nil {
+		return x.InvitationsScheduled
+	}
+	return 0
+}
+
+func (x *ImportUsersReport) GetResults() []*ImportUsersReport_RowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetInvitationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token         *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	IncludeClosed bool                  `protobuf:"varint,2,opt,name=include_closed,json=includeClosed,proto3" json:"include_closed,omitempty"` // also list accepted and revoked invitations
+}
+
+func (x *GetInvitationsReq) Reset() {
+	*x = GetInvitationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvitationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvitationsReq) ProtoMessage() {}
+
+func (x *GetInvitationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoValidateResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvitationsReq.ProtoReflect.Descriptor instead.
+func (*GetInvitationsReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetInvitationsReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetInvitationsReq) GetIncludeClosed() bool {
+	if x != nil {
+		return x.IncludeClosed
+	}
+	return false
+}
+
+type InvitationMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *InvitationMsg) Reset() {
+	*x = InvitationMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationMsg) ProtoMessage() {}
+
+func (x *InvitationMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendVerificationCodeReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationMsg.ProtoReflect.Descriptor instead.
+func (*InvitationMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InvitationMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *InvitationMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId      string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId   string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "pending", "expired", "accepted" or "revoked"
+	FirstSentAt int64  `protobuf:"varint,4,opt,name=first_sent_at,json=firstSentAt,proto3" json:"first_sent_at,omitempty"`
+	LastSentAt  int64  `protobuf:"varint,5,opt,name=last_sent_at,json=lastSentAt,proto3" json:"last_sent_at,omitempty"`
+	SendCount   int32  `protobuf:"varint,6,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	ExpiresAt   int64  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AcceptedAt  int64  `protobuf:"varint,8,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	RevokedAt   int64  `protobuf:"varint,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Invitation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invitation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetFirstSentAt() int64 {
+	if x != nil {
+		return x.FirstSentAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetLastSentAt() int64 {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *Invitation) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type InvitationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *InvitationList) Reset() {
+	*x = InvitationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationList) ProtoMessage() {}
+
+func (x *InvitationList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserReference); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeRefreshTokensReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshTokenRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppTokenRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationList.ProtoReflect.Descriptor instead.
+func (*InvitationList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InvitationList) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type CreateUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token             *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AccountId         string                `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	InitialPassword   string                `protobuf:"bytes,3,opt,name=initial_password,json=initialPassword,proto3" json:"initial_password,omitempty"`
+	PreferredLanguage string                `protobuf:"bytes,4,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+	Roles             []string              `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	Use_2Fa           bool                  `protobuf:"varint,6,opt,name=use_2fa,json=use2fa,proto3" json:"use_2fa,omitempty"`
+	ProfileNames      []string              `protobuf:"bytes,7,rep,name=profile_names,json=profileNames,proto3" json:"profile_names,omitempty"` // Optional list of profiles that should be added for the user right at creation. First entry will be the main profiles name.
+	// When migrating previous account, that should not be confirmed
+	AccountConfirmedAt int64 `protobuf:"varint,8,opt,name=account_confirmed_at,json=accountConfirmedAt,proto3" json:"account_confirmed_at,omitempty"`
+	CreatedAt          int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CreateUserReq) Reset() {
+	*x = CreateUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserReq) ProtoMessage() {}
+
+func (x *CreateUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppTokenValidation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserReq.ProtoReflect.Descriptor instead.
+func (*CreateUserReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateUserReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateUserReq) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateUserReq) GetInitialPassword() string {
+	if x != nil {
+		return x.InitialPassword
+	}
+	return ""
+}
+
+func (x *CreateUserReq) GetPreferredLanguage() string {
+	if x != nil {
+		return x.PreferredLanguage
+	}
+	return ""
+}
+
+func (x *CreateUserReq) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *CreateUserReq) GetUse_2Fa() bool {
+	if x != nil {
+		return x.Use_2Fa
+	}
+	return false
+}
+
+func (x *CreateUserReq) GetProfileNames() []string {
+	if x != nil {
+		return x.ProfileNames
+	}
+	return nil
+}
+
+func (x *CreateUserReq) GetAccountConfirmedAt() int64 {
+	if x != nil {
+		return x.AccountConfirmedAt
+	}
+	return 0
+}
+
+func (x *CreateUserReq) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type MoveUserMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token            *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // admin of the current instance
+	UserId           string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetInstanceId string                `protobuf:"bytes,3,opt,name=target_instance_id,json=targetInstanceId,proto3" json:"target_instance_id,omitempty"`
+}
+
+func (x *MoveUserMsg) Reset() {
+	*x = MoveUserMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveUserMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserMsg) ProtoMessage() {}
+
+func (x *MoveUserMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProfileRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserMsg.ProtoReflect.Descriptor instead.
+func (*MoveUserMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MoveUserMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *MoveUserMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveUserMsg) GetTargetInstanceId() string {
+	if x != nil {
+		return x.TargetInstanceId
+	}
+	return ""
+}
+
+type MergeAccountsMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SourceUserId string                `protobuf:"bytes,2,opt,name=source_user_id,json=sourceUserId,proto3" json:"source_user_id,omitempty"` // deleted after the merge
+	TargetUserId string                `protobuf:"bytes,3,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	DryRun       bool                  `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *MergeAccountsMsg) Reset() {
+	*x = MergeAccountsMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeAccountsMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsMsg) ProtoMessage() {}
+
+func (x *MergeAccountsMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAuthInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResendContactVerificationReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordChangeMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateResetPasswordMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfosForResetPasswordMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInfoForPWReset); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsMsg.ProtoReflect.Descriptor instead.
+func (*MergeAccountsMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MergeAccountsMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *MergeAccountsMsg) GetSourceUserId() string {
+	if x != nil {
+		return x.SourceUserId
+	}
+	return ""
+}
+
+func (x *MergeAccountsMsg) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *MergeAccountsMsg) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MergeAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun           bool              `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	User             *User             `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`                                                                                                                                           // target user after the merge
+	ProfileIdMapping map[string]string `protobuf:"bytes,3,rep,name=profile_id_mapping,json=profileIdMapping,proto3" json:"profile_id_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // source profile ID -> target profile ID
+	RolesNotMerged   []string          `protobuf:"bytes,4,rep,name=roles_not_merged,json=rolesNotMerged,proto3" json:"roles_not_merged,omitempty"`                                                                                               // roles of the source that require approval, to grant with GrantRole
+}
+
+func (x *MergeAccountsResponse) Reset() {
+	*x = MergeAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeAccountsResponse) ProtoMessage() {}
+
+func (x *MergeAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetPasswordMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeAccountsResponse.ProtoReflect.Descriptor instead.
+func (*MergeAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MergeAccountsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MergeAccountsResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MergeAccountsResponse) GetProfileIdMapping() map[string]string {
+	if x != nil {
+		return x.ProfileIdMapping
+	}
+	return nil
+}
+
+func (x *MergeAccountsResponse) GetRolesNotMerged() []string {
+	if x != nil {
+		return x.RolesNotMerged
+	}
+	return nil
+}
+
+type RoleMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AccountId string                `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role      string                `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt int64                 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // optional, the role is removed again after this time
+	Scopes    []string              `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`                         // optional, restricts the role e.g. to some studies
+}
+
+func (x *RoleMsg) Reset() {
+	*x = RoleMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMsg) ProtoMessage() {}
+
+func (x *RoleMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailChangeMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMsg.ProtoReflect.Descriptor instead.
+func (*RoleMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RoleMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RoleMsg) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RoleMsg) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleMsg) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RoleMsg) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type RoleGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId   string   `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role        string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Status      string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // "pending", "approved", "rejected", "expired" or "revoked"
+	RequestedBy string   `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt int64    `protobuf:"varint,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	DecidedBy   string   `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt   int64    `protobuf:"varint,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	ExpiresAt   int64    `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Scopes      []string `protobuf:"bytes,11,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (x *RoleGrant) Reset() {
+	*x = RoleGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGrant) ProtoMessage() {}
+
+func (x *RoleGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LanguageChangeMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_user_management_user_management_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContactPreferencesMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGrant.ProtoReflect.Descriptor instead.
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RoleGrant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *RoleGrant) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *RoleGrant) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *RoleGrant) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RoleGrant) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type GetRoleGrantsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Status string                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // optional
+}
+
+func (x *GetRoleGrantsReq) Reset() {
+	*x = GetRoleGrantsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoleGrantsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleGrantsReq) ProtoMessage() {}
+
+func (x *GetRoleGrantsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContactInfoMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleGrantsReq.ProtoReflect.Descriptor instead.
+func (*GetRoleGrantsReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetRoleGrantsReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetRoleGrantsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RoleGrantList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grants []*RoleGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *RoleGrantList) Reset() {
+	*x = RoleGrantList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleGrantList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGrantList) ProtoMessage() {}
+
+func (x *RoleGrantList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JWTRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGrantList.ProtoReflect.Descriptor instead.
+func (*RoleGrantList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RoleGrantList) GetGrants() []*RoleGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type RoleGrantDecisionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	GrantId string                `protobuf:"bytes,2,opt,name=grant_id,json=grantId,proto3" json:"grant_id,omitempty"`
+}
+
+func (x *RoleGrantDecisionMsg) Reset() {
+	*x = RoleGrantDecisionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleGrantDecisionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleGrantDecisionMsg) ProtoMessage() {}
+
+func (x *RoleGrantDecisionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefreshJWTRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleGrantDecisionMsg.ProtoReflect.Descriptor instead.
+func (*RoleGrantDecisionMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RoleGrantDecisionMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RoleGrantDecisionMsg) GetGrantId() string {
+	if x != nil {
+		return x.GrantId
+	}
+	return ""
+}
+
+type ImpersonateUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason string                `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // required, stored in the audit log
+}
+
+func (x *ImpersonateUserReq) Reset() {
+	*x = ImpersonateUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImpersonateUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateUserReq) ProtoMessage() {}
+
+func (x *ImpersonateUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateUserReq.ProtoReflect.Descriptor instead.
+func (*ImpersonateUserReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImpersonateUserReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ImpersonateUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImpersonateUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateServiceAccountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token             *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name              string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // used as account ID
+	PreferredLanguage string                `protobuf:"bytes,3,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+}
+
+func (x *CreateServiceAccountReq) Reset() {
+	*x = CreateServiceAccountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateServiceAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServiceAccountReq) ProtoMessage() {}
+
+func (x *CreateServiceAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServiceAccountReq.ProtoReflect.Descriptor instead.
+func (*CreateServiceAccountReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateServiceAccountReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateServiceAccountReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServiceAccountReq) GetPreferredLanguage() string {
+	if x != nil {
+		return x.PreferredLanguage
+	}
+	return ""
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes     []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"` // permissions the tokens are limited to, all of the account's if empty
+	CreatedBy  string   `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt  int64    `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt  int64    `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt int64    `protobuf:"varint,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  int64    `protobuf:"varint,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamUsersMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *APIKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *APIKey) GetLastUsedAt() int64 {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return 0
+}
+
+func (x *APIKey) GetRevokedAt() int64 {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return 0
+}
+
+type CreateAPIKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId    string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name      string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes    []string              `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt int64                 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // optional
+}
+
+func (x *CreateAPIKeyReq) Reset() {
+	*x = CreateAPIKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyReq) ProtoMessage() {}
+
+func (x *CreateAPIKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindNonParticipantUsersMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyReq.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAPIKeyReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyReq) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyReq) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key    string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // only returned here, the service only keeps its hash
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserListMsg); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetAPIKeysReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token          *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId         string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeRevoked bool                  `protobuf:"varint,3,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+}
+
+func (x *GetAPIKeysReq) Reset() {
+	*x = GetAPIKeysReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAPIKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPIKeysReq) ProtoMessage() {}
+
+func (x *GetAPIKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TempToken); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPIKeysReq.ProtoReflect.Descriptor instead.
+func (*GetAPIKeysReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAPIKeysReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetAPIKeysReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAPIKeysReq) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type APIKeyList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *APIKeyList) Reset() {
+	*x = APIKeyList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyList) ProtoMessage() {}
+
+func (x *APIKeyList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyList.ProtoReflect.Descriptor instead.
+func (*APIKeyList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *APIKeyList) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type APIKeyMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token  *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId string                `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KeyId  string                `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (x *APIKeyMsg) Reset() {
+	*x = APIKeyMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKeyMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyMsg) ProtoMessage() {}
+
+func (x *APIKeyMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_user_management_user_management_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamUsersMsg_Filters); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyMsg.ProtoReflect.Descriptor instead.
+func (*APIKeyMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *APIKeyMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *APIKeyMsg) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *APIKeyMsg) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type LoginWithAPIKeyMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	ApiKey     string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+}
+
+func (x *LoginWithAPIKeyMsg) Reset() {
+	*x = LoginWithAPIKeyMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginWithAPIKeyMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithAPIKeyMsg) ProtoMessage() {}
+
+func (x *LoginWithAPIKeyMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithAPIKeyMsg.ProtoReflect.Descriptor instead.
+func (*LoginWithAPIKeyMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LoginWithAPIKeyMsg) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *LoginWithAPIKeyMsg) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type RoleScopeMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AccountId string                `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role      string                `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Scope     string                `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"` // e.g. a study key
+}
+
+func (x *RoleScopeMsg) Reset() {
+	*x = RoleScopeMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleScopeMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleScopeMsg) ProtoMessage() {}
+
+func (x *RoleScopeMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleScopeMsg.ProtoReflect.Descriptor instead.
+func (*RoleScopeMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RoleScopeMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RoleScopeMsg) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RoleScopeMsg) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleScopeMsg) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type StreamUsersMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string                  `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Filters    *StreamUsersMsg_Filters `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	// top level user fields to include, e.g. "account", "contact_infos" (all if empty)
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// resume token of the last received batch (or ID of the last received user) to continue a stream
+	StartAfter string `protobuf:"bytes,4,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	BatchSize  int32  `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+}
+
+func (x *StreamUsersMsg) Reset() {
+	*x = StreamUsersMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamUsersMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersMsg) ProtoMessage() {}
+
+func (x *StreamUsersMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersMsg.ProtoReflect.Descriptor instead.
+func (*StreamUsersMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StreamUsersMsg) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *StreamUsersMsg) GetFilters() *StreamUsersMsg_Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *StreamUsersMsg) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *StreamUsersMsg) GetStartAfter() string {
+	if x != nil {
+		return x.StartAfter
+	}
+	return ""
+}
+
+func (x *StreamUsersMsg) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type UserBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// users are ordered by ID, use as start_after to continue after this batch
+	ResumeToken string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *UserBatch) Reset() {
+	*x = UserBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBatch) ProtoMessage() {}
+
+func (x *UserBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBatch.ProtoReflect.Descriptor instead.
+func (*UserBatch) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UserBatch) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *UserBatch) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type SubscriptionTopic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key         string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *SubscriptionTopic) Reset() {
+	*x = SubscriptionTopic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionTopic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionTopic) ProtoMessage() {}
+
+func (x *SubscriptionTopic) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionTopic.ProtoReflect.Descriptor instead.
+func (*SubscriptionTopic) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SubscriptionTopic) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SubscriptionTopic) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SubscriptionTopicMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Topic *SubscriptionTopic    `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *SubscriptionTopicMsg) Reset() {
+	*x = SubscriptionTopicMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionTopicMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionTopicMsg) ProtoMessage() {}
+
+func (x *SubscriptionTopicMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionTopicMsg.ProtoReflect.Descriptor instead.
+func (*SubscriptionTopicMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SubscriptionTopicMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *SubscriptionTopicMsg) GetTopic() *SubscriptionTopic {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type GetSubscriptionTopicsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+}
+
+func (x *GetSubscriptionTopicsReq) Reset() {
+	*x = GetSubscriptionTopicsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionTopicsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionTopicsReq) ProtoMessage() {}
+
+func (x *GetSubscriptionTopicsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionTopicsReq.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionTopicsReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetSubscriptionTopicsReq) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type SubscriptionTopicList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topics []*SubscriptionTopic `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *SubscriptionTopicList) Reset() {
+	*x = SubscriptionTopicList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionTopicList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionTopicList) ProtoMessage() {}
+
+func (x *SubscriptionTopicList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionTopicList.ProtoReflect.Descriptor instead.
+func (*SubscriptionTopicList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SubscriptionTopicList) GetTopics() []*SubscriptionTopic {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type RoleDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role             string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Description      string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions      []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	BuiltIn          bool     `protobuf:"varint,4,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`                            // defined by the service, cannot be changed
+	RequiresApproval bool     `protobuf:"varint,5,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"` // granting the role has to be approved by a second admin
+}
+
+func (x *RoleDefinition) Reset() {
+	*x = RoleDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDefinition) ProtoMessage() {}
+
+func (x *RoleDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDefinition.ProtoReflect.Descriptor instead.
+func (*RoleDefinition) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RoleDefinition) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleDefinition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoleDefinition) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *RoleDefinition) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *RoleDefinition) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+type RoleDefinitionMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token          *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RoleDefinition *RoleDefinition       `protobuf:"bytes,2,opt,name=role_definition,json=roleDefinition,proto3" json:"role_definition,omitempty"`
+}
+
+func (x *RoleDefinitionMsg) Reset() {
+	*x = RoleDefinitionMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDefinitionMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDefinitionMsg) ProtoMessage() {}
+
+func (x *RoleDefinitionMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDefinitionMsg.ProtoReflect.Descriptor instead.
+func (*RoleDefinitionMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RoleDefinitionMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *RoleDefinitionMsg) GetRoleDefinition() *RoleDefinition {
+	if x != nil {
+		return x.RoleDefinition
+	}
+	return nil
+}
+
+type GetRoleDefinitionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GetRoleDefinitionsReq) Reset() {
+	*x = GetRoleDefinitionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRoleDefinitionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoleDefinitionsReq) ProtoMessage() {}
+
+func (x *GetRoleDefinitionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoleDefinitionsReq.ProtoReflect.Descriptor instead.
+func (*GetRoleDefinitionsReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetRoleDefinitionsReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RoleDefinitionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleDefinitions      []*RoleDefinition `protobuf:"bytes,1,rep,name=role_definitions,json=roleDefinitions,proto3" json:"role_definitions,omitempty"`
+	AvailablePermissions []string          `protobuf:"bytes,2,rep,name=available_permissions,json=availablePermissions,proto3" json:"available_permissions,omitempty"`
+}
+
+func (x *RoleDefinitionList) Reset() {
+	*x = RoleDefinitionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleDefinitionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDefinitionList) ProtoMessage() {}
+
+func (x *RoleDefinitionList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDefinitionList.ProtoReflect.Descriptor instead.
+func (*RoleDefinitionList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RoleDefinitionList) GetRoleDefinitions() []*RoleDefinition {
+	if x != nil {
+		return x.RoleDefinitions
+	}
+	return nil
+}
+
+func (x *RoleDefinitionList) GetAvailablePermissions() []string {
+	if x != nil {
+		return x.AvailablePermissions
+	}
+	return nil
+}
+
+type PasswordPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLength      int32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`                  // at least 8
+	MinCharClasses int32 `protobuf:"varint,2,opt,name=min_char_classes,json=minCharClasses,proto3" json:"min_char_classes,omitempty"` // lowercase, uppercase, digits, symbols, at least 3
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PasswordPolicy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetMinCharClasses() int32 {
+	if x != nil {
+		return x.MinCharClasses
+	}
+	return 0
+}
+
+type TokenLifetimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken      int64 `protobuf:"varint,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`                // in minutes, 0 for the service default
+	VerificationCode int64 `protobuf:"varint,2,opt,name=verification_code,json=verificationCode,proto3" json:"verification_code,omitempty"` // in seconds, 0 for the service default
+	Invitation       int64 `protobuf:"varint,3,opt,name=invitation,proto3" json:"invitation,omitempty"`                                     // in seconds, 0 for the service default
+}
+
+func (x *TokenLifetimes) Reset() {
+	*x = TokenLifetimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenLifetimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLifetimes) ProtoMessage() {}
+
+func (x *TokenLifetimes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenLifetimes.ProtoReflect.Descriptor instead.
+func (*TokenLifetimes) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TokenLifetimes) GetAccessToken() int64 {
+	if x != nil {
+		return x.AccessToken
+	}
+	return 0
+}
+
+func (x *TokenLifetimes) GetVerificationCode() int64 {
+	if x != nil {
+		return x.VerificationCode
+	}
+	return 0
+}
+
+func (x *TokenLifetimes) GetInvitation() int64 {
+	if x != nil {
+		return x.Invitation
+	}
+	return 0
+}
+
+type InstanceSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Languages                   []string        `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"` // empty for any
+	SignupClosed                bool            `protobuf:"varint,2,opt,name=signup_closed,json=signupClosed,proto3" json:"signup_closed,omitempty"`
+	PasswordPolicy              *PasswordPolicy `protobuf:"bytes,3,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
+	TokenLifetimes              *TokenLifetimes `protobuf:"bytes,4,opt,name=token_lifetimes,json=tokenLifetimes,proto3" json:"token_lifetimes,omitempty"`
+	NewUserCountLimit           int64           `protobuf:"varint,5,opt,name=new_user_count_limit,json=newUserCountLimit,proto3" json:"new_user_count_limit,omitempty"`                                 // signups allowed within 5 minutes, 0 for the service default
+	CleanUpUnverifiedUsersAfter int64           `protobuf:"varint,6,opt,name=clean_up_unverified_users_after,json=cleanUpUnverifiedUsersAfter,proto3" json:"clean_up_unverified_users_after,omitempty"` // in seconds, 0 for the service default
+}
+
+func (x *InstanceSettings) Reset() {
+	*x = InstanceSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceSettings) ProtoMessage() {}
+
+func (x *InstanceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceSettings.ProtoReflect.Descriptor instead.
+func (*InstanceSettings) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *InstanceSettings) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *InstanceSettings) GetSignupClosed() bool {
+	if x != nil {
+		return x.SignupClosed
+	}
+	return false
+}
+
+func (x *InstanceSettings) GetPasswordPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *InstanceSettings) GetTokenLifetimes() *TokenLifetimes {
+	if x != nil {
+		return x.TokenLifetimes
+	}
+	return nil
+}
+
+func (x *InstanceSettings) GetNewUserCountLimit() int64 {
+	if x != nil {
+		return x.NewUserCountLimit
+	}
+	return 0
+}
+
+func (x *InstanceSettings) GetCleanUpUnverifiedUsersAfter() int64 {
+	if x != nil {
+		return x.CleanUpUnverifiedUsersAfter
+	}
+	return 0
+}
+
+type Instance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string            `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name       string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Settings   *InstanceSettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	CreatedAt  int64             `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt int64             `protobuf:"varint,5,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	UserCount  int64             `protobuf:"varint,6,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+}
+
+func (x *Instance) Reset() {
+	*x = Instance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instance) ProtoMessage() {}
+
+func (x *Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instance.ProtoReflect.Descriptor instead.
+func (*Instance) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Instance) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *Instance) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instance) GetSettings() *InstanceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Instance) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Instance) GetArchivedAt() int64 {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return 0
+}
+
+func (x *Instance) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+type InstanceMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	InstanceId string                `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Name       string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Settings   *InstanceSettings     `protobuf:"bytes,4,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *InstanceMsg) Reset() {
+	*x = InstanceMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceMsg) ProtoMessage() {}
+
+func (x *InstanceMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceMsg.ProtoReflect.Descriptor instead.
+func (*InstanceMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *InstanceMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *InstanceMsg) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *InstanceMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstanceMsg) GetSettings() *InstanceSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetInstancesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token           *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	IncludeArchived bool                  `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+}
+
+func (x *GetInstancesReq) Reset() {
+	*x = GetInstancesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstancesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstancesReq) ProtoMessage() {}
+
+func (x *GetInstancesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstancesReq.ProtoReflect.Descriptor instead.
+func (*GetInstancesReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetInstancesReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GetInstancesReq) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type InstanceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *InstanceList) Reset() {
+	*x = InstanceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstanceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstanceList) ProtoMessage() {}
+
+func (x *InstanceList) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstanceList.ProtoReflect.Descriptor instead.
+func (*InstanceList) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InstanceList) GetInstances() []*Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type DeliveryEventMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email   string                `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Event   string                `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"` // hard-bounce, soft-bounce or complaint
+	Details string                `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+	Token   *api_types.TokenInfos `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"` // needs the permission delivery.report
+}
+
+func (x *DeliveryEventMsg) Reset() {
+	*x = DeliveryEventMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryEventMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryEventMsg) ProtoMessage() {}
+
+func (x *DeliveryEventMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryEventMsg.ProtoReflect.Descriptor instead.
+func (*DeliveryEventMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeliveryEventMsg) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DeliveryEventMsg) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *DeliveryEventMsg) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *DeliveryEventMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type UnsubscribeTokensReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceId string   `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Topic      string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	UserIds    []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *UnsubscribeTokensReq) Reset() {
+	*x = UnsubscribeTokensReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeTokensReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeTokensReq) ProtoMessage() {}
+
+func (x *UnsubscribeTokensReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeTokensReq.ProtoReflect.Descriptor instead.
+func (*UnsubscribeTokensReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UnsubscribeTokensReq) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *UnsubscribeTokensReq) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *UnsubscribeTokensReq) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type UnsubscribeTokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens map[string]string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // user id -> token
+}
+
+func (x *UnsubscribeTokens) Reset() {
+	*x = UnsubscribeTokens{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeTokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeTokens) ProtoMessage() {}
+
+func (x *UnsubscribeTokens) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeTokens.ProtoReflect.Descriptor instead.
+func (*UnsubscribeTokens) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UnsubscribeTokens) GetTokens() map[string]string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type FindNonParticipantUsersMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *FindNonParticipantUsersMsg) Reset() {
+	*x = FindNonParticipantUsersMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindNonParticipantUsersMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNonParticipantUsersMsg) ProtoMessage() {}
+
+func (x *FindNonParticipantUsersMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNonParticipantUsersMsg.ProtoReflect.Descriptor instead.
+func (*FindNonParticipantUsersMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *FindNonParticipantUsersMsg) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type SearchUsersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token             *api_types.TokenInfos `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AccountIdPrefix   string                `protobuf:"bytes,2,opt,name=account_id_prefix,json=accountIdPrefix,proto3" json:"account_id_prefix,omitempty"`
+	Roles             []string              `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`                                                  // users with any of these roles
+	ConfirmationState string                `protobuf:"bytes,4,opt,name=confirmation_state,json=confirmationState,proto3" json:"confirmation_state,omitempty"` // "confirmed", "unconfirmed" or empty for any
+	CreatedAfter      int64                 `protobuf:"varint,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore     int64                 `protobuf:"varint,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	LastLoginAfter    int64                 `protobuf:"varint,7,opt,name=last_login_after,json=lastLoginAfter,proto3" json:"last_login_after,omitempty"`
+	LastLoginBefore   int64                 `protobuf:"varint,8,opt,name=last_login_before,json=lastLoginBefore,proto3" json:"last_login_before,omitempty"`
+	SortBy            string                `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // "accountId" (default), "createdAt" or "lastLogin"
+	SortDescending    bool                  `protobuf:"varint,10,opt,name=sort_descending,json=sortDescending,proto3" json:"sort_descending,omitempty"`
+	PageSize          int32                 `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor            string                `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor of the previous page
+}
+
+func (x *SearchUsersReq) Reset() {
+	*x = SearchUsersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersReq) ProtoMessage() {}
+
+func (x *SearchUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersReq.ProtoReflect.Descriptor instead.
+func (*SearchUsersReq) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SearchUsersReq) GetToken() *api_types.TokenInfos {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *SearchUsersReq) GetAccountIdPrefix() string {
+	if x != nil {
+		return x.AccountIdPrefix
+	}
+	return ""
+}
+
+func (x *SearchUsersReq) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SearchUsersReq) GetConfirmationState() string {
+	if x != nil {
+		return x.ConfirmationState
+	}
+	return ""
+}
+
+func (x *SearchUsersReq) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetLastLoginAfter() int64 {
+	if x != nil {
+		return x.LastLoginAfter
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetLastLoginBefore() int64 {
+	if x != nil {
+		return x.LastLoginBefore
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SearchUsersReq) GetSortDescending() bool {
+	if x != nil {
+		return x.SortDescending
+	}
+	return false
+}
+
+func (x *SearchUsersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchUsersReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users      []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalCount int64   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextCursor string  `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type UserListMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *UserListMsg) Reset() {
+	*x = UserListMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserListMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserListMsg) ProtoMessage() {}
+
+func (x *UserListMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserListMsg.ProtoReflect.Descriptor instead.
+func (*UserListMsg) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UserListMsg) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type TempToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *TempToken) Reset() {
+	*x = TempToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TempToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TempToken) ProtoMessage() {}
+
+func (x *TempToken) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TempToken.ProtoReflect.Descriptor instead.
+func (*TempToken) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *TempToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type TokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken       string     `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken      string     `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn         int32      `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	Profiles          []*Profile `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	SelectedProfileId string     `protobuf:"bytes,5,opt,name=selected_profile_id,json=selectedProfileId,proto3" json:"selected_profile_id,omitempty"`
+	PreferredLanguage string     `protobuf:"bytes,6,opt,name=preferred_language,json=preferredLanguage,proto3" json:"preferred_language,omitempty"`
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *TokenResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *TokenResponse) GetSelectedProfileId() string {
+	if x != nil {
+		return x.SelectedProfileId
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetPreferredLanguage() string {
+	if x != nil {
+		return x.PreferredLanguage
+	}
+	return ""
+}
+
+type ImportUsersReport_RowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row    int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // "created", "new" (dry run), "exists" or "invalid"
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	UserId string `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ImportUsersReport_RowResult) Reset() {
+	*x = ImportUsersReport_RowResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUsersReport_RowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersReport_RowResult) ProtoMessage() {}
+
+func (x *ImportUsersReport_RowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersReport_RowResult.ProtoReflect.Descriptor instead.
+func (*ImportUsersReport_RowResult) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{38, 0}
+}
+
+func (x *ImportUsersReport_RowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportUsersReport_RowResult) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportUsersReport_RowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportUsersReport_RowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportUsersReport_RowResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StreamUsersMsg_Filters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UseReminderWeekdayFilter bool     `protobuf:"varint,1,opt,name=use_reminder_weekday_filter,json=useReminderWeekdayFilter,proto3" json:"use_reminder_weekday_filter,omitempty"`
+	ReminderWeekday          int32    `protobuf:"varint,2,opt,name=reminder_weekday,json=reminderWeekday,proto3" json:"reminder_weekday,omitempty"`
+	OnlyConfirmedAccounts    bool     `protobuf:"varint,3,opt,name=only_confirmed_accounts,json=onlyConfirmedAccounts,proto3" json:"only_confirmed_accounts,omitempty"`
+	SubscribedToTopic        string   `protobuf:"bytes,4,opt,name=subscribed_to_topic,json=subscribedToTopic,proto3" json:"subscribed_to_topic,omitempty"`
+	OnlyDeliverableContacts  bool     `protobuf:"varint,5,opt,name=only_deliverable_contacts,json=onlyDeliverableContacts,proto3" json:"only_deliverable_contacts,omitempty"`
+	Roles                    []string `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"` // any of these
+	PreferredLanguages       []string `protobuf:"bytes,7,rep,name=preferred_languages,json=preferredLanguages,proto3" json:"preferred_languages,omitempty"`
+	CreatedAfter             int64    `protobuf:"varint,8,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore            int64    `protobuf:"varint,9,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	LastLoginAfter           int64    `protobuf:"varint,10,opt,name=last_login_after,json=lastLoginAfter,proto3" json:"last_login_after,omitempty"`
+	LastLoginBefore          int64    `protobuf:"varint,11,opt,name=last_login_before,json=lastLoginBefore,proto3" json:"last_login_before,omitempty"`
+	SubscribedToAnyTopic     []string `protobuf:"bytes,12,rep,name=subscribed_to_any_topic,json=subscribedToAnyTopic,proto3" json:"subscribed_to_any_topic,omitempty"`
+	AccountType              string   `protobuf:"bytes,13,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	MinProfileCount          int32    `protobuf:"varint,14,opt,name=min_profile_count,json=minProfileCount,proto3" json:"min_profile_count,omitempty"`
+	MaxProfileCount          int32    `protobuf:"varint,15,opt,name=max_profile_count,json=maxProfileCount,proto3" json:"max_profile_count,omitempty"` // 0 for no limit
+}
+
+func (x *StreamUsersMsg_Filters) Reset() {
+	*x = StreamUsersMsg_Filters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_management_user_management_service_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamUsersMsg_Filters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamUsersMsg_Filters) ProtoMessage() {}
+
+func (x *StreamUsersMsg_Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_user_management_user_management_service_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamUsersMsg_Filters.ProtoReflect.Descriptor instead.
+func (*StreamUsersMsg_Filters) Descriptor() ([]byte, []int) {
+	return file_user_management_user_management_service_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *StreamUsersMsg_Filters) GetUseReminderWeekdayFilter() bool {
+	if x != nil {
+		return x.UseReminderWeekdayFilter
+	}
+	return false
+}
+
+func (x *StreamUsersMsg_Filters) GetReminderWeekday() int32 {
+	if x != nil {
+		return x.ReminderWeekday
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetOnlyConfirmedAccounts() bool {
+	if x != nil {
+		return x.OnlyConfirmedAccounts
+	}
+	return false
+}
+
+func (x *StreamUsersMsg_Filters) GetSubscribedToTopic() string {
+	if x != nil {
+		return x.SubscribedToTopic
+	}
+	return ""
+}
+
+func (x *StreamUsersMsg_Filters) GetOnlyDeliverableContacts() bool {
+	if x != nil {
+		return x.OnlyDeliverableContacts
+	}
+	return false
+}
+
+func (x *StreamUsersMsg_Filters) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *StreamUsersMsg_Filters) GetPreferredLanguages() []string {
+	if x != nil {
+		return x.PreferredLanguages
+	}
+	return nil
+}
+
+func (x *StreamUsersMsg_Filters) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetLastLoginAfter() int64 {
+	if x != nil {
+		return x.LastLoginAfter
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetLastLoginBefore() int64 {
+	if x != nil {
+		return x.LastLoginBefore
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetSubscribedToAnyTopic() []string {
+	if x != nil {
+		return x.SubscribedToAnyTopic
+	}
+	return nil
+}
+
+func (x *StreamUsersMsg_Filters) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *StreamUsersMsg_Filters) GetMinProfileCount() int32 {
+	if x != nil {
+		return x.MinProfileCount
+	}
+	return 0
+}
+
+func (x *StreamUsersMsg_Filters) GetMaxProfileCount() int32 {
+	if x != nil {
+		return x.MaxProfileCount
+	}
+	return 0
+}
+
+var File_user_management_user_management_service_proto protoreflect.FileDescriptor
+
+var file_user_management_user_management_service_proto_rawDesc = []byte{
+	0x0a, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x20, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x1a, 0x1a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x42, 0x4c, 0x45, 0x4d, 0x10, 0x01, 0x22, 0xda, 0x01,
+	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6e, 0x74, 0x73, 0x5f,
+	0x6e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x77, 0x61, 0x6e, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x73, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x32, 0x66, 0x61, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x73, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x50,
+	0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x53, 0x0a, 0x0f, 0x41, 0x75,
+	0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xa5, 0x01, 0x0a, 0x14, 0x41, 0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x6d, 0x65, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x53, 0x61,
+	0x6d, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x6c, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69,
+	0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x4e, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x73,
+	0x22, 0x80, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x70,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x71, 0x0a,
+	0x0e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x47, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08,
+	0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x71, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x09, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x73,
+	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x74, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x6c, 0x64,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5a, 0x0a, 0x18, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x12,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x50, 0x57, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x4b, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa6,
+	0x01, 0x0a, 0x0e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6f, 0x6c,
+	0x64, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b,
+	0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73,
+	0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6b, 0x65, 0x65, 0x70, 0x4f, 0x6c, 0x64, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x6f, 0x0a, 0x11, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4d, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x0a,
+	0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc0, 0x01,
+	0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f,
+	0x77, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x81, 0x02, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73,
+	0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x43, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x52, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x22, 0x9c, 0x03, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x57,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x7a, 0x0a, 0x09, 0x52, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x71, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa0, 0x02, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x60, 0x0a, 0x0e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe4, 0x02, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x35,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f, 0x32, 0x66, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x32, 0x66, 0x61, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0xae, 0x01, 0x0a, 0x10, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
+	0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x7b, 0x0a, 0x12, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x1a, 0x43, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73,
+	0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x22,
+	0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x54, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x68, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x65,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67,
+	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x7c, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x93, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x6b, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x61,
+	0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x88, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x51, 0x0a, 0x0a, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x72,
+	0x0a, 0x09, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x49, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x22, 0x92, 0x07, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x69, 0x7a, 0x65, 0x1a, 0xb2, 0x05, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x3d, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x75, 0x73, 0x65, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x64,
+	0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6e, 0x6c,
+	0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6f, 0x6e, 0x6c, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x5f, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x64, 0x54, 0x6f, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6f, 0x6e, 0x6c, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x12, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x64, 0x54, 0x6f, 0x41, 0x6e, 0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47,
+	0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x73, 0x67,
+	0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x49, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x22, 0x3b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x64, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6c,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x35,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x59, 0x0a, 0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xa6, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x0e, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69,
+	0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x03, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x69,
+	0x67, 0x6e, 0x75, 0x70, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12,
+	0x59, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x59, 0x0a, 0x0f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x66, 0x65,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x1f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f,
+	0x75, 0x70, 0x5f, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x1b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x55, 0x70, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xee, 0x01, 0x0a,
+	0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e,
+	0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc9, 0x01,
+	0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x58,
+	0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48,
+	0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0x68, 0x0a, 0x14, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x11, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x57, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x53, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d,
+	0x73, 0x67, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd1, 0x03, 0x0a, 0x0e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7d, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x33, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0x21, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x2d, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x32, 0xfa, 0x43, 0x0a, 0x11,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x70,
+	0x69, 0x12, 0x51, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x41, 0x75,
+	0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4d, 0x73, 0x67, 0x1a,
+	0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x50, 0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x50, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x75, 0x70, 0x57,
+	0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4d, 0x73, 0x67, 0x1a, 0x2f,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x57, 0x54, 0x12, 0x2c,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x70, 0x0a,
+	0x08, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x4a, 0x57, 0x54, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x4a, 0x57, 0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x83, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x38, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x7b, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65,
+	0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x2b, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x58,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
+	0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x6f, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6a, 0x0a, 0x13, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x33,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a,
+	0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x76, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x58, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73,
+	0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x68, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x14, 0x4c,
+	0x69, 0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x16, 0x55, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x71, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a, 0x17, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x15, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x3a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67,
+	0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x8f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x46, 0x6f,
+	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x3d,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x34, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x50, 0x57, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x74, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x53, 0x61, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x0d, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x73, 0x0a,
+	0x13, 0x55, 0x73, 0x65, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x73, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x7f, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x63, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e,
+	0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4c, 0x0a,
+	0x08, 0x41, 0x64, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0b, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69,
+	0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x08,
+	0x41, 0x64, 0x64, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0b, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x0b, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x8c, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x3a, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x76, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
+	0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73,
+	0x67, 0x1a, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x01, 0x12, 0x61, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x39, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x69, 0x6e,
+	0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x79, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x36,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x12, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x4d, 0x73, 0x67,
+	0x1a, 0x28, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x77, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x2c, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x12, 0x4d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x0e,
+	0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x7c,
+	0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x4d, 0x73, 0x67, 0x1a, 0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f,
+	0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x63, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x2b, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x74, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x77,
+	0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x76, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x67, 0x1a, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12,
+	0x4e, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x4d, 0x73, 0x67, 0x1a,
+	0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x51, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x4d,
+	0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x17, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3c,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x2d, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x76, 0x0a, 0x0b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x35, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x4d, 0x73, 0x67, 0x1a, 0x0e, 0x2e, 0x69, 0x6e, 0x66, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x12, 0x74, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x4d, 0x73, 0x67, 0x1a, 0x2b, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x84, 0x01, 0x0a,
+	0x15, 0x53, 0x61, 0x76, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x73, 0x67, 0x1a, 0x33,
+	0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x36, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x37, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x7b,
+	0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x7c, 0x0a, 0x14, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x2f, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x77, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x71, 0x0a, 0x0e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x30, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x6b, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x73, 0x67, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x70, 0x70,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x6b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x2a, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2d, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x2a, 0x2e, 0x69, 0x6e,
+	0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65,
+	0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x69, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0f, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d, 0x2e,
+	0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x2a, 0x2e, 0x69,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x7a, 0x61,
+	0x6e, 0x65, 0x74, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_user_management_user_management_service_proto_rawDescOnce sync.Once
+	file_user_management_user_management_service_proto_rawDescData = file_user_management_user_management_service_proto_rawDesc
+)
+
+func file_user_management_user_management_service_proto_rawDescGZIP() []byte {
+	file_user_management_user_management_service_proto_rawDescOnce.Do(func() {
+		file_user_management_user_management_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_user_management_user_management_service_proto_rawDescData)
+	})
+	return file_user_management_user_management_service_proto_rawDescData
+}
+
+var file_user_management_user_management_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_management_user_management_service_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_user_management_user_management_service_proto_goTypes = []interface{}{
+	(ServiceStatus_StatusValue)(0),       // 0: influenzanet.user_management_api.ServiceStatus.StatusValue
+	(*ServiceStatus)(nil),                // 1: influenzanet.user_management_api.ServiceStatus
+	(*SignupWithEmailMsg)(nil),           // 2: influenzanet.user_management_api.SignupWithEmailMsg
+	(*LoginWithEmailMsg)(nil),            // 3: influenzanet.user_management_api.LoginWithEmailMsg
+	(*LoginWithExternalIDPMsg)(nil),      // 4: influenzanet.user_management_api.LoginWithExternalIDPMsg
+	(*LinkedIdentityMsg)(nil),            // 5: influenzanet.user_management_api.LinkedIdentityMsg
+	(*AutoValidateReq)(nil),              // 6: influenzanet.user_management_api.AutoValidateReq
+	(*AutoValidateResponse)(nil),         // 7: influenzanet.user_management_api.AutoValidateResponse
+	(*SendVerificationCodeReq)(nil),      // 8: influenzanet.user_management_api.SendVerificationCodeReq
+	(*LoginResponse)(nil),                // 9: influenzanet.user_management_api.LoginResponse
+	(*UserReference)(nil),                // 10: influenzanet.user_management_api.UserReference
+	(*RevokeRefreshTokensReq)(nil),       // 11: influenzanet.user_management_api.RevokeRefreshTokensReq
+	(*RefreshTokenRequest)(nil),          // 12: influenzanet.user_management_api.RefreshTokenRequest
+	(*AppTokenRequest)(nil),              // 13: influenzanet.user_management_api.AppTokenRequest
+	(*AppTokenValidation)(nil),           // 14: influenzanet.user_management_api.AppTokenValidation
+	(*AppToken)(nil),                     // 15: influenzanet.user_management_api.AppToken
+	(*CreateAppTokenReq)(nil),            // 16: influenzanet.user_management_api.CreateAppTokenReq
+	(*AppTokenSecret)(nil),               // 17: influenzanet.user_management_api.AppTokenSecret
+	(*GetAppTokensReq)(nil),              // 18: influenzanet.user_management_api.GetAppTokensReq
+	(*AppTokenList)(nil),                 // 19: influenzanet.user_management_api.AppTokenList
+	(*AppTokenMsg)(nil),                  // 20: influenzanet.user_management_api.AppTokenMsg
+	(*ProfileRequest)(nil),               // 21: influenzanet.user_management_api.ProfileRequest
+	(*UserAuthInfo)(nil),                 // 22: influenzanet.user_management_api.UserAuthInfo
+	(*ResendContactVerificationReq)(nil), // 23: influenzanet.user_management_api.ResendContactVerificationReq
+	(*PasswordChangeMsg)(nil),            // 24: influenzanet.user_management_api.PasswordChangeMsg
+	(*InitiateResetPasswordMsg)(nil),     // 25: influenzanet.user_management_api.InitiateResetPasswordMsg
+	(*GetInfosForResetPasswordMsg)(nil),  // 26: influenzanet.user_management_api.GetInfosForResetPasswordMsg
+	(*UserInfoForPWReset)(nil),           // 27: influenzanet.user_management_api.UserInfoForPWReset
+	(*ResetPasswordMsg)(nil),             // 28: influenzanet.user_management_api.ResetPasswordMsg
+	(*EmailChangeMsg)(nil),               // 29: influenzanet.user_management_api.EmailChangeMsg
+	(*PromoteContactInfoMsg)(nil),        // 30: influenzanet.user_management_api.PromoteContactInfoMsg
+	(*LanguageChangeMsg)(nil),            // 31: influenzanet.user_management_api.LanguageChangeMsg
+	(*ContactPreferencesMsg)(nil),        // 32: influenzanet.user_management_api.ContactPreferencesMsg
+	(*ContactInfoMsg)(nil),               // 33: influenzanet.user_management_api.ContactInfoMsg
+	(*PhoneVerificationMsg)(nil),         // 34: influenzanet.user_management_api.PhoneVerificationMsg
+	(*JWTRequest)(nil),                   // 35: influenzanet.user_management_api.JWTRequest
+	(*RefreshJWTRequest)(nil),            // 36: influenzanet.user_management_api.RefreshJWTRequest
+	(*ImportUserRow)(nil),                // 37: influenzanet.user_management_api.ImportUserRow
+	(*ImportUsersMsg)(nil),               // 38: influenzanet.user_management_api.ImportUsersMsg
+	(*ImportUsersReport)(nil),            // 39: influenzanet.user_management_api.ImportUsersReport
+	(*GetInvitationsReq)(nil),            // 40: influenzanet.user_management_api.GetInvitationsReq
+	(*InvitationMsg)(nil),                // 41: influenzanet.user_management_api.InvitationMsg
+	(*Invitation)(nil),                   // 42: influenzanet.user_management_api.Invitation
+	(*InvitationList)(nil),               // 43: influenzanet.user_management_api.InvitationList
+	(*CreateUserReq)(nil),                // 44: influenzanet.user_management_api.CreateUserReq
+	(*MoveUserMsg)(nil),                  // 45: influenzanet.user_management_api.MoveUserMsg
+	(*MergeAccountsMsg)(nil),             // 46: influenzanet.user_management_api.MergeAccountsMsg
+	(*MergeAccountsResponse)(nil),        // 47: influenzanet.user_management_api.MergeAccountsResponse
+	(*RoleMsg)(nil),                      // 48: influenzanet.user_management_api.RoleMsg
+	(*RoleGrant)(nil),                    // 49: influenzanet.user_management_api.RoleGrant
+	(*GetRoleGrantsReq)(nil),             // 50: influenzanet.user_management_api.GetRoleGrantsReq
+	(*RoleGrantList)(nil),                // 51: influenzanet.user_management_api.RoleGrantList
+	(*RoleGrantDecisionMsg)(nil),         // 52: influenzanet.user_management_api.RoleGrantDecisionMsg
+	(*ImpersonateUserReq)(nil),           // 53: influenzanet.user_management_api.ImpersonateUserReq
+	(*CreateServiceAccountReq)(nil),      // 54: influenzanet.user_management_api.CreateServiceAccountReq
+	(*APIKey)(nil),                       // 55: influenzanet.user_management_api.APIKey
+	(*CreateAPIKeyReq)(nil),              // 56: influenzanet.user_management_api.CreateAPIKeyReq
+	(*CreateAPIKeyResponse)(nil),         // 57: influenzanet.user_management_api.CreateAPIKeyResponse
+	(*GetAPIKeysReq)(nil),                // 58: influenzanet.user_management_api.GetAPIKeysReq
+	(*APIKeyList)(nil),                   // 59: influenzanet.user_management_api.APIKeyList
+	(*APIKeyMsg)(nil),                    // 60: influenzanet.user_management_api.APIKeyMsg
+	(*LoginWithAPIKeyMsg)(nil),           // 61: influenzanet.user_management_api.LoginWithAPIKeyMsg
+	(*RoleScopeMsg)(nil),                 // 62: influenzanet.user_management_api.RoleScopeMsg
+	(*StreamUsersMsg)(nil),               // 63: influenzanet.user_management_api.StreamUsersMsg
+	(*UserBatch)(nil),                    // 64: influenzanet.user_management_api.UserBatch
+	(*SubscriptionTopic)(nil),            // 65: influenzanet.user_management_api.SubscriptionTopic
+	(*SubscriptionTopicMsg)(nil),         // 66: influenzanet.user_management_api.SubscriptionTopicMsg
+	(*GetSubscriptionTopicsReq)(nil),     // 67: influenzanet.user_management_api.GetSubscriptionTopicsReq
+	(*SubscriptionTopicList)(nil),        // 68: influenzanet.user_management_api.SubscriptionTopicList
+	(*RoleDefinition)(nil),               // 69: influenzanet.user_management_api.RoleDefinition
+	(*RoleDefinitionMsg)(nil),            // 70: influenzanet.user_management_api.RoleDefinitionMsg
+	(*GetRoleDefinitionsReq)(nil),        // 71: influenzanet.user_management_api.GetRoleDefinitionsReq
+	(*RoleDefinitionList)(nil),           // 72: influenzanet.user_management_api.RoleDefinitionList
+	(*PasswordPolicy)(nil),               // 73: influenzanet.user_management_api.PasswordPolicy
+	(*TokenLifetimes)(nil),               // 74: influenzanet.user_management_api.TokenLifetimes
+	(*InstanceSettings)(nil),             // 75: influenzanet.user_management_api.InstanceSettings
+	(*Instance)(nil),                     // 76: influenzanet.user_management_api.Instance
+	(*InstanceMsg)(nil),                  // 77: influenzanet.user_management_api.InstanceMsg
+	(*GetInstancesReq)(nil),              // 78: influenzanet.user_management_api.GetInstancesReq
+	(*InstanceList)(nil),                 // 79: influenzanet.user_management_api.InstanceList
+	(*DeliveryEventMsg)(nil),             // 80: influenzanet.user_management_api.DeliveryEventMsg
+	(*UnsubscribeTokensReq)(nil),         // 81: influenzanet.user_management_api.UnsubscribeTokensReq
+	(*UnsubscribeTokens)(nil),            // 82: influenzanet.user_management_api.UnsubscribeTokens
+	(*FindNonParticipantUsersMsg)(nil),   // 83: influenzanet.user_management_api.FindNonParticipantUsersMsg
+	(*SearchUsersReq)(nil),               // 84: influenzanet.user_management_api.SearchUsersReq
+	(*SearchUsersResponse)(nil),          // 85: influenzanet.user_management_api.SearchUsersResponse
+	(*UserListMsg)(nil),                  // 86: influenzanet.user_management_api.UserListMsg
+	(*TempToken)(nil),                    // 87: influenzanet.user_management_api.TempToken
+	(*TokenResponse)(nil),                // 88: influenzanet.user_management_api.TokenResponse
+	(*ImportUsersReport_RowResult)(nil),  // 89: influenzanet.user_management_api.ImportUsersReport.RowResult
+	nil,                                  // 90: influenzanet.user_management_api.MergeAccountsResponse.ProfileIdMappingEntry
+	(*StreamUsersMsg_Filters)(nil),       // 91: influenzanet.user_management_api.StreamUsersMsg.Filters
+	nil,                                  // 92: influenzanet.user_management_api.UnsubscribeTokens.TokensEntry
+	(*api_types.TokenInfos)(nil),         // 93: influenzanet.shared.TokenInfos
+	(*User)(nil),                         // 94: inf.user.User
+	(*Profile)(nil),                      // 95: inf.user.Profile
+	(*ContactPreferences)(nil),           // 96: inf.user.ContactPreferences
+	(*ContactInfo)(nil),                  // 97: inf.user.ContactInfo
+	(*emptypb.Empty)(nil),                // 98: google.protobuf.Empty
+	(*api_types.TempTokenInfo)(nil),      // 99: influenzanet.shared.TempTokenInfo
+	(*api_types.TempTokenInfos)(nil),     // 100: influenzanet.shared.TempTokenInfos
+}
+var file_user_management_user_management_service_proto_depIdxs = []int32{
+	0,   // 0: influenzanet.user_management_api.ServiceStatus.status:type_name -> influenzanet.user_management_api.ServiceStatus.StatusValue
+	93,  // 1: influenzanet.user_management_api.LinkedIdentityMsg.token:type_name -> influenzanet.shared.TokenInfos
+	88,  // 2: influenzanet.user_management_api.LoginResponse.token:type_name -> influenzanet.user_management_api.TokenResponse
+	94,  // 3: influenzanet.user_management_api.LoginResponse.user:type_name -> inf.user.User
+	93,  // 4: influenzanet.user_management_api.UserReference.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 5: influenzanet.user_management_api.RevokeRefreshTokensReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 6: influenzanet.user_management_api.CreateAppTokenReq.token:type_name -> influenzanet.shared.TokenInfos
+	15,  // 7: influenzanet.user_management_api.AppTokenSecret.app_token:type_name -> influenzanet.user_management_api.AppToken
+	93,  // 8: influenzanet.user_management_api.GetAppTokensReq.token:type_name -> influenzanet.shared.TokenInfos
+	15,  // 9: influenzanet.user_management_api.AppTokenList.app_tokens:type_name -> influenzanet.user_management_api.AppToken
+	93,  // 10: influenzanet.user_management_api.AppTokenMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 11: influenzanet.user_management_api.ProfileRequest.token:type_name -> influenzanet.shared.TokenInfos
+	95,  // 12: influenzanet.user_management_api.ProfileRequest.profile:type_name -> inf.user.Profile
+	95,  // 13: influenzanet.user_management_api.UserAuthInfo.profiles:type_name -> inf.user.Profile
+	95,  // 14: influenzanet.user_management_api.UserAuthInfo.selected_profile:type_name -> inf.user.Profile
+	93,  // 15: influenzanet.user_management_api.ResendContactVerificationReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 16: influenzanet.user_management_api.PasswordChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 17: influenzanet.user_management_api.EmailChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 18: influenzanet.user_management_api.PromoteContactInfoMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 19: influenzanet.user_management_api.LanguageChangeMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 20: influenzanet.user_management_api.ContactPreferencesMsg.token:type_name -> influenzanet.shared.TokenInfos
+	96,  // 21: influenzanet.user_management_api.ContactPreferencesMsg.contact_preferences:type_name -> inf.user.ContactPreferences
+	93,  // 22: influenzanet.user_management_api.ContactInfoMsg.token:type_name -> influenzanet.shared.TokenInfos
+	97,  // 23: influenzanet.user_management_api.ContactInfoMsg.contact_info:type_name -> inf.user.ContactInfo
+	93,  // 24: influenzanet.user_management_api.PhoneVerificationMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 25: influenzanet.user_management_api.ImportUsersMsg.token:type_name -> influenzanet.shared.TokenInfos
+	37,  // 26: influenzanet.user_management_api.ImportUsersMsg.rows:type_name -> influenzanet.user_management_api.ImportUserRow
+	89,  // 27: influenzanet.user_management_api.ImportUsersReport.results:type_name -> influenzanet.user_management_api.ImportUsersReport.RowResult
+	93,  // 28: influenzanet.user_management_api.GetInvitationsReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 29: influenzanet.user_management_api.InvitationMsg.token:type_name -> influenzanet.shared.TokenInfos
+	42,  // 30: influenzanet.user_management_api.InvitationList.invitations:type_name -> influenzanet.user_management_api.Invitation
+	93,  // 31: influenzanet.user_management_api.CreateUserReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 32: influenzanet.user_management_api.MoveUserMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 33: influenzanet.user_management_api.MergeAccountsMsg.token:type_name -> influenzanet.shared.TokenInfos
+	94,  // 34: influenzanet.user_management_api.MergeAccountsResponse.user:type_name -> inf.user.User
+	90,  // 35: influenzanet.user_management_api.MergeAccountsResponse.profile_id_mapping:type_name -> influenzanet.user_management_api.MergeAccountsResponse.ProfileIdMappingEntry
+	93,  // 36: influenzanet.user_management_api.RoleMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 37: influenzanet.user_management_api.GetRoleGrantsReq.token:type_name -> influenzanet.shared.TokenInfos
+	49,  // 38: influenzanet.user_management_api.RoleGrantList.grants:type_name -> influenzanet.user_management_api.RoleGrant
+	93,  // 39: influenzanet.user_management_api.RoleGrantDecisionMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 40: influenzanet.user_management_api.ImpersonateUserReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 41: influenzanet.user_management_api.CreateServiceAccountReq.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 42: influenzanet.user_management_api.CreateAPIKeyReq.token:type_name -> influenzanet.shared.TokenInfos
+	55,  // 43: influenzanet.user_management_api.CreateAPIKeyResponse.api_key:type_name -> influenzanet.user_management_api.APIKey
+	93,  // 44: influenzanet.user_management_api.GetAPIKeysReq.token:type_name -> influenzanet.shared.TokenInfos
+	55,  // 45: influenzanet.user_management_api.APIKeyList.api_keys:type_name -> influenzanet.user_management_api.APIKey
+	93,  // 46: influenzanet.user_management_api.APIKeyMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 47: influenzanet.user_management_api.RoleScopeMsg.token:type_name -> influenzanet.shared.TokenInfos
+	91,  // 48: influenzanet.user_management_api.StreamUsersMsg.filters:type_name -> influenzanet.user_management_api.StreamUsersMsg.Filters
+	94,  // 49: influenzanet.user_management_api.UserBatch.users:type_name -> inf.user.User
+	93,  // 50: influenzanet.user_management_api.SubscriptionTopicMsg.token:type_name -> influenzanet.shared.TokenInfos
+	65,  // 51: influenzanet.user_management_api.SubscriptionTopicMsg.topic:type_name -> influenzanet.user_management_api.SubscriptionTopic
+	65,  // 52: influenzanet.user_management_api.SubscriptionTopicList.topics:type_name -> influenzanet.user_management_api.SubscriptionTopic
+	93,  // 53: influenzanet.user_management_api.RoleDefinitionMsg.token:type_name -> influenzanet.shared.TokenInfos
+	69,  // 54: influenzanet.user_management_api.RoleDefinitionMsg.role_definition:type_name -> influenzanet.user_management_api.RoleDefinition
+	93,  // 55: influenzanet.user_management_api.GetRoleDefinitionsReq.token:type_name -> influenzanet.shared.TokenInfos
+	69,  // 56: influenzanet.user_management_api.RoleDefinitionList.role_definitions:type_name -> influenzanet.user_management_api.RoleDefinition
+	73,  // 57: influenzanet.user_management_api.InstanceSettings.password_policy:type_name -> influenzanet.user_management_api.PasswordPolicy
+	74,  // 58: influenzanet.user_management_api.InstanceSettings.token_lifetimes:type_name -> influenzanet.user_management_api.TokenLifetimes
+	75,  // 59: influenzanet.user_management_api.Instance.settings:type_name -> influenzanet.user_management_api.InstanceSettings
+	93,  // 60: influenzanet.user_management_api.InstanceMsg.token:type_name -> influenzanet.shared.TokenInfos
+	75,  // 61: influenzanet.user_management_api.InstanceMsg.settings:type_name -> influenzanet.user_management_api.InstanceSettings
+	93,  // 62: influenzanet.user_management_api.GetInstancesReq.token:type_name -> influenzanet.shared.TokenInfos
+	76,  // 63: influenzanet.user_management_api.InstanceList.instances:type_name -> influenzanet.user_management_api.Instance
+	93,  // 64: influenzanet.user_management_api.DeliveryEventMsg.token:type_name -> influenzanet.shared.TokenInfos
+	92,  // 65: influenzanet.user_management_api.UnsubscribeTokens.tokens:type_name -> influenzanet.user_management_api.UnsubscribeTokens.TokensEntry
+	93,  // 66: influenzanet.user_management_api.FindNonParticipantUsersMsg.token:type_name -> influenzanet.shared.TokenInfos
+	93,  // 67: influenzanet.user_management_api.SearchUsersReq.token:type_name -> influenzanet.shared.TokenInfos
+	94,  // 68: influenzanet.user_management_api.SearchUsersResponse.users:type_name -> inf.user.User
+	94,  // 69: influenzanet.user_management_api.UserListMsg.users:type_name -> inf.user.User
+	95,  // 70: influenzanet.user_management_api.TokenResponse.profiles:type_name -> inf.user.Profile
+	98,  // 71: influenzanet.user_management_api.UserManagementApi.Status:input_type -> google.protobuf.Empty
+	8,   // 72: influenzanet.user_management_api.UserManagementApi.SendVerificationCode:input_type -> influenzanet.user_management_api.SendVerificationCodeReq
+	6,   // 73: influenzanet.user_management_api.UserManagementApi.AutoValidateTempToken:input_type -> influenzanet.user_management_api.AutoValidateReq
+	3,   // 74: influenzanet.user_management_api.UserManagementApi.LoginWithEmail:input_type -> influenzanet.user_management_api.LoginWithEmailMsg
+	61,  // 75: influenzanet.user_management_api.UserManagementApi.LoginWithAPIKey:input_type -> influenzanet.user_management_api.LoginWithAPIKeyMsg
+	4,   // 76: influenzanet.user_management_api.UserManagementApi.LoginWithExternalIDP:input_type -> influenzanet.user_management_api.LoginWithExternalIDPMsg
+	2,   // 77: influenzanet.user_management_api.UserManagementApi.SignupWithEmail:input_type -> influenzanet.user_management_api.SignupWithEmailMsg
+	35,  // 78: influenzanet.user_management_api.UserManagementApi.ValidateJWT:input_type -> influenzanet.user_management_api.JWTRequest
+	36,  // 79: influenzanet.user_management_api.UserManagementApi.RenewJWT:input_type -> influenzanet.user_management_api.RefreshJWTRequest
+	11,  // 80: influenzanet.user_management_api.UserManagementApi.RevokeAllRefreshTokens:input_type -> influenzanet.user_management_api.RevokeRefreshTokensReq
+	87,  // 81: influenzanet.user_management_api.UserManagementApi.VerifyContact:input_type -> influenzanet.user_management_api.TempToken
+	23,  // 82: influenzanet.user_management_api.UserManagementApi.ResendContactVerification:input_type -> influenzanet.user_management_api.ResendContactVerificationReq
+	13,  // 83: influenzanet.user_management_api.UserManagementApi.ValidateAppToken:input_type -> influenzanet.user_management_api.AppTokenRequest
+	99,  // 84: influenzanet.user_management_api.UserManagementApi.GetOrCreateTemptoken:input_type -> influenzanet.shared.TempTokenInfo
+	99,  // 85: influenzanet.user_management_api.UserManagementApi.GenerateTempToken:input_type -> influenzanet.shared.TempTokenInfo
+	99,  // 86: influenzanet.user_management_api.UserManagementApi.GetTempTokens:input_type -> influenzanet.shared.TempTokenInfo
+	87,  // 87: influenzanet.user_management_api.UserManagementApi.DeleteTempToken:input_type -> influenzanet.user_management_api.TempToken
+	99,  // 88: influenzanet.user_management_api.UserManagementApi.PurgeUserTempTokens:input_type -> influenzanet.shared.TempTokenInfo
+	81,  // 89: influenzanet.user_management_api.UserManagementApi.GetOrCreateUnsubscribeTokens:input_type -> influenzanet.user_management_api.UnsubscribeTokensReq
+	10,  // 90: influenzanet.user_management_api.UserManagementApi.GetUser:input_type -> influenzanet.user_management_api.UserReference
+	24,  // 91: influenzanet.user_management_api.UserManagementApi.ChangePassword:input_type -> influenzanet.user_management_api.PasswordChangeMsg
+	29,  // 92: influenzanet.user_management_api.UserManagementApi.ChangeAccountIDEmail:input_type -> influenzanet.user_management_api.EmailChangeMsg
+	30,  // 93: influenzanet.user_management_api.UserManagementApi.PromoteContactInfoToAccountID:input_type -> influenzanet.user_management_api.PromoteContactInfoMsg
+	5,   // 94: influenzanet.user_management_api.UserManagementApi.LinkExternalIdentity:input_type -> influenzanet.user_management_api.LinkedIdentityMsg
+	5,   // 95: influenzanet.user_management_api.UserManagementApi.UnlinkExternalIdentity:input_type -> influenzanet.user_management_api.LinkedIdentityMsg
+	10,  // 96: influenzanet.user_management_api.UserManagementApi.DeleteAccount:input_type -> influenzanet.user_management_api.UserReference
+	31,  // 97: influenzanet.user_management_api.UserManagementApi.ChangePreferredLanguage:input_type -> influenzanet.user_management_api.LanguageChangeMsg
+	25,  // 98: influenzanet.user_management_api.UserManagementApi.InitiatePasswordReset:input_type -> influenzanet.user_management_api.InitiateResetPasswordMsg
+	26,  // 99: influenzanet.user_management_api.UserManagementApi.GetInfosForPasswordReset:input_type -> influenzanet.user_management_api.GetInfosForResetPasswordMsg
+	28,  // 100: influenzanet.user_management_api.UserManagementApi.ResetPassword:input_type -> influenzanet.user_management_api.ResetPasswordMsg
+	21,  // 101: influenzanet.user_management_api.UserManagementApi.SaveProfile:input_type -> influenzanet.user_management_api.ProfileRequest
+	21,  // 102: influenzanet.user_management_api.UserManagementApi.RemoveProfile:input_type -> influenzanet.user_management_api.ProfileRequest
+	87,  // 103: influenzanet.user_management_api.UserManagementApi.UseUnsubscribeToken:input_type -> influenzanet.user_management_api.TempToken
+	87,  // 104: influenzanet.user_management_api.UserManagementApi.ConfirmSubscription:input_type -> influenzanet.user_management_api.TempToken
+	80,  // 105: influenzanet.user_management_api.UserManagementApi.ReportEmailDeliveryEvent:input_type -> influenzanet.user_management_api.DeliveryEventMsg
+	32,  // 106: influenzanet.user_management_api.UserManagementApi.UpdateContactPreferences:input_type -> influenzanet.user_management_api.ContactPreferencesMsg
+	33,  // 107: influenzanet.user_management_api.UserManagementApi.AddEmail:input_type -> influenzanet.user_management_api.ContactInfoMsg
+	33,  // 108: influenzanet.user_management_api.UserManagementApi.RemoveEmail:input_type -> influenzanet.user_management_api.ContactInfoMsg
+	33,  // 109: influenzanet.user_management_api.UserManagementApi.AddPhone:input_type -> influenzanet.user_management_api.ContactInfoMsg
+	33,  // 110: influenzanet.user_management_api.UserManagementApi.RemovePhone:input_type -> influenzanet.user_management_api.ContactInfoMsg
+	34,  // 111: influenzanet.user_management_api.UserManagementApi.VerifyPhone:input_type -> influenzanet.user_management_api.PhoneVerificationMsg
+	67,  // 112: influenzanet.user_management_api.UserManagementApi.GetSubscriptionTopics:input_type -> influenzanet.user_management_api.GetSubscriptionTopicsReq
+	44,  // 113: influenzanet.user_management_api.UserManagementApi.CreateUser:input_type -> influenzanet.user_management_api.CreateUserReq
+	38,  // 114: influenzanet.user_management_api.UserManagementApi.ImportUsers:input_type -> influenzanet.user_management_api.ImportUsersMsg
+	54,  // 115: influenzanet.user_management_api.UserManagementApi.CreateServiceAccount:input_type -> influenzanet.user_management_api.CreateServiceAccountReq
+	56,  // 116: influenzanet.user_management_api.UserManagementApi.CreateAPIKey:input_type -> influenzanet.user_management_api.CreateAPIKeyReq
+	58,  // 117: influenzanet.user_management_api.UserManagementApi.GetAPIKeys:input_type -> influenzanet.user_management_api.GetAPIKeysReq
+	60,  // 118: influenzanet.user_management_api.UserManagementApi.RevokeAPIKey:input_type -> influenzanet.user_management_api.APIKeyMsg
+	40,  // 119: influenzanet.user_management_api.UserManagementApi.GetInvitations:input_type -> influenzanet.user_management_api.GetInvitationsReq
+	41,  // 120: influenzanet.user_management_api.UserManagementApi.ResendInvitation:input_type -> influenzanet.user_management_api.InvitationMsg
+	41,  // 121: influenzanet.user_management_api.UserManagementApi.RevokeInvitation:input_type -> influenzanet.user_management_api.InvitationMsg
+	45,  // 122: influenzanet.user_management_api.UserManagementApi.MoveUserToInstance:input_type -> influenzanet.user_management_api.MoveUserMsg
+	46,  // 123: influenzanet.user_management_api.UserManagementApi.MergeAccounts:input_type -> influenzanet.user_management_api.MergeAccountsMsg
+	53,  // 124: influenzanet.user_management_api.UserManagementApi.ImpersonateUser:input_type -> influenzanet.user_management_api.ImpersonateUserReq
+	48,  // 125: influenzanet.user_management_api.UserManagementApi.AddRoleForUser:input_type -> influenzanet.user_management_api.RoleMsg
+	48,  // 126: influenzanet.user_management_api.UserManagementApi.RemoveRoleForUser:input_type -> influenzanet.user_management_api.RoleMsg
+	48,  // 127: influenzanet.user_management_api.UserManagementApi.GrantRole:input_type -> influenzanet.user_management_api.RoleMsg
+	50,  // 128: influenzanet.user_management_api.UserManagementApi.GetRoleGrants:input_type -> influenzanet.user_management_api.GetRoleGrantsReq
+	52,  // 129: influenzanet.user_management_api.UserManagementApi.ApproveRoleGrant:input_type -> influenzanet.user_management_api.RoleGrantDecisionMsg
+	52,  // 130: influenzanet.user_management_api.UserManagementApi.RejectRoleGrant:input_type -> influenzanet.user_management_api.RoleGrantDecisionMsg
+	62,  // 131: influenzanet.user_management_api.UserManagementApi.AddRoleScope:input_type -> influenzanet.user_management_api.RoleScopeMsg
+	62,  // 132: influenzanet.user_management_api.UserManagementApi.RemoveRoleScope:input_type -> influenzanet.user_management_api.RoleScopeMsg
+	83,  // 133: influenzanet.user_management_api.UserManagementApi.FindNonParticipantUsers:input_type -> influenzanet.user_management_api.FindNonParticipantUsersMsg
+	84,  // 134: influenzanet.user_management_api.UserManagementApi.SearchUsers:input_type -> influenzanet.user_management_api.SearchUsersReq
+	63,  // 135: influenzanet.user_management_api.UserManagementApi.StreamUsers:input_type -> influenzanet.user_management_api.StreamUsersMsg
+	63,  // 136: influenzanet.user_management_api.UserManagementApi.StreamUserBatches:input_type -> influenzanet.user_management_api.StreamUsersMsg
+	66,  // 137: influenzanet.user_management_api.UserManagementApi.SaveSubscriptionTopic:input_type -> influenzanet.user_management_api.SubscriptionTopicMsg
+	66,  // 138: influenzanet.user_management_api.UserManagementApi.RemoveSubscriptionTopic:input_type -> influenzanet.user_management_api.SubscriptionTopicMsg
+	71,  // 139: influenzanet.user_management_api.UserManagementApi.GetRoleDefinitions:input_type -> influenzanet.user_management_api.GetRoleDefinitionsReq
+	70,  // 140: influenzanet.user_management_api.UserManagementApi.SaveRoleDefinition:input_type -> influenzanet.user_management_api.RoleDefinitionMsg
+	70,  // 141: influenzanet.user_management_api.UserManagementApi.RemoveRoleDefinition:input_type -> influenzanet.user_management_api.RoleDefinitionMsg
+	16,  // 142: influenzanet.user_management_api.UserManagementApi.CreateAppToken:input_type -> influenzanet.user_management_api.CreateAppTokenReq
+	18,  // 143: influenzanet.user_management_api.UserManagementApi.GetAppTokens:input_type -> influenzanet.user_management_api.GetAppTokensReq
+	20,  // 144: influenzanet.user_management_api.UserManagementApi.RotateAppToken:input_type -> influenzanet.user_management_api.AppTokenMsg
+	20,  // 145: influenzanet.user_management_api.UserManagementApi.RevokeAppToken:input_type -> influenzanet.user_management_api.AppTokenMsg
+	77,  // 146: influenzanet.user_management_api.UserManagementApi.CreateInstance:input_type -> influenzanet.user_management_api.InstanceMsg
+	77,  // 147: influenzanet.user_management_api.UserManagementApi.UpdateInstanceSettings:input_type -> influenzanet.user_management_api.InstanceMsg
+	78,  // 148: influenzanet.user_management_api.UserManagementApi.GetInstances:input_type -> influenzanet.user_management_api.GetInstancesReq
+	77,  // 149: influenzanet.user_management_api.UserManagementApi.ArchiveInstance:input_type -> influenzanet.user_management_api.InstanceMsg
+	1,   // 150: influenzanet.user_management_api.UserManagementApi.Status:output_type -> influenzanet.user_management_api.ServiceStatus
+	1,   // 151: influenzanet.user_management_api.UserManagementApi.SendVerificationCode:output_type -> influenzanet.user_management_api.ServiceStatus
+	7,   // 152: influenzanet.user_management_api.UserManagementApi.AutoValidateTempToken:output_type -> influenzanet.user_management_api.AutoValidateResponse
+	9,   // 153: influenzanet.user_management_api.UserManagementApi.LoginWithEmail:output_type -> influenzanet.user_management_api.LoginResponse
+	88,  // 154: influenzanet.user_management_api.UserManagementApi.LoginWithAPIKey:output_type -> influenzanet.user_management_api.TokenResponse
+	9,   // 155: influenzanet.user_management_api.UserManagementApi.LoginWithExternalIDP:output_type -> influenzanet.user_management_api.LoginResponse
+	88,  // 156: influenzanet.user_management_api.UserManagementApi.SignupWithEmail:output_type -> influenzanet.user_management_api.TokenResponse
+	93,  // 157: influenzanet.user_management_api.UserManagementApi.ValidateJWT:output_type -> influenzanet.shared.TokenInfos
+	88,  // 158: influenzanet.user_management_api.UserManagementApi.RenewJWT:output_type -> influenzanet.user_management_api.TokenResponse
+	1,   // 159: influenzanet.user_management_api.UserManagementApi.RevokeAllRefreshTokens:output_type -> influenzanet.user_management_api.ServiceStatus
+	94,  // 160: influenzanet.user_management_api.UserManagementApi.VerifyContact:output_type -> inf.user.User
+	1,   // 161: influenzanet.user_management_api.UserManagementApi.ResendContactVerification:output_type -> influenzanet.user_management_api.ServiceStatus
+	14,  // 162: influenzanet.user_management_api.UserManagementApi.ValidateAppToken:output_type -> influenzanet.user_management_api.AppTokenValidation
+	87,  // 163: influenzanet.user_management_api.UserManagementApi.GetOrCreateTemptoken:output_type -> influenzanet.user_management_api.TempToken
+	87,  // 164: influenzanet.user_management_api.UserManagementApi.GenerateTempToken:output_type -> influenzanet.user_management_api.TempToken
+	100, // 165: influenzanet.user_management_api.UserManagementApi.GetTempTokens:output_type -> influenzanet.shared.TempTokenInfos
+	1,   // 166: influenzanet.user_management_api.UserManagementApi.DeleteTempToken:output_type -> influenzanet.user_management_api.ServiceStatus
+	1,   // 167: influenzanet.user_management_api.UserManagementApi.PurgeUserTempTokens:output_type -> influenzanet.user_management_api.ServiceStatus
+	82,  // 168: influenzanet.user_management_api.UserManagementApi.GetOrCreateUnsubscribeTokens:output_type -> influenzanet.user_management_api.UnsubscribeTokens
+	94,  // 169: influenzanet.user_management_api.UserManagementApi.GetUser:output_type -> inf.user.User
+	1,   // 170: influenzanet.user_management_api.UserManagementApi.ChangePassword:output_type -> influenzanet.user_management_api.ServiceStatus
+	94,  // 171: influenzanet.user_management_api.UserManagementApi.ChangeAccountIDEmail:output_type -> inf.user.User
+	94,  // 172: influenzanet.user_management_api.UserManagementApi.PromoteContactInfoToAccountID:output_type -> inf.user.User
+	94,  // 173: influenzanet.user_management_api.UserManagementApi.LinkExternalIdentity:output_type -> inf.user.User
+	94,  // 174: influenzanet.user_management_api.UserManagementApi.UnlinkExternalIdentity:output_type -> inf.user.User
+	1,   // 175: influenzanet.user_management_api.UserManagementApi.DeleteAccount:output_type -> influenzanet.user_management_api.ServiceStatus
+	94,  // 176: influenzanet.user_management_api.UserManagementApi.ChangePreferredLanguage:output_type -> inf.user.User
+	1,   // 177: influenzanet.user_management_api.UserManagementApi.InitiatePasswordReset:output_type -> influenzanet.user_management_api.ServiceStatus
+	27,  // 178: influenzanet.user_management_api.UserManagementApi.GetInfosForPasswordReset:output_type -> influenzanet.user_management_api.UserInfoForPWReset
+	1,   // 179: influenzanet.user_management_api.UserManagementApi.ResetPassword:output_type -> influenzanet.user_management_api.ServiceStatus
+	94,  // 180: influenzanet.user_management_api.UserManagementApi.SaveProfile:output_type -> inf.user.User
+	94,  // 181: influenzanet.user_management_api.UserManagementApi.RemoveProfile:output_type -> inf.user.User
+	1,   // 182: influenzanet.user_management_api.UserManagementApi.UseUnsubscribeToken:output_type -> influenzanet.user_management_api.ServiceStatus
+	1,   // 183: influenzanet.user_management_api.UserManagementApi.ConfirmSubscription:output_type -> influenzanet.user_management_api.ServiceStatus
+	1,   // 184: influenzanet.user_management_api.UserManagementApi.ReportEmailDeliveryEvent:output_type -> influenzanet.user_management_api.ServiceStatus
+	94,  // 185: influenzanet.user_management_api.UserManagementApi.UpdateContactPreferences:output_type -> inf.user.User
+	94,  // 186: influenzanet.user_management_api.UserManagementApi.AddEmail:output_type -> inf.user.User
+	94,  // 187: influenzanet.user_management_api.UserManagementApi.RemoveEmail:output_type -> inf.user.User
+	94,  // 188: influenzanet.user_management_api.UserManagementApi.AddPhone:output_type -> inf.user.User
+	94,  // 189: influenzanet.user_management_api.UserManagementApi.RemovePhone:output_type -> inf.user.User
+	94,  // 190: influenzanet.user_management_api.UserManagementApi.VerifyPhone:output_type -> inf.user.User
+	68,  // 191: influenzanet.user_management_api.UserManagementApi.GetSubscriptionTopics:output_type -> influenzanet.user_management_api.SubscriptionTopicList
+	94,  // 192: influenzanet.user_management_api.UserManagementApi.CreateUser:output_type -> inf.user.User
+	39,  // 193: influenzanet.user_management_api.UserManagementApi.ImportUsers:output_type -> influenzanet.user_management_api.ImportUsersReport
+	94,  // 194: influenzanet.user_management_api.UserManagementApi.CreateServiceAccount:output_type -> inf.user.User
+	57,  // 195: influenzanet.user_management_api.UserManagementApi.CreateAPIKey:output_type -> influenzanet.user_management_api.CreateAPIKeyResponse
+	59,  // 196: influenzanet.user_management_api.UserManagementApi.GetAPIKeys:output_type -> influenzanet.user_management_api.APIKeyList
+	55,  // 197: influenzanet.user_management_api.UserManagementApi.RevokeAPIKey:output_type -> influenzanet.user_management_api.APIKey
+	43,  // 198: influenzanet.user_management_api.UserManagementApi.GetInvitations:output_type -> influenzanet.user_management_api.InvitationList
+	42,  // 199: influenzanet.user_management_api.UserManagementApi.ResendInvitation:output_type -> influenzanet.user_management_api.Invitation
+	42,  // 200: influenzanet.user_management_api.UserManagementApi.RevokeInvitation:output_type -> influenzanet.user_management_api.Invitation
+	94,  // 201: influenzanet.user_management_api.UserManagementApi.MoveUserToInstance:output_type -> inf.user.User
+	47,  // 202: influenzanet.user_management_api.UserManagementApi.MergeAccounts:output_type -> influenzanet.user_management_api.MergeAccountsResponse
+	88,  // 203: influenzanet.user_management_api.UserManagementApi.ImpersonateUser:output_type -> influenzanet.user_management_api.TokenResponse
+	94,  // 204: influenzanet.user_management_api.UserManagementApi.AddRoleForUser:output_type -> inf.user.User
+	94,  // 205: influenzanet.user_management_api.UserManagementApi.RemoveRoleForUser:output_type -> inf.user.User
+	49,  // 206: influenzanet.user_management_api.UserManagementApi.GrantRole:output_type -> influenzanet.user_management_api.RoleGrant
+	51,  // 207: influenzanet.user_management_api.UserManagementApi.GetRoleGrants:output_type -> influenzanet.user_management_api.RoleGrantList
+	49,  // 208: influenzanet.user_management_api.UserManagementApi.ApproveRoleGrant:output_type -> influenzanet.user_management_api.RoleGrant
+	49,  // 209: influenzanet.user_management_api.UserManagementApi.RejectRoleGrant:output_type -> influenzanet.user_management_api.RoleGrant
+	94,  // 210: influenzanet.user_management_api.UserManagementApi.AddRoleScope:output_type -> inf.user.User
+	94,  // 211: influenzanet.user_management_api.UserManagementApi.RemoveRoleScope:output_type -> inf.user.User
+	86,  // 212: influenzanet.user_management_api.UserManagementApi.FindNonParticipantUsers:output_type -> influenzanet.user_management_api.UserListMsg
+	85,  // 213: influenzanet.user_management_api.UserManagementApi.SearchUsers:output_type -> influenzanet.user_management_api.SearchUsersResponse
+	94,  // 214: influenzanet.user_management_api.UserManagementApi.StreamUsers:output_type -> inf.user.User
+	64,  // 215: influenzanet.user_management_api.UserManagementApi.StreamUserBatches:output_type -> influenzanet.user_management_api.UserBatch
+	65,  // 216: influenzanet.user_management_api.UserManagementApi.SaveSubscriptionTopic:output_type -> influenzanet.user_management_api.SubscriptionTopic
+	1,   // 217: influenzanet.user_management_api.UserManagementApi.RemoveSubscriptionTopic:output_type -> influenzanet.user_management_api.ServiceStatus
+	72,  // 218: influenzanet.user_management_api.UserManagementApi.GetRoleDefinitions:output_type -> influenzanet.user_management_api.RoleDefinitionList
+	69,  // 219: influenzanet.user_management_api.UserManagementApi.SaveRoleDefinition:output_type -> influenzanet.user_management_api.RoleDefinition
+	1,   // 220: influenzanet.user_management_api.UserManagementApi.RemoveRoleDefinition:output_type -> influenzanet.user_management_api.ServiceStatus
+	17,  // 221: influenzanet.user_management_api.UserManagementApi.CreateAppToken:output_type -> influenzanet.user_management_api.AppTokenSecret
+	19,  // 222: influenzanet.user_management_api.UserManagementApi.GetAppTokens:output_type -> influenzanet.user_management_api.AppTokenList
+	17,  // 223: influenzanet.user_management_api.UserManagementApi.RotateAppToken:output_type -> influenzanet.user_management_api.AppTokenSecret
+	15,  // 224: influenzanet.user_management_api.UserManagementApi.RevokeAppToken:output_type -> influenzanet.user_management_api.AppToken
+	76,  // 225: influenzanet.user_management_api.UserManagementApi.CreateInstance:output_type -> influenzanet.user_management_api.Instance
+	76,  // 226: influenzanet.user_management_api.UserManagementApi.UpdateInstanceSettings:output_type -> influenzanet.user_management_api.Instance
+	79,  // 227: influenzanet.user_management_api.UserManagementApi.GetInstances:output_type -> influenzanet.user_management_api.InstanceList
+	76,  // 228: influenzanet.user_management_api.UserManagementApi.ArchiveInstance:output_type -> influenzanet.user_management_api.Instance
+	150, // [150:229] is the sub-list for method output_type
+	71,  // [71:150] is the sub-list for method input_type
+	71,  // [71:71] is the sub-list for extension type_name
+	71,  // [71:71] is the sub-list for extension extendee
+	0,   // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_user_management_user_management_service_proto_init() }
+func file_user_management_user_management_service_proto_init() {
+	if File_user_management_user_management_service_proto != nil {
+		return
+	}
+	file_user_management_user_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_user_management_user_management_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignupWithEmailMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithEmailMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithExternalIDPMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkedIdentityMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoValidateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerificationCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserReference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeRefreshTokensReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTokenValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAppTokenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTokenSecret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppTokensReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTokenList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTokenMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAuthInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResendContactVerificationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordChangeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateResetPasswordMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfosForResetPasswordMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInfoForPWReset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailChangeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteContactInfoMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguageChangeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactPreferencesMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactInfoMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneVerificationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWTRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshJWTRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUserRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInvitationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveUserMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeAccountsMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleGrantsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrantList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleGrantDecisionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImpersonateUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateServiceAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAPIKeysReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKeyMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginWithAPIKeyMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleScopeMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUsersMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionTopic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionTopicMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionTopicsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionTopicList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDefinitionMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleDefinitionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleDefinitionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenLifetimes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstancesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstanceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryEventMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeTokensReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeTokens); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindNonParticipantUsersMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserListMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TempToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersReport_RowResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_management_user_management_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamUsersMsg_Filters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_user_management_user_management_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   92,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_user_management_user_management_service_proto_goTypes,
+		DependencyIndexes: file_user_management_user_management_service_proto_depIdxs,
+		EnumInfos:         file_user_management_user_management_service_proto_enumTypes,
+		MessageInfos:      file_user_management_user_management_service_proto_msgTypes,
+	}.Build()
+	File_user_management_user_management_service_proto = out.File
+	file_user_management_user_management_service_proto_rawDesc = nil
+	file_user_management_user_management_service_proto_goTypes = nil
+	file_user_management_user_management_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// UserManagementApiClient is the client API for UserManagementApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type UserManagementApiClient interface {
+	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceStatus, error)
+	// Auth and Token handling:
+	SendVerificationCode(ctx context.Context, in *SendVerificationCodeReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	AutoValidateTempToken(ctx context.Context, in *AutoValidateReq, opts ...grpc.CallOption) (*AutoValidateResponse, error)
+	LoginWithEmail(ctx context.Context, in *LoginWithEmailMsg, opts ...grpc.CallOption) (*LoginResponse, error)
+	LoginWithAPIKey(ctx context.Context, in *LoginWithAPIKeyMsg, opts ...grpc.CallOption) (*TokenResponse, error)
+	LoginWithExternalIDP(ctx context.Context, in *LoginWithExternalIDPMsg, opts ...grpc.CallOption) (*LoginResponse, error)
+	SignupWithEmail(ctx context.Context, in *SignupWithEmailMsg, opts ...grpc.CallOption) (*TokenResponse, error)
+	ValidateJWT(ctx context.Context, in *JWTRequest, opts ...grpc.CallOption) (*api_types.TokenInfos, error)
+	RenewJWT(ctx context.Context, in *RefreshJWTRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	RevokeAllRefreshTokens(ctx context.Context, in *RevokeRefreshTokensReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	VerifyContact(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*User, error)
+	ResendContactVerification(ctx context.Context, in *ResendContactVerificationReq, opts ...grpc.CallOption) (*ServiceStatus, error)
+	ValidateAppToken(ctx context.Context, in *AppTokenRequest, opts ...grpc.CallOption) (*AppTokenValidation, error)
+	// Temporary Tokens handling:
+	GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
+	GenerateTempToken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
+	GetTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*api_types.TempTokenInfos, error)
+	DeleteTempToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
+	PurgeUserTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetOrCreateUnsubscribeTokens(ctx context.Context, in *UnsubscribeTokensReq, opts ...grpc.CallOption) (*UnsubscribeTokens, error)
+	// User properties:
+	GetUser(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*User, error)
+	// Account methods:
+	ChangePassword(ctx context.Context, in *PasswordChangeMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	ChangeAccountIDEmail(ctx context.Context, in *EmailChangeMsg, opts ...grpc.CallOption) (*User, error)
+	PromoteContactInfoToAccountID(ctx context.Context, in *PromoteContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	LinkExternalIdentity(ctx context.Context, in *LinkedIdentityMsg, opts ...grpc.CallOption) (*User, error)
+	UnlinkExternalIdentity(ctx context.Context, in *LinkedIdentityMsg, opts ...grpc.CallOption) (*User, error)
+	DeleteAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error)
+	ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error)
+	// PW reset:
+	InitiatePasswordReset(ctx context.Context, in *InitiateResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetInfosForPasswordReset(ctx context.Context, in *GetInfosForResetPasswordMsg, opts ...grpc.CallOption) (*UserInfoForPWReset, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	// Profile methods:
+	SaveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
+	RemoveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
+	// Contacts:
+	UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
+	ConfirmSubscription(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
+	ReportEmailDeliveryEvent(ctx context.Context, in *DeliveryEventMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	UpdateContactPreferences(ctx context.Context, in *ContactPreferencesMsg, opts ...grpc.CallOption) (*User, error)
+	AddEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	RemoveEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	AddPhone(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	RemovePhone(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	VerifyPhone(ctx context.Context, in *PhoneVerificationMsg, opts ...grpc.CallOption) (*User, error)
+	GetSubscriptionTopics(ctx context.Context, in *GetSubscriptionTopicsReq, opts ...grpc.CallOption) (*SubscriptionTopicList, error)
+	// Management Methods:
+	CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error)
+	ImportUsers(ctx context.Context, opts ...grpc.CallOption) (UserManagementApi_ImportUsersClient, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*User, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	GetAPIKeys(ctx context.Context, in *GetAPIKeysReq, opts ...grpc.CallOption) (*APIKeyList, error)
+	RevokeAPIKey(ctx context.Context, in *APIKeyMsg, opts ...grpc.CallOption) (*APIKey, error)
+	GetInvitations(ctx context.Context, in *GetInvitationsReq, opts ...grpc.CallOption) (*InvitationList, error)
+	ResendInvitation(ctx context.Context, in *InvitationMsg, opts ...grpc.CallOption) (*Invitation, error)
+	RevokeInvitation(ctx context.Context, in *InvitationMsg, opts ...grpc.CallOption) (*Invitation, error)
+	MoveUserToInstance(ctx context.Context, in *MoveUserMsg, opts ...grpc.CallOption) (*User, error)
+	MergeAccounts(ctx context.Context, in *MergeAccountsMsg, opts ...grpc.CallOption) (*MergeAccountsResponse, error)
+	ImpersonateUser(ctx context.Context, in *ImpersonateUserReq, opts ...grpc.CallOption) (*TokenResponse, error)
+	AddRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error)
+	RemoveRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error)
+	GrantRole(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*RoleGrant, error)
+	GetRoleGrants(ctx context.Context, in *GetRoleGrantsReq, opts ...grpc.CallOption) (*RoleGrantList, error)
+	ApproveRoleGrant(ctx context.Context, in *RoleGrantDecisionMsg, opts ...grpc.CallOption) (*RoleGrant, error)
+	RejectRoleGrant(ctx context.Context, in *RoleGrantDecisionMsg, opts ...grpc.CallOption) (*RoleGrant, error)
+	AddRoleScope(ctx context.Context, in *RoleScopeMsg, opts ...grpc.CallOption) (*User, error)
+	RemoveRoleScope(ctx context.Context, in *RoleScopeMsg, opts ...grpc.CallOption) (*User, error)
+	FindNonParticipantUsers(ctx context.Context, in *FindNonParticipantUsersMsg, opts ...grpc.CallOption) (*UserListMsg, error)
+	SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	StreamUsers(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUsersClient, error)
+	StreamUserBatches(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUserBatchesClient, error)
+	SaveSubscriptionTopic(ctx context.Context, in *SubscriptionTopicMsg, opts ...grpc.CallOption) (*SubscriptionTopic, error)
+	RemoveSubscriptionTopic(ctx context.Context, in *SubscriptionTopicMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetRoleDefinitions(ctx context.Context, in *GetRoleDefinitionsReq, opts ...grpc.CallOption) (*RoleDefinitionList, error)
+	SaveRoleDefinition(ctx context.Context, in *RoleDefinitionMsg, opts ...grpc.CallOption) (*RoleDefinition, error)
+	RemoveRoleDefinition(ctx context.Context, in *RoleDefinitionMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	CreateAppToken(ctx context.Context, in *CreateAppTokenReq, opts ...grpc.CallOption) (*AppTokenSecret, error)
+	GetAppTokens(ctx context.Context, in *GetAppTokensReq, opts ...grpc.CallOption) (*AppTokenList, error)
+	RotateAppToken(ctx context.Context, in *AppTokenMsg, opts ...grpc.CallOption) (*AppTokenSecret, error)
+	RevokeAppToken(ctx context.Context, in *AppTokenMsg, opts ...grpc.CallOption) (*AppToken, error)
+	CreateInstance(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error)
+	UpdateInstanceSettings(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error)
+	GetInstances(ctx context.Context, in *GetInstancesReq, opts ...grpc.CallOption) (*InstanceList, error)
+	ArchiveInstance(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error)
+}
+
+type userManagementApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserManagementApiClient(cc grpc.ClientConnInterface) UserManagementApiClient {
+	return &userManagementApiClient{cc}
+}
+
+func (c *userManagementApiClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) SendVerificationCode(ctx context.Context, in *SendVerificationCodeReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SendVerificationCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) AutoValidateTempToken(ctx context.Context, in *AutoValidateReq, opts ...grpc.CallOption) (*AutoValidateResponse, error) {
+	out := new(AutoValidateResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AutoValidateTempToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) LoginWithEmail(ctx context.Context, in *LoginWithEmailMsg, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LoginWithEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) LoginWithAPIKey(ctx context.Context, in *LoginWithAPIKeyMsg, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LoginWithAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) LoginWithExternalIDP(ctx context.Context, in *LoginWithExternalIDPMsg, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LoginWithExternalIDP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) SignupWithEmail(ctx context.Context, in *SignupWithEmailMsg, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SignupWithEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ValidateJWT(ctx context.Context, in *JWTRequest, opts ...grpc.CallOption) (*api_types.TokenInfos, error) {
+	out := new(api_types.TokenInfos)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ValidateJWT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RenewJWT(ctx context.Context, in *RefreshJWTRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RenewJWT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RevokeAllRefreshTokens(ctx context.Context, in *RevokeRefreshTokensReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RevokeAllRefreshTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) VerifyContact(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/VerifyContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ResendContactVerification(ctx context.Context, in *ResendContactVerificationReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResendContactVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ValidateAppToken(ctx context.Context, in *AppTokenRequest, opts ...grpc.CallOption) (*AppTokenValidation, error) {
+	out := new(AppTokenValidation)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ValidateAppToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error) {
+	out := new(TempToken)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetOrCreateTemptoken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GenerateTempToken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error) {
+	out := new(TempToken)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GenerateTempToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*api_types.TempTokenInfos, error) {
+	out := new(api_types.TempTokenInfos)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetTempTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) DeleteTempToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DeleteTempToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) PurgeUserTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/PurgeUserTempTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetOrCreateUnsubscribeTokens(ctx context.Context, in *UnsubscribeTokensReq, opts ...grpc.CallOption) (*UnsubscribeTokens, error) {
+	out := new(UnsubscribeTokens)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetOrCreateUnsubscribeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetUser(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ChangePassword(ctx context.Context, in *PasswordChangeMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ChangeAccountIDEmail(ctx context.Context, in *EmailChangeMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangeAccountIDEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) PromoteContactInfoToAccountID(ctx context.Context, in *PromoteContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/PromoteContactInfoToAccountID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) LinkExternalIdentity(ctx context.Context, in *LinkedIdentityMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LinkExternalIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UnlinkExternalIdentity(ctx context.Context, in *LinkedIdentityMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UnlinkExternalIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) DeleteAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangePreferredLanguage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) InitiatePasswordReset(ctx context.Context, in *InitiateResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/InitiatePasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetInfosForPasswordReset(ctx context.Context, in *GetInfosForResetPasswordMsg, opts ...grpc.CallOption) (*UserInfoForPWReset, error) {
+	out := new(UserInfoForPWReset)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetInfosForPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ResetPassword(ctx context.Context, in *ResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) SaveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SaveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UseUnsubscribeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ConfirmSubscription(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ConfirmSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ReportEmailDeliveryEvent(ctx context.Context, in *DeliveryEventMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ReportEmailDeliveryEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UpdateContactPreferences(ctx context.Context, in *ContactPreferencesMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UpdateContactPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) AddEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) AddPhone(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemovePhone(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemovePhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) VerifyPhone(ctx context.Context, in *PhoneVerificationMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/VerifyPhone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetSubscriptionTopics(ctx context.Context, in *GetSubscriptionTopicsReq, opts ...grpc.CallOption) (*SubscriptionTopicList, error) {
+	out := new(SubscriptionTopicList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetSubscriptionTopics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ImportUsers(ctx context.Context, opts ...grpc.CallOption) (UserManagementApi_ImportUsersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserManagementApi_serviceDesc.Streams[0], "/influenzanet.user_management_api.UserManagementApi/ImportUsers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userManagementApiImportUsersClient{stream}
+	return x, nil
+}
+
+type UserManagementApi_ImportUsersClient interface {
+	Send(*ImportUsersMsg) error
+	CloseAndRecv() (*ImportUsersReport, error)
+	grpc.ClientStream
+}
+
+type userManagementApiImportUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userManagementApiImportUsersClient) Send(m *ImportUsersMsg) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userManagementApiImportUsersClient) CloseAndRecv() (*ImportUsersReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportUsersReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userManagementApiClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyReq, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetAPIKeys(ctx context.Context, in *GetAPIKeysReq, opts ...grpc.CallOption) (*APIKeyList, error) {
+	out := new(APIKeyList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RevokeAPIKey(ctx context.Context, in *APIKeyMsg, opts ...grpc.CallOption) (*APIKey, error) {
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetInvitations(ctx context.Context, in *GetInvitationsReq, opts ...grpc.CallOption) (*InvitationList, error) {
+	out := new(InvitationList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ResendInvitation(ctx context.Context, in *InvitationMsg, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResendInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RevokeInvitation(ctx context.Context, in *InvitationMsg, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) MoveUserToInstance(ctx context.Context, in *MoveUserMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/MoveUserToInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) MergeAccounts(ctx context.Context, in *MergeAccountsMsg, opts ...grpc.CallOption) (*MergeAccountsResponse, error) {
+	out := new(MergeAccountsResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/MergeAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ImpersonateUser(ctx context.Context, in *ImpersonateUserReq, opts ...grpc.CallOption) (*TokenResponse, error) {
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ImpersonateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) AddRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddRoleForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveRoleForUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GrantRole(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetRoleGrants(ctx context.Context, in *GetRoleGrantsReq, opts ...grpc.CallOption) (*RoleGrantList, error) {
+	out := new(RoleGrantList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetRoleGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) ApproveRoleGrant(ctx context.Context, in *RoleGrantDecisionMsg, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ApproveRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RejectRoleGrant(ctx context.Context, in *RoleGrantDecisionMsg, opts ...grpc.CallOption) (*RoleGrant, error) {
+	out := new(RoleGrant)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RejectRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) AddRoleScope(ctx context.Context, in *RoleScopeMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddRoleScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveRoleScope(ctx context.Context, in *RoleScopeMsg, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveRoleScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) FindNonParticipantUsers(ctx context.Context, in *FindNonParticipantUsersMsg, opts ...grpc.CallOption) (*UserListMsg, error) {
+	out := new(UserListMsg)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/FindNonParticipantUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SearchUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) StreamUsers(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUsersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserManagementApi_serviceDesc.Streams[1], "/influenzanet.user_management_api.UserManagementApi/StreamUsers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userManagementApiStreamUsersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserManagementApi_StreamUsersClient interface {
+	Recv() (*User, error)
+	grpc.ClientStream
+}
+
+type userManagementApiStreamUsersClient struct {
+	grpc.ClientStream
+}
+
+func (x *userManagementApiStreamUsersClient) Recv() (*User, error) {
+	m := new(User)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userManagementApiClient) StreamUserBatches(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUserBatchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserManagementApi_serviceDesc.Streams[2], "/influenzanet.user_management_api.UserManagementApi/StreamUserBatches", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userManagementApiStreamUserBatchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserManagementApi_StreamUserBatchesClient interface {
+	Recv() (*UserBatch, error)
+	grpc.ClientStream
+}
+
+type userManagementApiStreamUserBatchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *userManagementApiStreamUserBatchesClient) Recv() (*UserBatch, error) {
+	m := new(UserBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userManagementApiClient) SaveSubscriptionTopic(ctx context.Context, in *SubscriptionTopicMsg, opts ...grpc.CallOption) (*SubscriptionTopic, error) {
+	out := new(SubscriptionTopic)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SaveSubscriptionTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveSubscriptionTopic(ctx context.Context, in *SubscriptionTopicMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveSubscriptionTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetRoleDefinitions(ctx context.Context, in *GetRoleDefinitionsReq, opts ...grpc.CallOption) (*RoleDefinitionList, error) {
+	out := new(RoleDefinitionList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetRoleDefinitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) SaveRoleDefinition(ctx context.Context, in *RoleDefinitionMsg, opts ...grpc.CallOption) (*RoleDefinition, error) {
+	out := new(RoleDefinition)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SaveRoleDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RemoveRoleDefinition(ctx context.Context, in *RoleDefinitionMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
+	out := new(ServiceStatus)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveRoleDefinition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) CreateAppToken(ctx context.Context, in *CreateAppTokenReq, opts ...grpc.CallOption) (*AppTokenSecret, error) {
+	out := new(AppTokenSecret)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateAppToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) GetAppTokens(ctx context.Context, in *GetAppTokensReq, opts ...grpc.CallOption) (*AppTokenList, error) {
+	out := new(AppTokenList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetAppTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RotateAppToken(ctx context.Context, in *AppTokenMsg, opts ...grpc.CallOption) (*AppTokenSecret, error) {
+	out := new(AppTokenSecret)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RotateAppToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) RevokeAppToken(ctx context.Context, in *AppTokenMsg, opts ...grpc.CallOption) (*AppToken, error) {
+	out := new(AppToken)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RevokeAppToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) CreateInstance(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userManagementApiClient) UpdateInstanceSettings(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UpdateInstanceSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_user_management_user_management_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   35,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_user_management_user_management_service_proto_goTypes,
-		DependencyIndexes: file_user_management_user_management_service_proto_depIdxs,
-		EnumInfos:         file_user_management_user_management_service_proto_enumTypes,
-		MessageInfos:      file_user_management_user_management_service_proto_msgTypes,
-	}.Build()
-	File_user_management_user_management_service_proto = out.File
-	file_user_management_user_management_service_proto_rawDesc = nil
-	file_user_management_user_management_service_proto_goTypes = nil
-	file_user_management_user_management_service_proto_depIdxs = nil
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (c *userManagementApiClient) GetInstances(ctx context.Context, in *GetInstancesReq, opts ...grpc.CallOption) (*InstanceList, error) {
+	out := new(InstanceList)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func (c *userManagementApiClient) ArchiveInstance(ctx context.Context, in *InstanceMsg, opts ...grpc.CallOption) (*Instance, error) {
+	out := new(Instance)
+	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ArchiveInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// UserManagementApiClient is the client API for UserManagementApi service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type UserManagementApiClient interface {
-	Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceStatus, error)
+// UserManagementApiServer is the server API for UserManagementApi service.
+type UserManagementApiServer interface {
+	Status(context.Context, *emptypb.Empty) (*ServiceStatus, error)
 	// Auth and Token handling:
-	SendVerificationCode(ctx context.Context, in *SendVerificationCodeReq, opts ...grpc.CallOption) (*ServiceStatus, error)
-	AutoValidateTempToken(ctx context.Context, in *AutoValidateReq, opts ...grpc.CallOption) (*AutoValidateResponse, error)
-	LoginWithEmail(ctx context.Context, in *LoginWithEmailMsg, opts ...grpc.CallOption) (*LoginResponse, error)
-	LoginWithExternalIDP(ctx context.Context, in *LoginWithExternalIDPMsg, opts ...grpc.CallOption) (*LoginResponse, error)
-	SignupWithEmail(ctx context.Context, in *SignupWithEmailMsg, opts ...grpc.CallOption) (*TokenResponse, error)
-	ValidateJWT(ctx context.Context, in *JWTRequest, opts ...grpc.CallOption) (*api_types.TokenInfos, error)
-	RenewJWT(ctx context.Context, in *RefreshJWTRequest, opts ...grpc.CallOption) (*TokenResponse, error)
-	RevokeAllRefreshTokens(ctx context.Context, in *RevokeRefreshTokensReq, opts ...grpc.CallOption) (*ServiceStatus, error)
-	VerifyContact(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*User, error)
-	ResendContactVerification(ctx context.Context, in *ResendContactVerificationReq, opts ...grpc.CallOption) (*ServiceStatus, error)
-	ValidateAppToken(ctx context.Context, in *AppTokenRequest, opts ...grpc.CallOption) (*AppTokenValidation, error)
+	SendVerificationCode(context.Context, *SendVerificationCodeReq) (*ServiceStatus, error)
+	AutoValidateTempToken(context.Context, *AutoValidateReq) (*AutoValidateResponse, error)
+	LoginWithEmail(context.Context, *LoginWithEmailMsg) (*LoginResponse, error)
+	LoginWithAPIKey(context.Context, *LoginWithAPIKeyMsg) (*TokenResponse, error)
+	LoginWithExternalIDP(context.Context, *LoginWithExternalIDPMsg) (*LoginResponse, error)
+	SignupWithEmail(context.Context, *SignupWithEmailMsg) (*TokenResponse, error)
+	ValidateJWT(context.Context, *JWTRequest) (*api_types.TokenInfos, error)
+	RenewJWT(context.Context, *RefreshJWTRequest) (*TokenResponse, error)
+	RevokeAllRefreshTokens(context.Context, *RevokeRefreshTokensReq) (*ServiceStatus, error)
+	VerifyContact(context.Context, *TempToken) (*User, error)
+	ResendContactVerification(context.Context, *ResendContactVerificationReq) (*ServiceStatus, error)
+	ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error)
 	// Temporary Tokens handling:
-	GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
-	GenerateTempToken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error)
-	GetTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*api_types.TempTokenInfos, error)
-	DeleteTempToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
-	PurgeUserTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*ServiceStatus, error)
+	GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
+	GenerateTempToken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
+	GetTempTokens(context.Context, *api_types.TempTokenInfo) (*api_types.TempTokenInfos, error)
+	DeleteTempToken(context.Context, *TempToken) (*ServiceStatus, error)
+	PurgeUserTempTokens(context.Context, *api_types.TempTokenInfo) (*ServiceStatus, error)
+	GetOrCreateUnsubscribeTokens(context.Context, *UnsubscribeTokensReq) (*UnsubscribeTokens, error)
 	// User properties:
-	GetUser(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*User, error)
+	GetUser(context.Context, *UserReference) (*User, error)
 	// Account methods:
-	ChangePassword(ctx context.Context, in *PasswordChangeMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
-	ChangeAccountIDEmail(ctx context.Context, in *EmailChangeMsg, opts ...grpc.CallOption) (*User, error)
-	DeleteAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error)
-	ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error)
+	ChangePassword(context.Context, *PasswordChangeMsg) (*ServiceStatus, error)
+	ChangeAccountIDEmail(context.Context, *EmailChangeMsg) (*User, error)
+	PromoteContactInfoToAccountID(context.Context, *PromoteContactInfoMsg) (*User, error)
+	LinkExternalIdentity(context.Context, *LinkedIdentityMsg) (*User, error)
+	UnlinkExternalIdentity(context.Context, *LinkedIdentityMsg) (*User, error)
+	DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error)
+	ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error)
 	// PW reset:
-	InitiatePasswordReset(ctx context.Context, in *InitiateResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
-	GetInfosForPasswordReset(ctx context.Context, in *GetInfosForResetPasswordMsg, opts ...grpc.CallOption) (*UserInfoForPWReset, error)
-	ResetPassword(ctx context.Context, in *ResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error)
+	InitiatePasswordReset(context.Context, *InitiateResetPasswordMsg) (*ServiceStatus, error)
+	GetInfosForPasswordReset(context.Context, *GetInfosForResetPasswordMsg) (*UserInfoForPWReset, error)
+	ResetPassword(context.Context, *ResetPasswordMsg) (*ServiceStatus, error)
 	// Profile methods:
-	SaveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
-	RemoveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error)
+	SaveProfile(context.Context, *ProfileRequest) (*User, error)
+	RemoveProfile(context.Context, *ProfileRequest) (*User, error)
 	// Contacts:
-	UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error)
-	UpdateContactPreferences(ctx context.Context, in *ContactPreferencesMsg, opts ...grpc.CallOption) (*User, error)
-	AddEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
-	RemoveEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error)
+	UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error)
+	ConfirmSubscription(context.Context, *TempToken) (*ServiceStatus, error)
+	ReportEmailDeliveryEvent(context.Context, *DeliveryEventMsg) (*ServiceStatus, error)
+	UpdateContactPreferences(context.Context, *ContactPreferencesMsg) (*User, error)
+	AddEmail(context.Context, *ContactInfoMsg) (*User, error)
+	RemoveEmail(context.Context, *ContactInfoMsg) (*User, error)
+	AddPhone(context.Context, *ContactInfoMsg) (*User, error)
+	RemovePhone(context.Context, *ContactInfoMsg) (*User, error)
+	VerifyPhone(context.Context, *PhoneVerificationMsg) (*User, error)
+	GetSubscriptionTopics(context.Context, *GetSubscriptionTopicsReq) (*SubscriptionTopicList, error)
 	// Management Methods:
-	CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error)
-	AddRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error)
-	RemoveRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error)
-	FindNonParticipantUsers(ctx context.Context, in *FindNonParticipantUsersMsg, opts ...grpc.CallOption) (*UserListMsg, error)
-	StreamUsers(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUsersClient, error)
+	CreateUser(context.Context, *CreateUserReq) (*User, error)
+	ImportUsers(UserManagementApi_ImportUsersServer) error
+	CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*User, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResponse, error)
+	GetAPIKeys(context.Context, *GetAPIKeysReq) (*APIKeyList, error)
+	RevokeAPIKey(context.Context, *APIKeyMsg) (*APIKey, error)
+	GetInvitations(context.Context, *GetInvitationsReq) (*InvitationList, error)
+	ResendInvitation(context.Context, *InvitationMsg) (*Invitation, error)
+	RevokeInvitation(context.Context, *InvitationMsg) (*Invitation, error)
+	MoveUserToInstance(context.Context, *MoveUserMsg) (*User, error)
+	MergeAccounts(context.Context, *MergeAccountsMsg) (*MergeAccountsResponse, error)
+	ImpersonateUser(context.Context, *ImpersonateUserReq) (*TokenResponse, error)
+	AddRoleForUser(context.Context, *RoleMsg) (*User, error)
+	RemoveRoleForUser(context.Context, *RoleMsg) (*User, error)
+	GrantRole(context.Context, *RoleMsg) (*RoleGrant, error)
+	GetRoleGrants(context.Context, *GetRoleGrantsReq) (*RoleGrantList, error)
+	ApproveRoleGrant(context.Context, *RoleGrantDecisionMsg) (*RoleGrant, error)
+	RejectRoleGrant(context.Context, *RoleGrantDecisionMsg) (*RoleGrant, error)
+	AddRoleScope(context.Context, *RoleScopeMsg) (*User, error)
+	RemoveRoleScope(context.Context, *RoleScopeMsg) (*User, error)
+	FindNonParticipantUsers(context.Context, *FindNonParticipantUsersMsg) (*UserListMsg, error)
+	SearchUsers(context.Context, *SearchUsersReq) (*SearchUsersResponse, error)
+	StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error
+	StreamUserBatches(*StreamUsersMsg, UserManagementApi_StreamUserBatchesServer) error
+	SaveSubscriptionTopic(context.Context, *SubscriptionTopicMsg) (*SubscriptionTopic, error)
+	RemoveSubscriptionTopic(context.Context, *SubscriptionTopicMsg) (*ServiceStatus, error)
+	GetRoleDefinitions(context.Context, *GetRoleDefinitionsReq) (*RoleDefinitionList, error)
+	SaveRoleDefinition(context.Context, *RoleDefinitionMsg) (*RoleDefinition, error)
+	RemoveRoleDefinition(context.Context, *RoleDefinitionMsg) (*ServiceStatus, error)
+	CreateAppToken(context.Context, *CreateAppTokenReq) (*AppTokenSecret, error)
+	GetAppTokens(context.Context, *GetAppTokensReq) (*AppTokenList, error)
+	RotateAppToken(context.Context, *AppTokenMsg) (*AppTokenSecret, error)
+	RevokeAppToken(context.Context, *AppTokenMsg) (*AppToken, error)
+	CreateInstance(context.Context, *InstanceMsg) (*Instance, error)
+	UpdateInstanceSettings(context.Context, *InstanceMsg) (*Instance, error)
+	GetInstances(context.Context, *GetInstancesReq) (*InstanceList, error)
+	ArchiveInstance(context.Context, *InstanceMsg) (*Instance, error)
+}
+
+// UnimplementedUserManagementApiServer can be embedded to have forward compatible implementations.
+type UnimplementedUserManagementApiServer struct {
+}
+
+func (*UnimplementedUserManagementApiServer) Status(context.Context, *emptypb.Empty) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (*UnimplementedUserManagementApiServer) SendVerificationCode(context.Context, *SendVerificationCodeReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationCode not implemented")
+}
+func (*UnimplementedUserManagementApiServer) AutoValidateTempToken(context.Context, *AutoValidateReq) (*AutoValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoValidateTempToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) LoginWithEmail(context.Context, *LoginWithEmailMsg) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithEmail not implemented")
+}
+func (*UnimplementedUserManagementApiServer) LoginWithAPIKey(context.Context, *LoginWithAPIKeyMsg) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithAPIKey not implemented")
+}
+func (*UnimplementedUserManagementApiServer) LoginWithExternalIDP(context.Context, *LoginWithExternalIDPMsg) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithExternalIDP not implemented")
+}
+func (*UnimplementedUserManagementApiServer) SignupWithEmail(context.Context, *SignupWithEmailMsg) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignupWithEmail not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ValidateJWT(context.Context, *JWTRequest) (*api_types.TokenInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateJWT not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RenewJWT(context.Context, *RefreshJWTRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewJWT not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RevokeAllRefreshTokens(context.Context, *RevokeRefreshTokensReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllRefreshTokens not implemented")
+}
+func (*UnimplementedUserManagementApiServer) VerifyContact(context.Context, *TempToken) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyContact not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ResendContactVerification(context.Context, *ResendContactVerificationReq) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendContactVerification not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAppToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateTemptoken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GenerateTempToken(context.Context, *api_types.TempTokenInfo) (*TempToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateTempToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetTempTokens(context.Context, *api_types.TempTokenInfo) (*api_types.TempTokenInfos, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTempTokens not implemented")
+}
+func (*UnimplementedUserManagementApiServer) DeleteTempToken(context.Context, *TempToken) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTempToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) PurgeUserTempTokens(context.Context, *api_types.TempTokenInfo) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserTempTokens not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetOrCreateUnsubscribeTokens(context.Context, *UnsubscribeTokensReq) (*UnsubscribeTokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateUnsubscribeTokens not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetUser(context.Context, *UserReference) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ChangePassword(context.Context, *PasswordChangeMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ChangeAccountIDEmail(context.Context, *EmailChangeMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAccountIDEmail not implemented")
+}
+func (*UnimplementedUserManagementApiServer) PromoteContactInfoToAccountID(context.Context, *PromoteContactInfoMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteContactInfoToAccountID not implemented")
+}
+func (*UnimplementedUserManagementApiServer) LinkExternalIdentity(context.Context, *LinkedIdentityMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalIdentity not implemented")
+}
+func (*UnimplementedUserManagementApiServer) UnlinkExternalIdentity(context.Context, *LinkedIdentityMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkExternalIdentity not implemented")
+}
+func (*UnimplementedUserManagementApiServer) DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePreferredLanguage not implemented")
+}
+func (*UnimplementedUserManagementApiServer) InitiatePasswordReset(context.Context, *InitiateResetPasswordMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiatePasswordReset not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetInfosForPasswordReset(context.Context, *GetInfosForResetPasswordMsg) (*UserInfoForPWReset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfosForPasswordReset not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ResetPassword(context.Context, *ResetPasswordMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedUserManagementApiServer) SaveProfile(context.Context, *ProfileRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProfile not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemoveProfile(context.Context, *ProfileRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProfile not implemented")
+}
+func (*UnimplementedUserManagementApiServer) UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UseUnsubscribeToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ConfirmSubscription(context.Context, *TempToken) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmSubscription not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ReportEmailDeliveryEvent(context.Context, *DeliveryEventMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportEmailDeliveryEvent not implemented")
+}
+func (*UnimplementedUserManagementApiServer) UpdateContactPreferences(context.Context, *ContactPreferencesMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContactPreferences not implemented")
+}
+func (*UnimplementedUserManagementApiServer) AddEmail(context.Context, *ContactInfoMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEmail not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemoveEmail(context.Context, *ContactInfoMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmail not implemented")
+}
+func (*UnimplementedUserManagementApiServer) AddPhone(context.Context, *ContactInfoMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPhone not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemovePhone(context.Context, *ContactInfoMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePhone not implemented")
+}
+func (*UnimplementedUserManagementApiServer) VerifyPhone(context.Context, *PhoneVerificationMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPhone not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetSubscriptionTopics(context.Context, *GetSubscriptionTopicsReq) (*SubscriptionTopicList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionTopics not implemented")
+}
+func (*UnimplementedUserManagementApiServer) CreateUser(context.Context, *CreateUserReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ImportUsers(UserManagementApi_ImportUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (*UnimplementedUserManagementApiServer) CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (*UnimplementedUserManagementApiServer) CreateAPIKey(context.Context, *CreateAPIKeyReq) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetAPIKeys(context.Context, *GetAPIKeysReq) (*APIKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPIKeys not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RevokeAPIKey(context.Context, *APIKeyMsg) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetInvitations(context.Context, *GetInvitationsReq) (*InvitationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ResendInvitation(context.Context, *InvitationMsg) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvitation not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RevokeInvitation(context.Context, *InvitationMsg) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (*UnimplementedUserManagementApiServer) MoveUserToInstance(context.Context, *MoveUserMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveUserToInstance not implemented")
+}
+func (*UnimplementedUserManagementApiServer) MergeAccounts(context.Context, *MergeAccountsMsg) (*MergeAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeAccounts not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ImpersonateUser(context.Context, *ImpersonateUserReq) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateUser not implemented")
+}
+func (*UnimplementedUserManagementApiServer) AddRoleForUser(context.Context, *RoleMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoleForUser not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemoveRoleForUser(context.Context, *RoleMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleForUser not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GrantRole(context.Context, *RoleMsg) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetRoleGrants(context.Context, *GetRoleGrantsReq) (*RoleGrantList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleGrants not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ApproveRoleGrant(context.Context, *RoleGrantDecisionMsg) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoleGrant not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RejectRoleGrant(context.Context, *RoleGrantDecisionMsg) (*RoleGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRoleGrant not implemented")
+}
+func (*UnimplementedUserManagementApiServer) AddRoleScope(context.Context, *RoleScopeMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRoleScope not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemoveRoleScope(context.Context, *RoleScopeMsg) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleScope not implemented")
+}
+func (*UnimplementedUserManagementApiServer) FindNonParticipantUsers(context.Context, *FindNonParticipantUsersMsg) (*UserListMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNonParticipantUsers not implemented")
+}
+func (*UnimplementedUserManagementApiServer) SearchUsers(context.Context, *SearchUsersReq) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (*UnimplementedUserManagementApiServer) StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUsers not implemented")
+}
+func (*UnimplementedUserManagementApiServer) StreamUserBatches(*StreamUsersMsg, UserManagementApi_StreamUserBatchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUserBatches not implemented")
 }
-
-type userManagementApiClient struct {
-	cc grpc.ClientConnInterface
+func (*UnimplementedUserManagementApiServer) SaveSubscriptionTopic(context.Context, *SubscriptionTopicMsg) (*SubscriptionTopic, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSubscriptionTopic not implemented")
 }
-
-func NewUserManagementApiClient(cc grpc.ClientConnInterface) UserManagementApiClient {
-	return &userManagementApiClient{cc}
+func (*UnimplementedUserManagementApiServer) RemoveSubscriptionTopic(context.Context, *SubscriptionTopicMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSubscriptionTopic not implemented")
 }
-
-func (c *userManagementApiClient) Status(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/Status", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedUserManagementApiServer) GetRoleDefinitions(context.Context, *GetRoleDefinitionsReq) (*RoleDefinitionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleDefinitions not implemented")
 }
-
-func (c *userManagementApiClient) SendVerificationCode(ctx context.Context, in *SendVerificationCodeReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SendVerificationCode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedUserManagementApiServer) SaveRoleDefinition(context.Context, *RoleDefinitionMsg) (*RoleDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRoleDefinition not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RemoveRoleDefinition(context.Context, *RoleDefinitionMsg) (*ServiceStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleDefinition not implemented")
+}
+func (*UnimplementedUserManagementApiServer) CreateAppToken(context.Context, *CreateAppTokenReq) (*AppTokenSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetAppTokens(context.Context, *GetAppTokensReq) (*AppTokenList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppTokens not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RotateAppToken(context.Context, *AppTokenMsg) (*AppTokenSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAppToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) RevokeAppToken(context.Context, *AppTokenMsg) (*AppToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAppToken not implemented")
+}
+func (*UnimplementedUserManagementApiServer) CreateInstance(context.Context, *InstanceMsg) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstance not implemented")
+}
+func (*UnimplementedUserManagementApiServer) UpdateInstanceSettings(context.Context, *InstanceMsg) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInstanceSettings not implemented")
+}
+func (*UnimplementedUserManagementApiServer) GetInstances(context.Context, *GetInstancesReq) (*InstanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstances not implemented")
+}
+func (*UnimplementedUserManagementApiServer) ArchiveInstance(context.Context, *InstanceMsg) (*Instance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveInstance not implemented")
 }
 
-func (c *userManagementApiClient) AutoValidateTempToken(ctx context.Context, in *AutoValidateReq, opts ...grpc.CallOption) (*AutoValidateResponse, error) {
-	out := new(AutoValidateResponse)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AutoValidateTempToken", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func RegisterUserManagementApiServer(s *grpc.Server, srv UserManagementApiServer) {
+	s.RegisterService(&_UserManagementApi_serviceDesc, srv)
 }
 
-func (c *userManagementApiClient) LoginWithEmail(ctx context.Context, in *LoginWithEmailMsg, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LoginWithEmail", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).Status(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) LoginWithExternalIDP(ctx context.Context, in *LoginWithExternalIDPMsg, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/LoginWithExternalIDP", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_SendVerificationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerificationCodeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).SendVerificationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SendVerificationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).SendVerificationCode(ctx, req.(*SendVerificationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) SignupWithEmail(ctx context.Context, in *SignupWithEmailMsg, opts ...grpc.CallOption) (*TokenResponse, error) {
-	out := new(TokenResponse)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SignupWithEmail", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_AutoValidateTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoValidateReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).AutoValidateTempToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AutoValidateTempToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).AutoValidateTempToken(ctx, req.(*AutoValidateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ValidateJWT(ctx context.Context, in *JWTRequest, opts ...grpc.CallOption) (*api_types.TokenInfos, error) {
-	out := new(api_types.TokenInfos)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ValidateJWT", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_LoginWithEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithEmailMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).LoginWithEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).LoginWithEmail(ctx, req.(*LoginWithEmailMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) RenewJWT(ctx context.Context, in *RefreshJWTRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
-	out := new(TokenResponse)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RenewJWT", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_LoginWithAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithAPIKeyMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).LoginWithAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).LoginWithAPIKey(ctx, req.(*LoginWithAPIKeyMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) RevokeAllRefreshTokens(ctx context.Context, in *RevokeRefreshTokensReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RevokeAllRefreshTokens", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_LoginWithExternalIDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithExternalIDPMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).LoginWithExternalIDP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithExternalIDP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).LoginWithExternalIDP(ctx, req.(*LoginWithExternalIDPMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) VerifyContact(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/VerifyContact", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_SignupWithEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignupWithEmailMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).SignupWithEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SignupWithEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).SignupWithEmail(ctx, req.(*SignupWithEmailMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ResendContactVerification(ctx context.Context, in *ResendContactVerificationReq, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResendContactVerification", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ValidateJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JWTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ValidateJWT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ValidateJWT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ValidateJWT(ctx, req.(*JWTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ValidateAppToken(ctx context.Context, in *AppTokenRequest, opts ...grpc.CallOption) (*AppTokenValidation, error) {
-	out := new(AppTokenValidation)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ValidateAppToken", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_RenewJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshJWTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RenewJWT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RenewJWT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RenewJWT(ctx, req.(*RefreshJWTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) GetOrCreateTemptoken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error) {
-	out := new(TempToken)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetOrCreateTemptoken", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_RevokeAllRefreshTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRefreshTokensReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RevokeAllRefreshTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RevokeAllRefreshTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RevokeAllRefreshTokens(ctx, req.(*RevokeRefreshTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) GenerateTempToken(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*TempToken, error) {
-	out := new(TempToken)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GenerateTempToken", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_VerifyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).VerifyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/VerifyContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).VerifyContact(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) GetTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*api_types.TempTokenInfos, error) {
-	out := new(api_types.TempTokenInfos)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetTempTokens", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ResendContactVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendContactVerificationReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ResendContactVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResendContactVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ResendContactVerification(ctx, req.(*ResendContactVerificationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) DeleteTempToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DeleteTempToken", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ValidateAppToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *userManagementApiClient) PurgeUserTempTokens(ctx context.Context, in *api_types.TempTokenInfo, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/PurgeUserTempTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ValidateAppToken(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *userManagementApiClient) GetUser(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ValidateAppToken",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ValidateAppToken(ctx, req.(*AppTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ChangePassword(ctx context.Context, in *PasswordChangeMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangePassword", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GetOrCreateTemptoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api_types.TempTokenInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetOrCreateTemptoken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetOrCreateTemptoken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetOrCreateTemptoken(ctx, req.(*api_types.TempTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ChangeAccountIDEmail(ctx context.Context, in *EmailChangeMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangeAccountIDEmail", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GenerateTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api_types.TempTokenInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GenerateTempToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GenerateTempToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GenerateTempToken(ctx, req.(*api_types.TempTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) DeleteAccount(ctx context.Context, in *UserReference, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/DeleteAccount", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GetTempTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api_types.TempTokenInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetTempTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetTempTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetTempTokens(ctx, req.(*api_types.TempTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ChangePreferredLanguage(ctx context.Context, in *LanguageChangeMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ChangePreferredLanguage", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_DeleteTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).DeleteTempToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DeleteTempToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).DeleteTempToken(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) InitiatePasswordReset(ctx context.Context, in *InitiateResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/InitiatePasswordReset", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_PurgeUserTempTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api_types.TempTokenInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).PurgeUserTempTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/PurgeUserTempTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).PurgeUserTempTokens(ctx, req.(*api_types.TempTokenInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) GetInfosForPasswordReset(ctx context.Context, in *GetInfosForResetPasswordMsg, opts ...grpc.CallOption) (*UserInfoForPWReset, error) {
-	out := new(UserInfoForPWReset)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/GetInfosForPasswordReset", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GetOrCreateUnsubscribeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeTokensReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetOrCreateUnsubscribeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetOrCreateUnsubscribeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetOrCreateUnsubscribeTokens(ctx, req.(*UnsubscribeTokensReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) ResetPassword(ctx context.Context, in *ResetPasswordMsg, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/ResetPassword", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetUser(ctx, req.(*UserReference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) SaveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/SaveProfile", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordChangeMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ChangePassword(ctx, req.(*PasswordChangeMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) RemoveProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveProfile", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ChangeAccountIDEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ChangeAccountIDEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangeAccountIDEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ChangeAccountIDEmail(ctx, req.(*EmailChangeMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) UseUnsubscribeToken(ctx context.Context, in *TempToken, opts ...grpc.CallOption) (*ServiceStatus, error) {
-	out := new(ServiceStatus)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UseUnsubscribeToken", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_PromoteContactInfoToAccountID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteContactInfoMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).PromoteContactInfoToAccountID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/PromoteContactInfoToAccountID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).PromoteContactInfoToAccountID(ctx, req.(*PromoteContactInfoMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) UpdateContactPreferences(ctx context.Context, in *ContactPreferencesMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/UpdateContactPreferences", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_LinkExternalIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkedIdentityMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).LinkExternalIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LinkExternalIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).LinkExternalIdentity(ctx, req.(*LinkedIdentityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) AddEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddEmail", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_UnlinkExternalIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkedIdentityMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).UnlinkExternalIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UnlinkExternalIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).UnlinkExternalIdentity(ctx, req.(*LinkedIdentityMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) RemoveEmail(ctx context.Context, in *ContactInfoMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveEmail", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserReference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).DeleteAccount(ctx, req.(*UserReference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) CreateUser(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/CreateUser", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ChangePreferredLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LanguageChangeMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ChangePreferredLanguage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangePreferredLanguage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ChangePreferredLanguage(ctx, req.(*LanguageChangeMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) AddRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/AddRoleForUser", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_InitiatePasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateResetPasswordMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).InitiatePasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/InitiatePasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).InitiatePasswordReset(ctx, req.(*InitiateResetPasswordMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) RemoveRoleForUser(ctx context.Context, in *RoleMsg, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/RemoveRoleForUser", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_GetInfosForPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfosForResetPasswordMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).GetInfosForPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetInfosForPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).GetInfosForPasswordReset(ctx, req.(*GetInfosForResetPasswordMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) FindNonParticipantUsers(ctx context.Context, in *FindNonParticipantUsersMsg, opts ...grpc.CallOption) (*UserListMsg, error) {
-	out := new(UserListMsg)
-	err := c.cc.Invoke(ctx, "/influenzanet.user_management_api.UserManagementApi/FindNonParticipantUsers", in, out, opts...)
-	if err != nil {
+func _UserManagementApi_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordMsg)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ResetPassword(ctx, req.(*ResetPasswordMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userManagementApiClient) StreamUsers(ctx context.Context, in *StreamUsersMsg, opts ...grpc.CallOption) (UserManagementApi_StreamUsersClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserManagementApi_serviceDesc.Streams[0], "/influenzanet.user_management_api.UserManagementApi/StreamUsers", opts...)
-	if err != nil {
+func _UserManagementApi_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &userManagementApiStreamUsersClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).SaveProfile(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SaveProfile",
 	}
-	return x, nil
-}
-
-type UserManagementApi_StreamUsersClient interface {
-	Recv() (*User, error)
-	grpc.ClientStream
-}
-
-type userManagementApiStreamUsersClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).SaveProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *userManagementApiStreamUsersClient) Recv() (*User, error) {
-	m := new(User)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _UserManagementApi_RemoveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-// UserManagementApiServer is the server API for UserManagementApi service.
-type UserManagementApiServer interface {
-	Status(context.Context, *empty.Empty) (*ServiceStatus, error)
-	// Auth and Token handling:
-	SendVerificationCode(context.Context, *SendVerificationCodeReq) (*ServiceStatus, error)
-	AutoValidateTempToken(context.Context, *AutoValidateReq) (*AutoValidateResponse, error)
-	LoginWithEmail(context.Context, *LoginWithEmailMsg) (*LoginResponse, error)
-	LoginWithExternalIDP(context.Context, *LoginWithExternalIDPMsg) (*LoginResponse, error)
-	SignupWithEmail(context.Context, *SignupWithEmailMsg) (*TokenResponse, error)
-	ValidateJWT(context.Context, *JWTRequest) (*api_types.TokenInfos, error)
-	RenewJWT(context.Context, *RefreshJWTRequest) (*TokenResponse, error)
-	RevokeAllRefreshTokens(context.Context, *RevokeRefreshTokensReq) (*ServiceStatus, error)
-	VerifyContact(context.Context, *TempToken) (*User, error)
-	ResendContactVerification(context.Context, *ResendContactVerificationReq) (*ServiceStatus, error)
-	ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error)
-	// Temporary Tokens handling:
-	GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
-	GenerateTempToken(context.Context, *api_types.TempTokenInfo) (*TempToken, error)
-	GetTempTokens(context.Context, *api_types.TempTokenInfo) (*api_types.TempTokenInfos, error)
-	DeleteTempToken(context.Context, *TempToken) (*ServiceStatus, error)
-	PurgeUserTempTokens(context.Context, *api_types.TempTokenInfo) (*ServiceStatus, error)
-	// User properties:
-	GetUser(context.Context, *UserReference) (*User, error)
-	// Account methods:
-	ChangePassword(context.Context, *PasswordChangeMsg) (*ServiceStatus, error)
-	ChangeAccountIDEmail(context.Context, *EmailChangeMsg) (*User, error)
-	DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error)
-	ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error)
-	// PW reset:
-	InitiatePasswordReset(context.Context, *InitiateResetPasswordMsg) (*ServiceStatus, error)
-	GetInfosForPasswordReset(context.Context, *GetInfosForResetPasswordMsg) (*UserInfoForPWReset, error)
-	ResetPassword(context.Context, *ResetPasswordMsg) (*ServiceStatus, error)
-	// Profile methods:
-	SaveProfile(context.Context, *ProfileRequest) (*User, error)
-	RemoveProfile(context.Context, *ProfileRequest) (*User, error)
-	// Contacts:
-	UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error)
-	UpdateContactPreferences(context.Context, *ContactPreferencesMsg) (*User, error)
-	AddEmail(context.Context, *ContactInfoMsg) (*User, error)
-	RemoveEmail(context.Context, *ContactInfoMsg) (*User, error)
-	// Management Methods:
-	CreateUser(context.Context, *CreateUserReq) (*User, error)
-	AddRoleForUser(context.Context, *RoleMsg) (*User, error)
-	RemoveRoleForUser(context.Context, *RoleMsg) (*User, error)
-	FindNonParticipantUsers(context.Context, *FindNonParticipantUsersMsg) (*UserListMsg, error)
-	StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error
-}
-
-// UnimplementedUserManagementApiServer can be embedded to have forward compatible implementations.
-type UnimplementedUserManagementApiServer struct {
-}
-
-func (*UnimplementedUserManagementApiServer) Status(context.Context, *empty.Empty) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
-}
-func (*UnimplementedUserManagementApiServer) SendVerificationCode(context.Context, *SendVerificationCodeReq) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendVerificationCode not implemented")
-}
-func (*UnimplementedUserManagementApiServer) AutoValidateTempToken(context.Context, *AutoValidateReq) (*AutoValidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AutoValidateTempToken not implemented")
-}
-func (*UnimplementedUserManagementApiServer) LoginWithEmail(context.Context, *LoginWithEmailMsg) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginWithEmail not implemented")
-}
-func (*UnimplementedUserManagementApiServer) LoginWithExternalIDP(context.Context, *LoginWithExternalIDPMsg) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginWithExternalIDP not implemented")
-}
-func (*UnimplementedUserManagementApiServer) SignupWithEmail(context.Context, *SignupWithEmailMsg) (*TokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignupWithEmail not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ValidateJWT(context.Context, *JWTRequest) (*api_types.TokenInfos, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateJWT not implemented")
-}
-func (*UnimplementedUserManagementApiServer) RenewJWT(context.Context, *RefreshJWTRequest) (*TokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenewJWT not implemented")
-}
-func (*UnimplementedUserManagementApiServer) RevokeAllRefreshTokens(context.Context, *RevokeRefreshTokensReq) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllRefreshTokens not implemented")
-}
-func (*UnimplementedUserManagementApiServer) VerifyContact(context.Context, *TempToken) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyContact not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ResendContactVerification(context.Context, *ResendContactVerificationReq) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResendContactVerification not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ValidateAppToken(context.Context, *AppTokenRequest) (*AppTokenValidation, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateAppToken not implemented")
-}
-func (*UnimplementedUserManagementApiServer) GetOrCreateTemptoken(context.Context, *api_types.TempTokenInfo) (*TempToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrCreateTemptoken not implemented")
-}
-func (*UnimplementedUserManagementApiServer) GenerateTempToken(context.Context, *api_types.TempTokenInfo) (*TempToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateTempToken not implemented")
-}
-func (*UnimplementedUserManagementApiServer) GetTempTokens(context.Context, *api_types.TempTokenInfo) (*api_types.TempTokenInfos, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTempTokens not implemented")
-}
-func (*UnimplementedUserManagementApiServer) DeleteTempToken(context.Context, *TempToken) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTempToken not implemented")
-}
-func (*UnimplementedUserManagementApiServer) PurgeUserTempTokens(context.Context, *api_types.TempTokenInfo) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserTempTokens not implemented")
-}
-func (*UnimplementedUserManagementApiServer) GetUser(context.Context, *UserReference) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ChangePassword(context.Context, *PasswordChangeMsg) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ChangeAccountIDEmail(context.Context, *EmailChangeMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeAccountIDEmail not implemented")
-}
-func (*UnimplementedUserManagementApiServer) DeleteAccount(context.Context, *UserReference) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ChangePreferredLanguage(context.Context, *LanguageChangeMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePreferredLanguage not implemented")
-}
-func (*UnimplementedUserManagementApiServer) InitiatePasswordReset(context.Context, *InitiateResetPasswordMsg) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InitiatePasswordReset not implemented")
-}
-func (*UnimplementedUserManagementApiServer) GetInfosForPasswordReset(context.Context, *GetInfosForResetPasswordMsg) (*UserInfoForPWReset, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfosForPasswordReset not implemented")
-}
-func (*UnimplementedUserManagementApiServer) ResetPassword(context.Context, *ResetPasswordMsg) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
-}
-func (*UnimplementedUserManagementApiServer) SaveProfile(context.Context, *ProfileRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaveProfile not implemented")
-}
-func (*UnimplementedUserManagementApiServer) RemoveProfile(context.Context, *ProfileRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveProfile not implemented")
-}
-func (*UnimplementedUserManagementApiServer) UseUnsubscribeToken(context.Context, *TempToken) (*ServiceStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UseUnsubscribeToken not implemented")
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RemoveProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RemoveProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) UpdateContactPreferences(context.Context, *ContactPreferencesMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateContactPreferences not implemented")
+
+func _UserManagementApi_UseUnsubscribeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).UseUnsubscribeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UseUnsubscribeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).UseUnsubscribeToken(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) AddEmail(context.Context, *ContactInfoMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEmail not implemented")
+
+func _UserManagementApi_ConfirmSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TempToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ConfirmSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ConfirmSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ConfirmSubscription(ctx, req.(*TempToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) RemoveEmail(context.Context, *ContactInfoMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmail not implemented")
+
+func _UserManagementApi_ReportEmailDeliveryEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveryEventMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).ReportEmailDeliveryEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ReportEmailDeliveryEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).ReportEmailDeliveryEvent(ctx, req.(*DeliveryEventMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) CreateUser(context.Context, *CreateUserReq) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func _UserManagementApi_UpdateContactPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactPreferencesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).UpdateContactPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UpdateContactPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).UpdateContactPreferences(ctx, req.(*ContactPreferencesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) AddRoleForUser(context.Context, *RoleMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRoleForUser not implemented")
+
+func _UserManagementApi_AddEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactInfoMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).AddEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).AddEmail(ctx, req.(*ContactInfoMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) RemoveRoleForUser(context.Context, *RoleMsg) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleForUser not implemented")
+
+func _UserManagementApi_RemoveEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactInfoMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RemoveEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RemoveEmail(ctx, req.(*ContactInfoMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) FindNonParticipantUsers(context.Context, *FindNonParticipantUsersMsg) (*UserListMsg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindNonParticipantUsers not implemented")
+
+func _UserManagementApi_AddPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactInfoMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).AddPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).AddPhone(ctx, req.(*ContactInfoMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedUserManagementApiServer) StreamUsers(*StreamUsersMsg, UserManagementApi_StreamUsersServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamUsers not implemented")
+
+func _UserManagementApi_RemovePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactInfoMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).RemovePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemovePhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).RemovePhone(ctx, req.(*ContactInfoMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserManagementApiServer(s *grpc.Server, srv UserManagementApiServer) {
-	s.RegisterService(&_UserManagementApi_serviceDesc, srv)
+func _UserManagementApi_VerifyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PhoneVerificationMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserManagementApiServer).VerifyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/VerifyPhone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserManagementApiServer).VerifyPhone(ctx, req.(*PhoneVerificationMsg))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _UserManagementApi_GetSubscriptionTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionTopicsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).Status(ctx, in)
+		return srv.(UserManagementApiServer).GetSubscriptionTopics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/Status",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetSubscriptionTopics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).Status(ctx, req.(*empty.Empty))
+		return srv.(UserManagementApiServer).GetSubscriptionTopics(ctx, req.(*GetSubscriptionTopicsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_SendVerificationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendVerificationCodeReq)
+func _UserManagementApi_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).SendVerificationCode(ctx, in)
+		return srv.(UserManagementApiServer).CreateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SendVerificationCode",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).SendVerificationCode(ctx, req.(*SendVerificationCodeReq))
+		return srv.(UserManagementApiServer).CreateUser(ctx, req.(*CreateUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_AutoValidateTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AutoValidateReq)
+func _UserManagementApi_ImportUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserManagementApiServer).ImportUsers(&userManagementApiImportUsersServer{stream})
+}
+
+type UserManagementApi_ImportUsersServer interface {
+	SendAndClose(*ImportUsersReport) error
+	Recv() (*ImportUsersMsg, error)
+	grpc.ServerStream
+}
+
+type userManagementApiImportUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userManagementApiImportUsersServer) SendAndClose(m *ImportUsersReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userManagementApiImportUsersServer) Recv() (*ImportUsersMsg, error) {
+	m := new(ImportUsersMsg)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserManagementApi_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).AutoValidateTempToken(ctx, in)
+		return srv.(UserManagementApiServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AutoValidateTempToken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateServiceAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).AutoValidateTempToken(ctx, req.(*AutoValidateReq))
+		return srv.(UserManagementApiServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_LoginWithEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginWithEmailMsg)
+func _UserManagementApi_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).LoginWithEmail(ctx, in)
+		return srv.(UserManagementApiServer).CreateAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithEmail",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateAPIKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).LoginWithEmail(ctx, req.(*LoginWithEmailMsg))
+		return srv.(UserManagementApiServer).CreateAPIKey(ctx, req.(*CreateAPIKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_LoginWithExternalIDP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginWithExternalIDPMsg)
+func _UserManagementApi_GetAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPIKeysReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).LoginWithExternalIDP(ctx, in)
+		return srv.(UserManagementApiServer).GetAPIKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithExternalIDP",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetAPIKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).LoginWithExternalIDP(ctx, req.(*LoginWithExternalIDPMsg))
+		return srv.(UserManagementApiServer).GetAPIKeys(ctx, req.(*GetAPIKeysReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_SignupWithEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignupWithEmailMsg)
+func _UserManagementApi_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).SignupWithEmail(ctx, in)
+		return srv.(UserManagementApiServer).RevokeAPIKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SignupWithEmail",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RevokeAPIKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).SignupWithEmail(ctx, req.(*SignupWithEmailMsg))
+		return srv.(UserManagementApiServer).RevokeAPIKey(ctx, req.(*APIKeyMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ValidateJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JWTRequest)
+func _UserManagementApi_GetInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ValidateJWT(ctx, in)
+		return srv.(UserManagementApiServer).GetInvitations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ValidateJWT",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetInvitations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ValidateJWT(ctx, req.(*JWTRequest))
+		return srv.(UserManagementApiServer).GetInvitations(ctx, req.(*GetInvitationsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_RenewJWT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshJWTRequest)
+func _UserManagementApi_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).RenewJWT(ctx, in)
+		return srv.(UserManagementApiServer).ResendInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RenewJWT",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResendInvitation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).RenewJWT(ctx, req.(*RefreshJWTRequest))
+		return srv.(UserManagementApiServer).ResendInvitation(ctx, req.(*InvitationMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_RevokeAllRefreshTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeRefreshTokensReq)
+func _UserManagementApi_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).RevokeAllRefreshTokens(ctx, in)
+		return srv.(UserManagementApiServer).RevokeInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RevokeAllRefreshTokens",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RevokeInvitation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).RevokeAllRefreshTokens(ctx, req.(*RevokeRefreshTokensReq))
+		return srv.(UserManagementApiServer).RevokeInvitation(ctx, req.(*InvitationMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_VerifyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TempToken)
+func _UserManagementApi_MoveUserToInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveUserMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).VerifyContact(ctx, in)
+		return srv.(UserManagementApiServer).MoveUserToInstance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/VerifyContact",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/MoveUserToInstance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).VerifyContact(ctx, req.(*TempToken))
+		return srv.(UserManagementApiServer).MoveUserToInstance(ctx, req.(*MoveUserMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ResendContactVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResendContactVerificationReq)
+func _UserManagementApi_MergeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeAccountsMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ResendContactVerification(ctx, in)
+		return srv.(UserManagementApiServer).MergeAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResendContactVerification",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/MergeAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ResendContactVerification(ctx, req.(*ResendContactVerificationReq))
+		return srv.(UserManagementApiServer).MergeAccounts(ctx, req.(*MergeAccountsMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ValidateAppToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppTokenRequest)
+func _UserManagementApi_ImpersonateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ValidateAppToken(ctx, in)
+		return srv.(UserManagementApiServer).ImpersonateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ValidateAppToken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ImpersonateUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ValidateAppToken(ctx, req.(*AppTokenRequest))
+		return srv.(UserManagementApiServer).ImpersonateUser(ctx, req.(*ImpersonateUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_GetOrCreateTemptoken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api_types.TempTokenInfo)
+func _UserManagementApi_AddRoleForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).GetOrCreateTemptoken(ctx, in)
+		return srv.(UserManagementApiServer).AddRoleForUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetOrCreateTemptoken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddRoleForUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).GetOrCreateTemptoken(ctx, req.(*api_types.TempTokenInfo))
+		return srv.(UserManagementApiServer).AddRoleForUser(ctx, req.(*RoleMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_GenerateTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api_types.TempTokenInfo)
+func _UserManagementApi_RemoveRoleForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).GenerateTempToken(ctx, in)
+		return srv.(UserManagementApiServer).RemoveRoleForUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GenerateTempToken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveRoleForUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).GenerateTempToken(ctx, req.(*api_types.TempTokenInfo))
+		return srv.(UserManagementApiServer).RemoveRoleForUser(ctx, req.(*RoleMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_GetTempTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api_types.TempTokenInfo)
+func _UserManagementApi_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).GetTempTokens(ctx, in)
+		return srv.(UserManagementApiServer).GrantRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetTempTokens",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GrantRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).GetTempTokens(ctx, req.(*api_types.TempTokenInfo))
+		return srv.(UserManagementApiServer).GrantRole(ctx, req.(*RoleMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_DeleteTempToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TempToken)
+func _UserManagementApi_GetRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleGrantsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).DeleteTempToken(ctx, in)
+		return srv.(UserManagementApiServer).GetRoleGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DeleteTempToken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetRoleGrants",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).DeleteTempToken(ctx, req.(*TempToken))
+		return srv.(UserManagementApiServer).GetRoleGrants(ctx, req.(*GetRoleGrantsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_PurgeUserTempTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api_types.TempTokenInfo)
+func _UserManagementApi_ApproveRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleGrantDecisionMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).PurgeUserTempTokens(ctx, in)
+		return srv.(UserManagementApiServer).ApproveRoleGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/PurgeUserTempTokens",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ApproveRoleGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).PurgeUserTempTokens(ctx, req.(*api_types.TempTokenInfo))
+		return srv.(UserManagementApiServer).ApproveRoleGrant(ctx, req.(*RoleGrantDecisionMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserReference)
+func _UserManagementApi_RejectRoleGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleGrantDecisionMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).GetUser(ctx, in)
+		return srv.(UserManagementApiServer).RejectRoleGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetUser",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RejectRoleGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).GetUser(ctx, req.(*UserReference))
+		return srv.(UserManagementApiServer).RejectRoleGrant(ctx, req.(*RoleGrantDecisionMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordChangeMsg)
+func _UserManagementApi_AddRoleScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleScopeMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ChangePassword(ctx, in)
+		return srv.(UserManagementApiServer).AddRoleScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangePassword",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddRoleScope",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ChangePassword(ctx, req.(*PasswordChangeMsg))
+		return srv.(UserManagementApiServer).AddRoleScope(ctx, req.(*RoleScopeMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ChangeAccountIDEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmailChangeMsg)
+func _UserManagementApi_RemoveRoleScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleScopeMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ChangeAccountIDEmail(ctx, in)
+		return srv.(UserManagementApiServer).RemoveRoleScope(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangeAccountIDEmail",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveRoleScope",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ChangeAccountIDEmail(ctx, req.(*EmailChangeMsg))
+		return srv.(UserManagementApiServer).RemoveRoleScope(ctx, req.(*RoleScopeMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserReference)
+func _UserManagementApi_FindNonParticipantUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNonParticipantUsersMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).DeleteAccount(ctx, in)
+		return srv.(UserManagementApiServer).FindNonParticipantUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/DeleteAccount",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/FindNonParticipantUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).DeleteAccount(ctx, req.(*UserReference))
+		return srv.(UserManagementApiServer).FindNonParticipantUsers(ctx, req.(*FindNonParticipantUsersMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ChangePreferredLanguage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LanguageChangeMsg)
+func _UserManagementApi_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ChangePreferredLanguage(ctx, in)
+		return srv.(UserManagementApiServer).SearchUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ChangePreferredLanguage",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SearchUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ChangePreferredLanguage(ctx, req.(*LanguageChangeMsg))
+		return srv.(UserManagementApiServer).SearchUsers(ctx, req.(*SearchUsersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_InitiatePasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitiateResetPasswordMsg)
+func _UserManagementApi_StreamUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUsersMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserManagementApiServer).StreamUsers(m, &userManagementApiStreamUsersServer{stream})
+}
+
+type UserManagementApi_StreamUsersServer interface {
+	Send(*User) error
+	grpc.ServerStream
+}
+
+type userManagementApiStreamUsersServer struct {
+	grpc.ServerStream
+}
+
+func (x *userManagementApiStreamUsersServer) Send(m *User) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserManagementApi_StreamUserBatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamUsersMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserManagementApiServer).StreamUserBatches(m, &userManagementApiStreamUserBatchesServer{stream})
+}
+
+type UserManagementApi_StreamUserBatchesServer interface {
+	Send(*UserBatch) error
+	grpc.ServerStream
+}
+
+type userManagementApiStreamUserBatchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *userManagementApiStreamUserBatchesServer) Send(m *UserBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UserManagementApi_SaveSubscriptionTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionTopicMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).InitiatePasswordReset(ctx, in)
+		return srv.(UserManagementApiServer).SaveSubscriptionTopic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/InitiatePasswordReset",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SaveSubscriptionTopic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).InitiatePasswordReset(ctx, req.(*InitiateResetPasswordMsg))
+		return srv.(UserManagementApiServer).SaveSubscriptionTopic(ctx, req.(*SubscriptionTopicMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_GetInfosForPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfosForResetPasswordMsg)
+func _UserManagementApi_RemoveSubscriptionTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionTopicMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).GetInfosForPasswordReset(ctx, in)
+		return srv.(UserManagementApiServer).RemoveSubscriptionTopic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetInfosForPasswordReset",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveSubscriptionTopic",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).GetInfosForPasswordReset(ctx, req.(*GetInfosForResetPasswordMsg))
+		return srv.(UserManagementApiServer).RemoveSubscriptionTopic(ctx, req.(*SubscriptionTopicMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordMsg)
+func _UserManagementApi_GetRoleDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoleDefinitionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).ResetPassword(ctx, in)
+		return srv.(UserManagementApiServer).GetRoleDefinitions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ResetPassword",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetRoleDefinitions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).ResetPassword(ctx, req.(*ResetPasswordMsg))
+		return srv.(UserManagementApiServer).GetRoleDefinitions(ctx, req.(*GetRoleDefinitionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_SaveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileRequest)
+func _UserManagementApi_SaveRoleDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleDefinitionMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).SaveProfile(ctx, in)
+		return srv.(UserManagementApiServer).SaveRoleDefinition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SaveProfile",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/SaveRoleDefinition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).SaveProfile(ctx, req.(*ProfileRequest))
+		return srv.(UserManagementApiServer).SaveRoleDefinition(ctx, req.(*RoleDefinitionMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_RemoveProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileRequest)
+func _UserManagementApi_RemoveRoleDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleDefinitionMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).RemoveProfile(ctx, in)
+		return srv.(UserManagementApiServer).RemoveRoleDefinition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveProfile",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveRoleDefinition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).RemoveProfile(ctx, req.(*ProfileRequest))
+		return srv.(UserManagementApiServer).RemoveRoleDefinition(ctx, req.(*RoleDefinitionMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_UseUnsubscribeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TempToken)
+func _UserManagementApi_CreateAppToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).UseUnsubscribeToken(ctx, in)
+		return srv.(UserManagementApiServer).CreateAppToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UseUnsubscribeToken",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateAppToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).UseUnsubscribeToken(ctx, req.(*TempToken))
+		return srv.(UserManagementApiServer).CreateAppToken(ctx, req.(*CreateAppTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_UpdateContactPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContactPreferencesMsg)
+func _UserManagementApi_GetAppTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppTokensReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).UpdateContactPreferences(ctx, in)
+		return srv.(UserManagementApiServer).GetAppTokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UpdateContactPreferences",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetAppTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).UpdateContactPreferences(ctx, req.(*ContactPreferencesMsg))
+		return srv.(UserManagementApiServer).GetAppTokens(ctx, req.(*GetAppTokensReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_AddEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContactInfoMsg)
+func _UserManagementApi_RotateAppToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppTokenMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).AddEmail(ctx, in)
+		return srv.(UserManagementApiServer).RotateAppToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddEmail",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RotateAppToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).AddEmail(ctx, req.(*ContactInfoMsg))
+		return srv.(UserManagementApiServer).RotateAppToken(ctx, req.(*AppTokenMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_RemoveEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContactInfoMsg)
+func _UserManagementApi_RevokeAppToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppTokenMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).RemoveEmail(ctx, in)
+		return srv.(UserManagementApiServer).RevokeAppToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveEmail",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RevokeAppToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).RemoveEmail(ctx, req.(*ContactInfoMsg))
+		return srv.(UserManagementApiServer).RevokeAppToken(ctx, req.(*AppTokenMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserReq)
+func _UserManagementApi_CreateInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).CreateUser(ctx, in)
+		return srv.(UserManagementApiServer).CreateInstance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateUser",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/CreateInstance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).CreateUser(ctx, req.(*CreateUserReq))
+		return srv.(UserManagementApiServer).CreateInstance(ctx, req.(*InstanceMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_AddRoleForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleMsg)
+func _UserManagementApi_UpdateInstanceSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).AddRoleForUser(ctx, in)
+		return srv.(UserManagementApiServer).UpdateInstanceSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/AddRoleForUser",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/UpdateInstanceSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).AddRoleForUser(ctx, req.(*RoleMsg))
+		return srv.(UserManagementApiServer).UpdateInstanceSettings(ctx, req.(*InstanceMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_RemoveRoleForUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleMsg)
+func _UserManagementApi_GetInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstancesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).RemoveRoleForUser(ctx, in)
+		return srv.(UserManagementApiServer).GetInstances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/RemoveRoleForUser",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/GetInstances",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).RemoveRoleForUser(ctx, req.(*RoleMsg))
+		return srv.(UserManagementApiServer).GetInstances(ctx, req.(*GetInstancesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_FindNonParticipantUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindNonParticipantUsersMsg)
+func _UserManagementApi_ArchiveInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserManagementApiServer).FindNonParticipantUsers(ctx, in)
+		return srv.(UserManagementApiServer).ArchiveInstance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/influenzanet.user_management_api.UserManagementApi/FindNonParticipantUsers",
+		FullMethod: "/influenzanet.user_management_api.UserManagementApi/ArchiveInstance",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserManagementApiServer).FindNonParticipantUsers(ctx, req.(*FindNonParticipantUsersMsg))
+		return srv.(UserManagementApiServer).ArchiveInstance(ctx, req.(*InstanceMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserManagementApi_StreamUsers_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamUsersMsg)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(UserManagementApiServer).StreamUsers(m, &userManagementApiStreamUsersServer{stream})
-}
-
-type UserManagementApi_StreamUsersServer interface {
-	Send(*User) error
-	grpc.ServerStream
-}
-
-type userManagementApiStreamUsersServer struct {
-	grpc.ServerStream
-}
-
-func (x *userManagementApiStreamUsersServer) Send(m *User) error {
-	return x.ServerStream.SendMsg(m)
-}
-
 var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "influenzanet.user_management_api.UserManagementApi",
 	HandlerType: (*UserManagementApiServer)(nil),
@@ -4676,6 +11717,10 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoginWithEmail",
 			Handler:    _UserManagementApi_LoginWithEmail_Handler,
 		},
+		{
+			MethodName: "LoginWithAPIKey",
+			Handler:    _UserManagementApi_LoginWithAPIKey_Handler,
+		},
 		{
 			MethodName: "LoginWithExternalIDP",
 			Handler:    _UserManagementApi_LoginWithExternalIDP_Handler,
@@ -4728,6 +11773,10 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeUserTempTokens",
 			Handler:    _UserManagementApi_PurgeUserTempTokens_Handler,
 		},
+		{
+			MethodName: "GetOrCreateUnsubscribeTokens",
+			Handler:    _UserManagementApi_GetOrCreateUnsubscribeTokens_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserManagementApi_GetUser_Handler,
@@ -4740,6 +11789,18 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeAccountIDEmail",
 			Handler:    _UserManagementApi_ChangeAccountIDEmail_Handler,
 		},
+		{
+			MethodName: "PromoteContactInfoToAccountID",
+			Handler:    _UserManagementApi_PromoteContactInfoToAccountID_Handler,
+		},
+		{
+			MethodName: "LinkExternalIdentity",
+			Handler:    _UserManagementApi_LinkExternalIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkExternalIdentity",
+			Handler:    _UserManagementApi_UnlinkExternalIdentity_Handler,
+		},
 		{
 			MethodName: "DeleteAccount",
 			Handler:    _UserManagementApi_DeleteAccount_Handler,
@@ -4772,6 +11833,14 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UseUnsubscribeToken",
 			Handler:    _UserManagementApi_UseUnsubscribeToken_Handler,
 		},
+		{
+			MethodName: "ConfirmSubscription",
+			Handler:    _UserManagementApi_ConfirmSubscription_Handler,
+		},
+		{
+			MethodName: "ReportEmailDeliveryEvent",
+			Handler:    _UserManagementApi_ReportEmailDeliveryEvent_Handler,
+		},
 		{
 			MethodName: "UpdateContactPreferences",
 			Handler:    _UserManagementApi_UpdateContactPreferences_Handler,
@@ -4784,10 +11853,66 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveEmail",
 			Handler:    _UserManagementApi_RemoveEmail_Handler,
 		},
+		{
+			MethodName: "AddPhone",
+			Handler:    _UserManagementApi_AddPhone_Handler,
+		},
+		{
+			MethodName: "RemovePhone",
+			Handler:    _UserManagementApi_RemovePhone_Handler,
+		},
+		{
+			MethodName: "VerifyPhone",
+			Handler:    _UserManagementApi_VerifyPhone_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionTopics",
+			Handler:    _UserManagementApi_GetSubscriptionTopics_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _UserManagementApi_CreateUser_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _UserManagementApi_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _UserManagementApi_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "GetAPIKeys",
+			Handler:    _UserManagementApi_GetAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _UserManagementApi_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "GetInvitations",
+			Handler:    _UserManagementApi_GetInvitations_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _UserManagementApi_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _UserManagementApi_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "MoveUserToInstance",
+			Handler:    _UserManagementApi_MoveUserToInstance_Handler,
+		},
+		{
+			MethodName: "MergeAccounts",
+			Handler:    _UserManagementApi_MergeAccounts_Handler,
+		},
+		{
+			MethodName: "ImpersonateUser",
+			Handler:    _UserManagementApi_ImpersonateUser_Handler,
+		},
 		{
 			MethodName: "AddRoleForUser",
 			Handler:    _UserManagementApi_AddRoleForUser_Handler,
@@ -4796,17 +11921,107 @@ var _UserManagementApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleForUser",
 			Handler:    _UserManagementApi_RemoveRoleForUser_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _UserManagementApi_GrantRole_Handler,
+		},
+		{
+			MethodName: "GetRoleGrants",
+			Handler:    _UserManagementApi_GetRoleGrants_Handler,
+		},
+		{
+			MethodName: "ApproveRoleGrant",
+			Handler:    _UserManagementApi_ApproveRoleGrant_Handler,
+		},
+		{
+			MethodName: "RejectRoleGrant",
+			Handler:    _UserManagementApi_RejectRoleGrant_Handler,
+		},
+		{
+			MethodName: "AddRoleScope",
+			Handler:    _UserManagementApi_AddRoleScope_Handler,
+		},
+		{
+			MethodName: "RemoveRoleScope",
+			Handler:    _UserManagementApi_RemoveRoleScope_Handler,
+		},
 		{
 			MethodName: "FindNonParticipantUsers",
 			Handler:    _UserManagementApi_FindNonParticipantUsers_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserManagementApi_SearchUsers_Handler,
+		},
+		{
+			MethodName: "SaveSubscriptionTopic",
+			Handler:    _UserManagementApi_SaveSubscriptionTopic_Handler,
+		},
+		{
+			MethodName: "RemoveSubscriptionTopic",
+			Handler:    _UserManagementApi_RemoveSubscriptionTopic_Handler,
+		},
+		{
+			MethodName: "GetRoleDefinitions",
+			Handler:    _UserManagementApi_GetRoleDefinitions_Handler,
+		},
+		{
+			MethodName: "SaveRoleDefinition",
+			Handler:    _UserManagementApi_SaveRoleDefinition_Handler,
+		},
+		{
+			MethodName: "RemoveRoleDefinition",
+			Handler:    _UserManagementApi_RemoveRoleDefinition_Handler,
+		},
+		{
+			MethodName: "CreateAppToken",
+			Handler:    _UserManagementApi_CreateAppToken_Handler,
+		},
+		{
+			MethodName: "GetAppTokens",
+			Handler:    _UserManagementApi_GetAppTokens_Handler,
+		},
+		{
+			MethodName: "RotateAppToken",
+			Handler:    _UserManagementApi_RotateAppToken_Handler,
+		},
+		{
+			MethodName: "RevokeAppToken",
+			Handler:    _UserManagementApi_RevokeAppToken_Handler,
+		},
+		{
+			MethodName: "CreateInstance",
+			Handler:    _UserManagementApi_CreateInstance_Handler,
+		},
+		{
+			MethodName: "UpdateInstanceSettings",
+			Handler:    _UserManagementApi_UpdateInstanceSettings_Handler,
+		},
+		{
+			MethodName: "GetInstances",
+			Handler:    _UserManagementApi_GetInstances_Handler,
+		},
+		{
+			MethodName: "ArchiveInstance",
+			Handler:    _UserManagementApi_ArchiveInstance_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportUsers",
+			Handler:       _UserManagementApi_ImportUsers_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamUsers",
 			Handler:       _UserManagementApi_StreamUsers_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamUserBatches",
+			Handler:       _UserManagementApi_StreamUserBatches_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "user_management/user-management-service.proto",
 }
@@ -0,0 +1,200 @@
+// Package authz defines the permissions checked by the endpoints and how the roles of an instance map to them.
+package authz
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+const (
+	PERMISSION_USERS_READ        = "users.read"
+	PERMISSION_USERS_CREATE      = "users.create"
+	PERMISSION_USERS_UPDATE      = "users.update"
+	PERMISSION_USERS_DELETE      = "users.delete"
+	PERMISSION_USERS_IMPERSONATE = "users.impersonate"
+	PERMISSION_ROLES_ASSIGN      = "roles.assign"
+	PERMISSION_ROLES_MANAGE      = "roles.manage"
+	PERMISSION_TOPICS_MANAGE     = "topics.manage"
+	PERMISSION_APPTOKENS_MANAGE  = "apptokens.manage"
+	PERMISSION_DELIVERY_REPORT   = "delivery.report" // for services reporting bounces and complaints
+
+	// PERMISSION_INSTANCES_MANAGE is not bound to the instance of the token, only the super admin role grants it
+	PERMISSION_INSTANCES_MANAGE = "instances.manage"
+)
+
+// ROLE_SUPER_ADMIN can manage all instances, the role can only be assigned by another super admin
+const ROLE_SUPER_ADMIN = "SUPER_ADMIN"
+
+// Keys of the claims that are passed on in the payload of validated tokens
+const (
+	PAYLOAD_KEY_PERMISSIONS  = "permissions"
+	PAYLOAD_KEY_SCOPES       = "scopes"
+	PAYLOAD_KEY_IMPERSONATOR = "impersonator"
+)
+
+// AllPermissions lists the permissions known to the service that role definitions of an instance can grant
+var AllPermissions = []string{
+	PERMISSION_USERS_READ,
+	PERMISSION_USERS_CREATE,
+	PERMISSION_USERS_UPDATE,
+	PERMISSION_USERS_DELETE,
+	PERMISSION_USERS_IMPERSONATE,
+	PERMISSION_ROLES_ASSIGN,
+	PERMISSION_ROLES_MANAGE,
+	PERMISSION_TOPICS_MANAGE,
+	PERMISSION_APPTOKENS_MANAGE,
+	PERMISSION_DELIVERY_REPORT,
+}
+
+// IsKnownPermission checks if the permission is used by the service
+func IsKnownPermission(permission string) bool {
+	return HasPermission(AllPermissions, permission)
+}
+
+// StandardRoles can be assigned in every instance, other roles need a role definition of the instance
+var StandardRoles = []string{
+	constants.USER_ROLE_PARTICIPANT,
+	constants.USER_ROLE_RESEARCHER,
+	constants.USER_ROLE_ADMIN,
+	constants.USER_ROLE_SERVICE_ACCOUNT,
+}
+
+// BuiltInRoleDefinitions returns the definitions every instance has and cannot change
+func BuiltInRoleDefinitions() []models.RoleDefinition {
+	return []models.RoleDefinition{
+		{
+			Role:             constants.USER_ROLE_ADMIN,
+			Description:      "all permissions",
+			Permissions:      append([]string{}, AllPermissions...),
+			RequiresApproval: true,
+			BuiltIn:          true,
+		},
+		{
+			Role:             ROLE_SUPER_ADMIN,
+			Description:      "all permissions and management of the instances",
+			Permissions:      append([]string{PERMISSION_INSTANCES_MANAGE}, AllPermissions...),
+			RequiresApproval: true,
+			BuiltIn:          true,
+		},
+	}
+}
+
+// IsBuiltInRole checks if the role has a built-in definition
+func IsBuiltInRole(role string) bool {
+	for _, def := range BuiltInRoleDefinitions() {
+		if def.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// findRoleDefinition looks up the definition of the role, built-in definitions win over the instance's ones
+func findRoleDefinition(role string, instanceDefs []models.RoleDefinition) (models.RoleDefinition, bool) {
+	for _, def := range append(BuiltInRoleDefinitions(), instanceDefs...) {
+		if def.Role == role {
+			return def, true
+		}
+	}
+	return models.RoleDefinition{}, false
+}
+
+// ResolvePermissions returns the permissions granted by the roles according to the built-in and the instance's definitions.
+// Roles without a definition grant no permission.
+func ResolvePermissions(roles []string, instanceDefs []models.RoleDefinition) []string {
+	permissions := []string{}
+	for _, role := range roles {
+		def, ok := findRoleDefinition(role, instanceDefs)
+		if !ok {
+			continue
+		}
+		for _, p := range def.Permissions {
+			if !HasPermission(permissions, p) {
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	return permissions
+}
+
+// RestrictPermissions keeps the permissions that are in the allowed list, all of them if the list is empty
+func RestrictPermissions(permissions []string, allowed []string) []string {
+	if len(allowed) == 0 {
+		return permissions
+	}
+	res := []string{}
+	for _, p := range permissions {
+		if HasPermission(allowed, p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// IsAssignableRole checks if the role is a standard role or defined by the instance
+func IsAssignableRole(role string, instanceDefs []models.RoleDefinition) bool {
+	for _, r := range StandardRoles {
+		if r == role {
+			return true
+		}
+	}
+	_, ok := findRoleDefinition(role, instanceDefs)
+	return ok
+}
+
+// RequiredPermissionToAssign returns the permission needed in addition to roles.assign to give the role, empty if none
+func RequiredPermissionToAssign(role string) string {
+	if role == ROLE_SUPER_ADMIN {
+		return PERMISSION_INSTANCES_MANAGE
+	}
+	return ""
+}
+
+// RequiresApproval checks if granting the role has to be approved by a second admin
+func RequiresApproval(role string, instanceDefs []models.RoleDefinition) bool {
+	def, ok := findRoleDefinition(role, instanceDefs)
+	return ok && def.RequiresApproval
+}
+
+// PermissionsFromPayload reads the permissions claim from the payload of a validated token, ok is false if the token has none
+func PermissionsFromPayload(payload map[string]string) (permissions []string, ok bool) {
+	val, ok := payload[PAYLOAD_KEY_PERMISSIONS]
+	if !ok {
+		return nil, false
+	}
+	if val == "" {
+		return []string{}, true
+	}
+	return strings.Split(val, ","), true
+}
+
+// ScopesToPayload encodes the scopes claim (role -> scopes) for the payload of validated tokens
+func ScopesToPayload(scopes map[string][]string) (string, error) {
+	b, err := json.Marshal(scopes)
+	return string(b), err
+}
+
+// ScopesFromPayload reads the scopes claim from the payload of a validated token. Roles without entry are not
+// restricted.
+func ScopesFromPayload(payload map[string]string) (map[string][]string, error) {
+	scopes := map[string][]string{}
+	val, ok := payload[PAYLOAD_KEY_SCOPES]
+	if !ok || val == "" {
+		return scopes, nil
+	}
+	err := json.Unmarshal([]byte(val), &scopes)
+	return scopes, err
+}
+
+// HasPermission checks if the permission is in the list
+func HasPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
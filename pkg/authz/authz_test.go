@@ -0,0 +1,125 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	defs := []models.RoleDefinition{
+		{Role: "RESEARCHER", Permissions: []string{PERMISSION_USERS_READ}},
+		{Role: "SUPPORT", Permissions: []string{PERMISSION_USERS_READ, PERMISSION_USERS_CREATE}},
+		{Role: "ADMIN", Permissions: []string{}},
+	}
+
+	t.Run("role without definition", func(t *testing.T) {
+		p := ResolvePermissions([]string{"PARTICIPANT"}, defs)
+		if len(p) != 0 {
+			t.Errorf("unexpected permissions: %v", p)
+		}
+	})
+
+	t.Run("roles are combined", func(t *testing.T) {
+		p := ResolvePermissions([]string{"PARTICIPANT", "RESEARCHER", "SUPPORT"}, defs)
+		if len(p) != 2 || !HasPermission(p, PERMISSION_USERS_READ) || !HasPermission(p, PERMISSION_USERS_CREATE) {
+			t.Errorf("unexpected permissions: %v", p)
+		}
+	})
+
+	t.Run("built-in definition cannot be overridden", func(t *testing.T) {
+		p := ResolvePermissions([]string{"ADMIN"}, defs)
+		if len(p) != len(AllPermissions) {
+			t.Errorf("unexpected permissions: %v", p)
+		}
+	})
+}
+
+func TestSuperAdminRole(t *testing.T) {
+	p := ResolvePermissions([]string{ROLE_SUPER_ADMIN}, nil)
+	if !HasPermission(p, PERMISSION_INSTANCES_MANAGE) || len(p) != len(AllPermissions)+1 {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+	if HasPermission(ResolvePermissions([]string{"ADMIN"}, nil), PERMISSION_INSTANCES_MANAGE) {
+		t.Error("admin should not manage instances")
+	}
+	if IsKnownPermission(PERMISSION_INSTANCES_MANAGE) {
+		t.Error("role definitions should not grant instance management")
+	}
+	if RequiredPermissionToAssign(ROLE_SUPER_ADMIN) != PERMISSION_INSTANCES_MANAGE || RequiredPermissionToAssign("ADMIN") != "" {
+		t.Error("unexpected permission required to assign roles")
+	}
+}
+
+func TestPermissionsFromPayload(t *testing.T) {
+	if _, ok := PermissionsFromPayload(map[string]string{"roles": "ADMIN"}); ok {
+		t.Error("token without permissions claim")
+	}
+	p, ok := PermissionsFromPayload(map[string]string{"permissions": ""})
+	if !ok || len(p) != 0 {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+	p, ok = PermissionsFromPayload(map[string]string{"permissions": "users.read,roles.assign"})
+	if !ok || len(p) != 2 || p[1] != PERMISSION_ROLES_ASSIGN {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+}
+
+func TestRoleAssignmentRules(t *testing.T) {
+	defs := []models.RoleDefinition{
+		{Role: "RESEARCHER", Permissions: []string{PERMISSION_USERS_READ}, RequiresApproval: true},
+		{Role: "SUPPORT", Permissions: []string{PERMISSION_USERS_READ}},
+		{Role: "ADMIN", RequiresApproval: false},
+	}
+
+	for _, role := range []string{"PARTICIPANT", "RESEARCHER", "ADMIN", "SERVICE", "SUPPORT"} {
+		if !IsAssignableRole(role, defs) {
+			t.Errorf("%s should be assignable", role)
+		}
+	}
+	if IsAssignableRole("SUPERUSER", defs) {
+		t.Error("undefined role should not be assignable")
+	}
+
+	if !RequiresApproval("ADMIN", defs) || !RequiresApproval("RESEARCHER", defs) {
+		t.Error("should require approval")
+	}
+	if RequiresApproval("SUPPORT", defs) || RequiresApproval("PARTICIPANT", defs) {
+		t.Error("should not require approval")
+	}
+}
+
+func TestScopesPayload(t *testing.T) {
+	scopes, err := ScopesFromPayload(map[string]string{"roles": "RESEARCHER"})
+	if err != nil || len(scopes) != 0 {
+		t.Errorf("unexpected scopes: %v, %v", scopes, err)
+	}
+
+	val, err := ScopesToPayload(map[string][]string{"RESEARCHER": {"study1", "study2"}})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	scopes, err = ScopesFromPayload(map[string]string{"scopes": val})
+	if err != nil || len(scopes["RESEARCHER"]) != 2 || scopes["RESEARCHER"][1] != "study2" {
+		t.Errorf("unexpected scopes: %v, %v", scopes, err)
+	}
+
+	if _, err := ScopesFromPayload(map[string]string{"scopes": "study1"}); err == nil {
+		t.Error("should fail for malformed claim")
+	}
+}
+
+func TestRestrictPermissions(t *testing.T) {
+	permissions := []string{PERMISSION_USERS_READ, PERMISSION_USERS_UPDATE}
+	if p := RestrictPermissions(permissions, nil); len(p) != 2 {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+	p := RestrictPermissions(permissions, []string{PERMISSION_USERS_READ, PERMISSION_ROLES_MANAGE})
+	if len(p) != 1 || p[0] != PERMISSION_USERS_READ {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+	if p := RestrictPermissions(permissions, []string{PERMISSION_ROLES_MANAGE}); p == nil || len(p) != 0 {
+		t.Errorf("unexpected permissions: %v", p)
+	}
+}
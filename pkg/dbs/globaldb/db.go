@@ -62,6 +62,14 @@ func (dbService *GlobalDBService) collectionRefInstances() *mongo.Collection {
 	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("instances")
 }
 
+func (dbService *GlobalDBService) collectionRefSubscriptionTopics() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("subscription-topics")
+}
+
+func (dbService *GlobalDBService) collectionRefRoleDefinitions() *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + "global-infos").Collection("role-definitions")
+}
+
 // DB utils
 func (dbService *GlobalDBService) getContext() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(dbService.timeout)*time.Second)
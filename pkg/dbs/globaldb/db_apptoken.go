@@ -1,23 +1,177 @@
 package globaldb
 
 import (
+	"time"
+
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// FindAppToken looks up the entry of the token by its hash, or the plain token for entries that were added by hand
 func (dbService *GlobalDBService) FindAppToken(token string) (appTokenInfos models.AppToken, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	filter := bson.M{"tokens": token}
+	filter := bson.M{"$or": bson.A{
+		bson.M{"tokenHash": tokens.HashSecret(token)},
+		bson.M{"tokens": token},
+	}}
 	err = dbService.collectionAppToken().FindOne(ctx, filter).Decode(&appTokenInfos)
 	return
 }
 
-func (dbService *GlobalDBService) AddAppToken(appToken models.AppToken) (err error) {
+func (dbService *GlobalDBService) AddAppToken(appToken models.AppToken) (models.AppToken, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	_, err = dbService.collectionAppToken().InsertOne(ctx, appToken)
-	return
+	res, err := dbService.collectionAppToken().InsertOne(ctx, appToken)
+	if err != nil {
+		return appToken, err
+	}
+	appToken.ID = res.InsertedID.(primitive.ObjectID)
+	return appToken, nil
+}
+
+func (dbService *GlobalDBService) GetAppTokenByID(id string) (models.AppToken, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	elem := models.AppToken{}
+	err := dbService.collectionAppToken().FindOne(ctx, bson.M{"_id": _id}).Decode(&elem)
+	return elem, err
+}
+
+// GetAppTokens returns the app tokens valid for the instance, all of them if instanceID is empty
+func (dbService *GlobalDBService) GetAppTokens(instanceID string, includeRevoked bool) ([]models.AppToken, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if instanceID != "" {
+		filter["instances"] = instanceID
+	}
+	if !includeRevoked {
+		filter["revokedAt"] = bson.M{"$exists": false}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "appName", Value: 1}, {Key: "createdAt", Value: 1}})
+	cur, err := dbService.collectionAppToken().Find(ctx, filter, opts)
+	if err != nil {
+		return []models.AppToken{}, err
+	}
+	defer cur.Close(ctx)
+
+	appTokens := []models.AppToken{}
+	for cur.Next(ctx) {
+		var result models.AppToken
+		if err := cur.Decode(&result); err != nil {
+			return appTokens, err
+		}
+		appTokens = append(appTokens, result)
+	}
+	return appTokens, cur.Err()
+}
+
+// RotateAppToken replaces the token of the (not revoked) entry by the new hash, plain tokens of the entry stop working
+func (dbService *GlobalDBService) RotateAppToken(id string, tokenHash string, expiresAt int64) (models.AppToken, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id, "revokedAt": bson.M{"$exists": false}}
+	update := bson.M{
+		"$set": bson.M{
+			"tokenHash": tokenHash,
+			"rotatedAt": time.Now().Unix(),
+			"expiresAt": expiresAt,
+		},
+		"$unset": bson.M{"tokens": ""},
+	}
+
+	elem := models.AppToken{}
+	rd := options.After
+	fro := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+	err := dbService.collectionAppToken().FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
+	return elem, err
+}
+
+// RevokeAppToken sets the revocation time of the entry, mongo.ErrNoDocuments if already revoked
+func (dbService *GlobalDBService) RevokeAppToken(id string) (models.AppToken, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id, "revokedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revokedAt": time.Now().Unix()}}
+
+	elem := models.AppToken{}
+	rd := options.After
+	fro := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+	err := dbService.collectionAppToken().FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *GlobalDBService) SaveAppTokenUsed(id primitive.ObjectID, usedAt int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"lastUsedAt": usedAt}}
+	_, err := dbService.collectionAppToken().UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// MigrateLegacyAppTokens replaces the plain tokens of entries added by hand by one hashed entry per token, with the
+// same app name, instances, expiration and revocation time. Returns the number of tokens migrated.
+func (dbService *GlobalDBService) MigrateLegacyAppTokens() (int, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	cur, err := dbService.collectionAppToken().Find(ctx, bson.M{"tokens.0": bson.M{"$exists": true}})
+	if err != nil {
+		return 0, err
+	}
+	defer cur.Close(ctx)
+
+	legacy := []models.AppToken{}
+	for cur.Next(ctx) {
+		var result models.AppToken
+		if err := cur.Decode(&result); err != nil {
+			return 0, err
+		}
+		legacy = append(legacy, result)
+	}
+	if err := cur.Err(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	now := time.Now().Unix()
+	for _, entry := range legacy {
+		for _, t := range entry.Tokens {
+			_, err := dbService.collectionAppToken().InsertOne(ctx, models.AppToken{
+				AppName:    entry.AppName,
+				TokenHash:  tokens.HashSecret(t),
+				Instances:  entry.Instances,
+				CreatedAt:  now,
+				ExpiresAt:  entry.ExpiresAt,
+				LastUsedAt: entry.LastUsedAt,
+				RevokedAt:  entry.RevokedAt,
+			})
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+		if _, err := dbService.collectionAppToken().DeleteOne(ctx, bson.M{"_id": entry.ID}); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
 }
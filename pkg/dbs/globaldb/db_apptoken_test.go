@@ -2,8 +2,10 @@ package globaldb
 
 import (
 	"testing"
+	"time"
 
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
 )
 
 func TestDbInterfaceMethodsForAppToken(t *testing.T) {
@@ -39,3 +41,118 @@ func TestDbInterfaceMethodsForAppToken(t *testing.T) {
 		}
 	})
 }
+
+func TestDbAppTokenLifecycle(t *testing.T) {
+	var id string
+
+	t.Run("add hashed token", func(t *testing.T) {
+		appToken, err := testDBService.AddAppToken(models.AppToken{
+			AppName:   "lifecycle-app",
+			TokenHash: tokens.HashSecret("at_lifecycle_1"),
+			Instances: []string{testInstanceID},
+			CreatedAt: time.Now().Unix(),
+		})
+		if err != nil || appToken.ID.IsZero() {
+			t.Errorf("unexpected result: %v, %v", appToken, err)
+			return
+		}
+		id = appToken.ID.Hex()
+
+		found, err := testDBService.FindAppToken("at_lifecycle_1")
+		if err != nil || found.ID.Hex() != id {
+			t.Errorf("unexpected result: %v, %v", found, err)
+		}
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		appToken, err := testDBService.RotateAppToken(id, tokens.HashSecret("at_lifecycle_2"), 0)
+		if err != nil || appToken.RotatedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", appToken, err)
+			return
+		}
+		if _, err := testDBService.FindAppToken("at_lifecycle_1"); err == nil {
+			t.Error("previous token should not be found")
+		}
+		if _, err := testDBService.FindAppToken("at_lifecycle_2"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		appToken, err := testDBService.RevokeAppToken(id)
+		if err != nil || appToken.RevokedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", appToken, err)
+			return
+		}
+		if _, err := testDBService.RevokeAppToken(id); err == nil {
+			t.Error("should not revoke twice")
+		}
+		appTokens, err := testDBService.GetAppTokens(testInstanceID, false)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		for _, a := range appTokens {
+			if a.ID.Hex() == id {
+				t.Error("revoked token should not be listed")
+			}
+		}
+	})
+
+	t.Run("migrate plain tokens", func(t *testing.T) {
+		_, err := testDBService.AddAppToken(models.AppToken{
+			AppName:   "legacy-app",
+			Tokens:    []string{"legacy1", "legacy2"},
+			Instances: []string{testInstanceID},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		count, err := testDBService.MigrateLegacyAppTokens()
+		if err != nil || count < 2 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+			return
+		}
+		found, err := testDBService.FindAppToken("legacy2")
+		if err != nil || found.TokenHash == "" || len(found.Tokens) > 0 || found.AppName != "legacy-app" {
+			t.Errorf("unexpected result: %v, %v", found, err)
+		}
+	})
+
+	t.Run("migrate revoked and expired plain tokens", func(t *testing.T) {
+		revokedAt := time.Now().Unix() - 10
+		expiresAt := time.Now().Unix() - 5
+		_, err := testDBService.AddAppToken(models.AppToken{
+			AppName:   "legacy-revoked",
+			Tokens:    []string{"legacy-revoked1"},
+			Instances: []string{testInstanceID},
+			RevokedAt: revokedAt,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		_, err = testDBService.AddAppToken(models.AppToken{
+			AppName:   "legacy-expired",
+			Tokens:    []string{"legacy-expired1"},
+			Instances: []string{testInstanceID},
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testDBService.MigrateLegacyAppTokens(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		found, err := testDBService.FindAppToken("legacy-revoked1")
+		if err != nil || found.TokenHash == "" || found.RevokedAt != revokedAt || found.IsActive(time.Now().Unix()) {
+			t.Errorf("unexpected result: %v, %v", found, err)
+		}
+		found, err = testDBService.FindAppToken("legacy-expired1")
+		if err != nil || found.TokenHash == "" || found.ExpiresAt != expiresAt || found.IsActive(time.Now().Unix()) {
+			t.Errorf("unexpected result: %v, %v", found, err)
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package globaldb
+
+import (
+	"errors"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *GlobalDBService) GetRoleDefinitions(instanceID string) ([]models.RoleDefinition, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID}
+	opts := options.Find().SetSort(bson.M{"role": 1})
+	cur, err := dbService.collectionRefRoleDefinitions().Find(ctx, filter, opts)
+	if err != nil {
+		return []models.RoleDefinition{}, err
+	}
+	defer cur.Close(ctx)
+
+	defs := []models.RoleDefinition{}
+	for cur.Next(ctx) {
+		var result models.RoleDefinition
+		if err := cur.Decode(&result); err != nil {
+			return defs, err
+		}
+		defs = append(defs, result)
+	}
+	if err := cur.Err(); err != nil {
+		return defs, err
+	}
+	return defs, nil
+}
+
+// SaveRoleDefinition creates the definition or replaces the permissions of the existing one for the same role
+func (dbService *GlobalDBService) SaveRoleDefinition(def models.RoleDefinition) (models.RoleDefinition, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": def.InstanceID, "role": def.Role}
+	update := bson.M{"$set": bson.M{
+		"description":      def.Description,
+		"permissions":      def.Permissions,
+		"requiresApproval": def.RequiresApproval,
+	}}
+	upsert := true
+	rd := options.After
+	opts := options.FindOneAndUpdateOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+
+	var elem models.RoleDefinition
+	err := dbService.collectionRefRoleDefinitions().FindOneAndUpdate(ctx, filter, update, &opts).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *GlobalDBService) DeleteRoleDefinition(instanceID string, role string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID, "role": role}
+	res, err := dbService.collectionRefRoleDefinitions().DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		return errors.New("no definition found for the given role")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package globaldb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbInterfaceMethodsForRoleDefinitions(t *testing.T) {
+	t.Run("Add new definition", func(t *testing.T) {
+		def, err := testDBService.SaveRoleDefinition(models.RoleDefinition{
+			InstanceID:  testInstanceID,
+			Role:        "SUPPORT",
+			Permissions: []string{"users.read"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if def.ID.IsZero() || def.Role != "SUPPORT" || len(def.Permissions) != 1 {
+			t.Errorf("unexpected definition: %v", def)
+		}
+	})
+
+	t.Run("Update existing definition", func(t *testing.T) {
+		def, err := testDBService.SaveRoleDefinition(models.RoleDefinition{
+			InstanceID:  testInstanceID,
+			Role:        "SUPPORT",
+			Permissions: []string{"users.read", "users.create"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(def.Permissions) != 2 {
+			t.Errorf("unexpected definition: %v", def)
+		}
+	})
+
+	t.Run("Get definitions of instance", func(t *testing.T) {
+		defs, err := testDBService.GetRoleDefinitions(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(defs) != 1 {
+			t.Errorf("unexpected number of definitions: %d", len(defs))
+		}
+	})
+
+	t.Run("Delete definition", func(t *testing.T) {
+		if err := testDBService.DeleteRoleDefinition(testInstanceID, "SUPPORT"); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.DeleteRoleDefinition(testInstanceID, "SUPPORT"); err == nil {
+			t.Error("should fail for missing definition")
+		}
+	})
+}
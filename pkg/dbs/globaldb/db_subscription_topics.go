@@ -0,0 +1,69 @@
+package globaldb
+
+import (
+	"errors"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *GlobalDBService) GetSubscriptionTopics(instanceID string) ([]models.SubscriptionTopic, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID}
+	opts := options.Find().SetSort(bson.M{"key": 1})
+	cur, err := dbService.collectionRefSubscriptionTopics().Find(ctx, filter, opts)
+	if err != nil {
+		return []models.SubscriptionTopic{}, err
+	}
+	defer cur.Close(ctx)
+
+	topics := []models.SubscriptionTopic{}
+	for cur.Next(ctx) {
+		var result models.SubscriptionTopic
+		if err := cur.Decode(&result); err != nil {
+			return topics, err
+		}
+		topics = append(topics, result)
+	}
+	if err := cur.Err(); err != nil {
+		return topics, err
+	}
+	return topics, nil
+}
+
+// SaveSubscriptionTopic creates the topic or updates the existing one with the same key
+func (dbService *GlobalDBService) SaveSubscriptionTopic(topic models.SubscriptionTopic) (models.SubscriptionTopic, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": topic.InstanceID, "key": topic.Key}
+	update := bson.M{"$set": bson.M{"description": topic.Description}}
+	upsert := true
+	rd := options.After
+	opts := options.FindOneAndUpdateOptions{
+		Upsert:         &upsert,
+		ReturnDocument: &rd,
+	}
+
+	var elem models.SubscriptionTopic
+	err := dbService.collectionRefSubscriptionTopics().FindOneAndUpdate(ctx, filter, update, &opts).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *GlobalDBService) DeleteSubscriptionTopic(instanceID string, key string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID, "key": key}
+	res, err := dbService.collectionRefSubscriptionTopics().DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount < 1 {
+		return errors.New("no topic found with the given key")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package globaldb
+
+import (
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbInterfaceMethodsForSubscriptionTopics(t *testing.T) {
+	t.Run("Add new topic", func(t *testing.T) {
+		topic, err := testDBService.SaveSubscriptionTopic(models.SubscriptionTopic{
+			InstanceID:  testInstanceID,
+			Key:         "study-invitations",
+			Description: "invitations",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if topic.ID.IsZero() || topic.Key != "study-invitations" {
+			t.Errorf("unexpected topic: %v", topic)
+		}
+	})
+
+	t.Run("Update existing topic", func(t *testing.T) {
+		topic, err := testDBService.SaveSubscriptionTopic(models.SubscriptionTopic{
+			InstanceID:  testInstanceID,
+			Key:         "study-invitations",
+			Description: "updated",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if topic.Description != "updated" {
+			t.Errorf("unexpected topic: %v", topic)
+		}
+	})
+
+	t.Run("Get topics of instance", func(t *testing.T) {
+		topics, err := testDBService.GetSubscriptionTopics(testInstanceID)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(topics) != 1 {
+			t.Errorf("unexpected number of topics: %d", len(topics))
+		}
+	})
+
+	t.Run("Delete topic", func(t *testing.T) {
+		if err := testDBService.DeleteSubscriptionTopic(testInstanceID, "study-invitations"); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := testDBService.DeleteSubscriptionTopic(testInstanceID, "study-invitations"); err == nil {
+			t.Error("should fail for missing topic")
+		}
+	})
+}
@@ -25,6 +25,66 @@ func (dbService *GlobalDBService) AddTempToken(t models.TempToken) (token string
 	return
 }
 
+// AddTempTokens inserts all tokens at once and returns the generated token strings in the same order
+func (dbService *GlobalDBService) AddTempTokens(ts []models.TempToken) (tokenStrings []string, err error) {
+	if len(ts) < 1 {
+		return []string{}, nil
+	}
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	docs := make([]interface{}, len(ts))
+	tokenStrings = make([]string, len(ts))
+	for i, t := range ts {
+		t.Token, err = tokens.GenerateUniqueTokenString()
+		if err != nil {
+			return []string{}, err
+		}
+		docs[i] = t
+		tokenStrings[i] = t.Token
+	}
+
+	_, err = dbService.collectionRefTempToken().InsertMany(ctx, docs)
+	if err != nil {
+		return []string{}, err
+	}
+	return tokenStrings, nil
+}
+
+// GetTempTokensForUsers finds the tokens with the given purpose of all listed users, that are still valid at minExpiration
+func (dbService *GlobalDBService) GetTempTokensForUsers(instanceID string, purpose string, userIDs []string, minExpiration int64) (tokens models.TempTokens, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{
+		"instanceID": instanceID,
+		"purpose":    purpose,
+		"userID":     bson.M{"$in": userIDs},
+		"expiration": bson.M{"$gt": minExpiration},
+	}
+
+	cur, err := dbService.collectionRefTempToken().Find(ctx, filter)
+	if err != nil {
+		return tokens, err
+	}
+	defer cur.Close(ctx)
+
+	tokens = []models.TempToken{}
+	for cur.Next(ctx) {
+		var result models.TempToken
+		err := cur.Decode(&result)
+		if err != nil {
+			return tokens, err
+		}
+
+		tokens = append(tokens, result)
+	}
+	if err := cur.Err(); err != nil {
+		return tokens, err
+	}
+	return tokens, nil
+}
+
 func (dbService *GlobalDBService) GetTempTokenForUser(instanceID string, uid string, purpose string) (tokens models.TempTokens, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -97,6 +157,21 @@ func (dbService *GlobalDBService) DeleteAllTempTokenForUser(instanceID string, u
 	return nil
 }
 
+// MoveTempTokensToInstance keeps the user's temp tokens valid after the user was moved to another instance
+func (dbService *GlobalDBService) MoveTempTokensToInstance(userID string, fromInstanceID string, toInstanceID string) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": fromInstanceID, "userID": userID}
+	res, err := dbService.collectionRefTempToken().UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{"instanceID": toInstanceID},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
 func (dbService *GlobalDBService) DeleteTempTokensExpireBefore(instanceID string, purpose string, expiresBefore int64) error {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
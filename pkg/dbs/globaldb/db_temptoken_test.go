@@ -290,3 +290,64 @@ func TestDBDeleteExpiredTempTokens(t *testing.T) {
 		}
 	})
 }
+
+func TestDBTempTokensForMultipleUsers(t *testing.T) {
+	testTempTokens := []models.TempToken{
+		{Expiration: time.Now().Unix() + 100, Purpose: "bulk_purpose", UserID: "bulkUID1", InstanceID: testInstanceID},
+		{Expiration: time.Now().Unix() + 100, Purpose: "bulk_purpose", UserID: "bulkUID2", InstanceID: testInstanceID},
+		{Expiration: time.Now().Unix() + 10, Purpose: "bulk_purpose", UserID: "bulkUID3", InstanceID: testInstanceID},
+	}
+
+	t.Run("Add multiple tokens", func(t *testing.T) {
+		tokenStrings, err := testDBService.AddTempTokens(testTempTokens)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err.Error())
+			return
+		}
+		if len(tokenStrings) != 3 || tokenStrings[0] == tokenStrings[1] {
+			t.Errorf("unexpected tokens: %v", tokenStrings)
+		}
+	})
+
+	t.Run("Get tokens valid long enough", func(t *testing.T) {
+		tokens, err := testDBService.GetTempTokensForUsers(testInstanceID, "bulk_purpose", []string{"bulkUID1", "bulkUID3"}, time.Now().Unix()+50)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err.Error())
+			return
+		}
+		if len(tokens) != 1 || tokens[0].UserID != "bulkUID1" {
+			t.Errorf("unexpected tokens: %v", tokens)
+		}
+	})
+}
+
+func TestDbMoveTempTokensToInstance(t *testing.T) {
+	targetInstanceID := testInstanceID + "_target"
+	token, err := testDBService.AddTempToken(models.TempToken{
+		Expiration: time.Now().Unix() + 100,
+		Purpose:    "move_purpose",
+		UserID:     "moveUID",
+		InstanceID: testInstanceID,
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err.Error())
+		return
+	}
+
+	count, err := testDBService.MoveTempTokensToInstance("moveUID", testInstanceID, targetInstanceID)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err.Error())
+		return
+	}
+	if count != 1 {
+		t.Errorf("unexpected number of moved tokens: %d", count)
+	}
+	tempToken, err := testDBService.GetTempToken(token)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err.Error())
+		return
+	}
+	if tempToken.InstanceID != targetInstanceID {
+		t.Errorf("unexpected instance: %s", tempToken.InstanceID)
+	}
+}
@@ -1,15 +1,23 @@
 package globaldb
 
 import (
+	"errors"
+
 	"github.com/influenzanet/go-utils/pkg/global_types"
+	"github.com/influenzanet/user-management-service/pkg/models"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// ErrInstanceExists is returned if an instance with the same ID was already created (also if it is archived)
+var ErrInstanceExists = errors.New("instance already exists")
+
+// GetAllInstances returns the instances in use, archived ones are left out
 func (dbService *GlobalDBService) GetAllInstances() ([]global_types.Instance, error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
 
-	filter := bson.M{}
+	filter := bson.M{"archivedAt": bson.M{"$exists": false}}
 	cur, err := dbService.collectionRefInstances().Find(
 		ctx,
 		filter,
@@ -36,3 +44,95 @@ func (dbService *GlobalDBService) GetAllInstances() ([]global_types.Instance, er
 
 	return instances, nil
 }
+
+// GetInstances returns the entries of the instances with their settings
+func (dbService *GlobalDBService) GetInstances(includeArchived bool) ([]models.Instance, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if !includeArchived {
+		filter["archivedAt"] = bson.M{"$exists": false}
+	}
+	opts := options.Find().SetSort(bson.M{"instanceID": 1})
+	cur, err := dbService.collectionRefInstances().Find(ctx, filter, opts)
+	if err != nil {
+		return []models.Instance{}, err
+	}
+	defer cur.Close(ctx)
+
+	instances := []models.Instance{}
+	for cur.Next(ctx) {
+		var result models.Instance
+		if err := cur.Decode(&result); err != nil {
+			return instances, err
+		}
+		instances = append(instances, result)
+	}
+	if err := cur.Err(); err != nil {
+		return instances, err
+	}
+	return instances, nil
+}
+
+func (dbService *GlobalDBService) GetInstance(instanceID string) (models.Instance, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	var elem models.Instance
+	err := dbService.collectionRefInstances().FindOne(ctx, bson.M{"instanceID": instanceID}).Decode(&elem)
+	return elem, err
+}
+
+// CreateInstance adds the entry of the instance, fails with ErrInstanceExists if there is one already
+func (dbService *GlobalDBService) CreateInstance(instance models.Instance) (models.Instance, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instance.InstanceID}
+	update := bson.M{"$setOnInsert": instance}
+	res, err := dbService.collectionRefInstances().UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return instance, err
+	}
+	if res.UpsertedCount < 1 {
+		return instance, ErrInstanceExists
+	}
+	return instance, nil
+}
+
+// UpdateInstanceSettings replaces the settings of an instance that is not archived
+func (dbService *GlobalDBService) UpdateInstanceSettings(instanceID string, name string, settings models.InstanceSettings) (models.Instance, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID, "archivedAt": bson.M{"$exists": false}}
+	set := bson.M{"settings": settings}
+	if name != "" {
+		set["name"] = name
+	}
+	rd := options.After
+	opts := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+
+	var elem models.Instance
+	err := dbService.collectionRefInstances().FindOneAndUpdate(ctx, filter, bson.M{"$set": set}, &opts).Decode(&elem)
+	return elem, err
+}
+
+// ArchiveInstance marks the instance as archived, an instance already archived keeps its time of archiving
+func (dbService *GlobalDBService) ArchiveInstance(instanceID string, archivedAt int64) (models.Instance, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"instanceID": instanceID, "archivedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"archivedAt": archivedAt, "settings.signupClosed": true}}
+	if _, err := dbService.collectionRefInstances().UpdateOne(ctx, filter, update); err != nil {
+		return models.Instance{}, err
+	}
+
+	var elem models.Instance
+	err := dbService.collectionRefInstances().FindOne(ctx, bson.M{"instanceID": instanceID}).Decode(&elem)
+	return elem, err
+}
@@ -2,6 +2,8 @@ package globaldb
 
 import (
 	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
 func TestDbInterfaceMethods(t *testing.T) {
@@ -20,3 +22,61 @@ func TestDbInterfaceMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestDbInstanceManagement(t *testing.T) {
+	instanceID := testInstanceID + "_managed"
+
+	t.Run("create instance", func(t *testing.T) {
+		_, err := testDBService.CreateInstance(models.Instance{
+			InstanceID: instanceID,
+			Name:       "Managed",
+			Settings:   models.InstanceSettings{Languages: []string{"en"}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		_, err = testDBService.CreateInstance(models.Instance{InstanceID: instanceID})
+		if err != ErrInstanceExists {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("update settings", func(t *testing.T) {
+		instance, err := testDBService.UpdateInstanceSettings(instanceID, "", models.InstanceSettings{SignupClosed: true})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if instance.Name != "Managed" || !instance.Settings.SignupClosed || len(instance.Settings.Languages) != 0 {
+			t.Errorf("unexpected instance: %v", instance)
+		}
+	})
+
+	t.Run("archive instance", func(t *testing.T) {
+		instance, err := testDBService.ArchiveInstance(instanceID, 100)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if instance.ArchivedAt != 100 || !instance.Settings.SignupClosed {
+			t.Errorf("unexpected instance: %v", instance)
+		}
+		instance, err = testDBService.ArchiveInstance(instanceID, 200)
+		if err != nil || instance.ArchivedAt != 100 {
+			t.Errorf("unexpected result: %v, %v", instance, err)
+		}
+
+		instances, err := testDBService.GetAllInstances()
+		if err != nil || len(instances) != 0 {
+			t.Errorf("unexpected instances: %v, %v", instances, err)
+		}
+		all, err := testDBService.GetInstances(true)
+		if err != nil || len(all) != 1 {
+			t.Errorf("unexpected instances: %v, %v", all, err)
+		}
+		if _, err := testDBService.UpdateInstanceSettings(instanceID, "", models.InstanceSettings{}); err == nil {
+			t.Error("archived instance should not be updated")
+		}
+	})
+}
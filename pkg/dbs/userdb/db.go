@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -56,6 +57,39 @@ func (dbService *UserDBService) collectionRefUsers(instanceID string) *mongo.Col
 	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection("users")
 }
 
+func (dbService *UserDBService) collectionRefRoleGrants(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection("role-grants")
+}
+
+func (dbService *UserDBService) collectionRefAPIKeys(instanceID string) *mongo.Collection {
+	return dbService.DBClient.Database(dbService.DBNamePrefix + instanceID + "_users").Collection("api-keys")
+}
+
+// CreateIndexes makes sure the indexes used by lookups and admin searches exist
+func (dbService *UserDBService) CreateIndexes(instanceID string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_, err := dbService.collectionRefUsers(instanceID).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "account.accountID", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "roles", Value: 1}}},
+		{Keys: bson.D{{Key: "account.accountConfirmedAt", Value: 1}}},
+		{Keys: bson.D{{Key: "timestamps.createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "timestamps.lastLogin", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "contactInfos.email", Value: 1}}},
+		{Keys: bson.D{{Key: "account.linkedIdentities.idp", Value: 1}, {Key: "account.linkedIdentities.subject", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = dbService.collectionRefAPIKeys(instanceID).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "keyHash", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return err
+}
+
 // DB utils
 func (dbService *UserDBService) getContext() (ctx context.Context, cancel context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(dbService.timeout)*time.Second)
@@ -0,0 +1,97 @@
+package userdb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *UserDBService) AddAPIKey(instanceID string, key models.APIKey) (models.APIKey, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefAPIKeys(instanceID).InsertOne(ctx, key)
+	if err != nil {
+		return key, err
+	}
+	key.ID = res.InsertedID.(primitive.ObjectID)
+	return key, nil
+}
+
+// FindAPIKeys returns the keys of the user, most recent first
+func (dbService *UserDBService) FindAPIKeys(instanceID string, userID string, includeRevoked bool) ([]models.APIKey, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"userID": userID}
+	if !includeRevoked {
+		filter["revokedAt"] = bson.M{"$exists": false}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}})
+	cur, err := dbService.collectionRefAPIKeys(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return []models.APIKey{}, err
+	}
+	defer cur.Close(ctx)
+
+	keys := []models.APIKey{}
+	for cur.Next(ctx) {
+		var result models.APIKey
+		if err := cur.Decode(&result); err != nil {
+			return keys, err
+		}
+		keys = append(keys, result)
+	}
+	return keys, cur.Err()
+}
+
+func (dbService *UserDBService) FindAPIKeyByHash(instanceID string, keyHash string) (models.APIKey, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := models.APIKey{}
+	err := dbService.collectionRefAPIKeys(instanceID).FindOne(ctx, bson.M{"keyHash": keyHash}).Decode(&elem)
+	return elem, err
+}
+
+// RevokeAPIKey sets the revocation time of an active key of the user, mongo.ErrNoDocuments if there is none
+func (dbService *UserDBService) RevokeAPIKey(instanceID string, userID string, id string) (models.APIKey, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id, "userID": userID, "revokedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revokedAt": time.Now().Unix()}}
+
+	elem := models.APIKey{}
+	rd := options.After
+	fro := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+	err := dbService.collectionRefAPIKeys(instanceID).FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
+	return elem, err
+}
+
+func (dbService *UserDBService) SaveAPIKeyUsed(instanceID string, id primitive.ObjectID) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"lastUsedAt": time.Now().Unix()}}
+	_, err := dbService.collectionRefAPIKeys(instanceID).UpdateOne(ctx, bson.M{"_id": id}, update)
+	return err
+}
+
+// DeleteAPIKeysOfUser removes all keys of the user, e.g. when the user leaves the instance
+func (dbService *UserDBService) DeleteAPIKeysOfUser(instanceID string, userID string) (int64, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefAPIKeys(instanceID).DeleteMany(ctx, bson.M{"userID": userID})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
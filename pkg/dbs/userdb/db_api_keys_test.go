@@ -0,0 +1,77 @@
+package userdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbAPIKeys(t *testing.T) {
+	now := time.Now().Unix()
+	var keyID string
+
+	t.Run("add key", func(t *testing.T) {
+		key, err := testDBService.AddAPIKey(testInstanceID, models.APIKey{
+			UserID:    "api_key_user_1",
+			Name:      "exporter",
+			KeyHash:   "test_hash_1",
+			CreatedBy: "admin_1",
+			CreatedAt: now,
+		})
+		if err != nil || key.ID.IsZero() {
+			t.Errorf("unexpected result: %v, %v", key, err)
+			return
+		}
+		keyID = key.ID.Hex()
+	})
+
+	t.Run("find key", func(t *testing.T) {
+		key, err := testDBService.FindAPIKeyByHash(testInstanceID, "test_hash_1")
+		if err != nil || key.ID.Hex() != keyID {
+			t.Errorf("unexpected result: %v, %v", key, err)
+			return
+		}
+		if err := testDBService.SaveAPIKeyUsed(testInstanceID, key.ID); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		keys, err := testDBService.FindAPIKeys(testInstanceID, "api_key_user_1", false)
+		if err != nil || len(keys) != 1 || keys[0].LastUsedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", keys, err)
+		}
+	})
+
+	t.Run("revoke key", func(t *testing.T) {
+		if _, err := testDBService.RevokeAPIKey(testInstanceID, "other_user", keyID); err == nil {
+			t.Error("should not revoke key of another user")
+		}
+		key, err := testDBService.RevokeAPIKey(testInstanceID, "api_key_user_1", keyID)
+		if err != nil || key.RevokedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", key, err)
+			return
+		}
+		if _, err := testDBService.RevokeAPIKey(testInstanceID, "api_key_user_1", keyID); err == nil {
+			t.Error("should not revoke twice")
+		}
+		keys, err := testDBService.FindAPIKeys(testInstanceID, "api_key_user_1", false)
+		if err != nil || len(keys) != 0 {
+			t.Errorf("unexpected result: %v, %v", keys, err)
+		}
+		keys, err = testDBService.FindAPIKeys(testInstanceID, "api_key_user_1", true)
+		if err != nil || len(keys) != 1 {
+			t.Errorf("unexpected result: %v, %v", keys, err)
+		}
+	})
+
+	t.Run("delete keys of user", func(t *testing.T) {
+		count, err := testDBService.DeleteAPIKeysOfUser(testInstanceID, "api_key_user_1")
+		if err != nil || count != 1 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+			return
+		}
+		keys, err := testDBService.FindAPIKeys(testInstanceID, "api_key_user_1", true)
+		if err != nil || len(keys) != 0 {
+			t.Errorf("unexpected result: %v, %v", keys, err)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package userdb
+
+import (
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const archiveBatchSize = 500
+
+// ErrAccountIDTaken is returned if a restored user has the account ID of another user in the target instance
+var ErrAccountIDTaken = errors.New("account id used by another user")
+
+// ForEachUserDocument calls cbk with the complete stored document of each user, ordered by ID. Stops at the first error returned by cbk.
+func (dbService *UserDBService) ForEachUserDocument(instanceID string, cbk func(doc bson.Raw) error) error {
+	lastID := primitive.NilObjectID
+	for {
+		docs, err := dbService.findUserDocuments(instanceID, lastID)
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			if err := cbk(doc); err != nil {
+				return err
+			}
+		}
+		if len(docs) < archiveBatchSize {
+			return nil
+		}
+		id, ok := docs[len(docs)-1].Lookup("_id").ObjectIDOK()
+		if !ok {
+			return errors.New("user document without object id")
+		}
+		lastID = id
+	}
+}
+
+func (dbService *UserDBService) findUserDocuments(instanceID string, after primitive.ObjectID) ([]bson.Raw, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if !after.IsZero() {
+		filter["_id"] = bson.M{"$gt": after}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(archiveBatchSize)
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	docs := []bson.Raw{}
+	for cur.Next(ctx) {
+		// cursor reuses its buffer
+		docs = append(docs, append(bson.Raw{}, cur.Current...))
+	}
+	return docs, cur.Err()
+}
+
+// RestoreUserDocument inserts the stored document of a user as is (keeping its ID). Returns false if a user with this ID already exists.
+func (dbService *UserDBService) RestoreUserDocument(instanceID string, doc bson.Raw) (restored bool, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	id, ok := doc.Lookup("_id").ObjectIDOK()
+	if !ok {
+		return false, errors.New("user document without object id")
+	}
+	accountID, _ := doc.Lookup("account", "accountID").StringValueOK()
+
+	count, err := dbService.collectionRefUsers(instanceID).CountDocuments(ctx, bson.M{
+		"account.accountID": accountID,
+		"_id":               bson.M{"$ne": id},
+	})
+	if err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, ErrAccountIDTaken
+	}
+
+	// _id comes from the filter when inserting
+	fields := bson.D{}
+	elements, err := doc.Elements()
+	if err != nil {
+		return false, err
+	}
+	for _, e := range elements {
+		if e.Key() != "_id" {
+			fields = append(fields, bson.E{Key: e.Key(), Value: e.Value()})
+		}
+	}
+
+	res, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx,
+		bson.M{"_id": id},
+		bson.M{"$setOnInsert": fields},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+// ArchiveUserDB moves all collections of the instance's users database into a database named after the time of
+// archiving, so that they are kept but not used by the instance anymore. Returns the name of that database. Collections
+// left after a failed move are moved on the next call with the same time. Each move gets the full DB timeout.
+func (dbService *UserDBService) ArchiveUserDB(instanceID string, archivedAt int64) (string, error) {
+	source := dbService.DBNamePrefix + instanceID + "_users"
+	target := fmt.Sprintf("%s_archived_%d", source, archivedAt)
+
+	ctx, cancel := dbService.getContext()
+	names, err := dbService.DBClient.Database(source).ListCollectionNames(ctx, bson.M{})
+	cancel()
+	if err != nil {
+		return target, err
+	}
+	for _, name := range names {
+		if err := dbService.renameCollection(source+"."+name, target+"."+name); err != nil {
+			return target, err
+		}
+	}
+	return target, nil
+}
+
+func (dbService *UserDBService) renameCollection(from string, to string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	cmd := bson.D{
+		{Key: "renameCollection", Value: from},
+		{Key: "to", Value: to},
+	}
+	return dbService.DBClient.Database("admin").RunCommand(ctx, cmd).Err()
+}
@@ -0,0 +1,97 @@
+package userdb
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestDbArchiveUsers(t *testing.T) {
+	sourceInstance := testInstanceID + "_archive_source"
+	targetInstance := testInstanceID + "_archive_target"
+
+	testUsers := []models.User{
+		{Account: models.Account{AccountID: "archive_1", Password: "hash1"}, Roles: []string{"PARTICIPANT"}},
+		{Account: models.Account{AccountID: "archive_2", Password: "hash2"}, Roles: []string{"PARTICIPANT"}},
+	}
+	for _, u := range testUsers {
+		_, err := testDBService.AddUser(sourceInstance, u)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	docs := []bson.Raw{}
+	err := testDBService.ForEachUserDocument(sourceInstance, func(doc bson.Raw) error {
+		docs = append(docs, doc)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(docs) != 2 {
+		t.Errorf("unexpected number of documents: %d", len(docs))
+		return
+	}
+
+	t.Run("restore keeps ids and passwords", func(t *testing.T) {
+		for _, doc := range docs {
+			restored, err := testDBService.RestoreUserDocument(targetInstance, doc)
+			if err != nil || !restored {
+				t.Errorf("unexpected result: %v, %v", restored, err)
+				return
+			}
+		}
+		source, _ := testDBService.GetUserByAccountID(sourceInstance, "archive_1")
+		target, err := testDBService.GetUserByAccountID(targetInstance, "archive_1")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if target.ID != source.ID || target.Account.Password != "hash1" {
+			t.Errorf("unexpected user: %v", target)
+		}
+	})
+
+	t.Run("restore again", func(t *testing.T) {
+		restored, err := testDBService.RestoreUserDocument(targetInstance, docs[0])
+		if err != nil || restored {
+			t.Errorf("unexpected result: %v, %v", restored, err)
+		}
+	})
+
+	t.Run("account id taken", func(t *testing.T) {
+		otherInstance := testInstanceID + "_archive_other"
+		_, err := testDBService.AddUser(otherInstance, models.User{Account: models.Account{AccountID: "archive_1"}})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		_, err = testDBService.RestoreUserDocument(otherInstance, docs[0])
+		if err != ErrAccountIDTaken {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("archive user db", func(t *testing.T) {
+		archiveDB, err := testDBService.ArchiveUserDB(sourceInstance, 1000)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		defer testDBService.DBClient.Database(archiveDB).Drop(context.Background())
+
+		count, err := testDBService.CountUsers(sourceInstance)
+		if err != nil || count != 0 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+		}
+		count, err = testDBService.DBClient.Database(archiveDB).Collection("users").CountDocuments(context.Background(), bson.M{})
+		if err != nil || count != 2 {
+			t.Errorf("unexpected result: %d, %v", count, err)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package userdb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveInvitationSent records that an invitation valid until expiresAt has been sent to the user (re-opens a revoked invitation)
+func (dbService *UserDBService) SaveInvitationSent(instanceID string, userID string, sentAt int64, expiresAt int64) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(userID)
+	filter := bson.M{"_id": _id}
+	update := bson.M{
+		"$set": bson.M{
+			"invitation.lastSentAt": sentAt,
+			"invitation.expiresAt":  expiresAt,
+		},
+		"$min":   bson.M{"invitation.firstSentAt": sentAt},
+		"$inc":   bson.M{"invitation.sendCount": 1},
+		"$unset": bson.M{"invitation.revokedAt": ""},
+	}
+	_, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+	return err
+}
+
+// SaveInvitationAccepted marks the invitation of the user as accepted, if there is an open one
+func (dbService *UserDBService) SaveInvitationAccepted(instanceID string, userID string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(userID)
+	filter := bson.M{
+		"_id":                   _id,
+		"invitation":            bson.M{"$exists": true},
+		"invitation.acceptedAt": bson.M{"$not": bson.M{"$gt": 0}},
+	}
+	update := bson.M{"$set": bson.M{"invitation.acceptedAt": time.Now().Unix()}}
+	_, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+	return err
+}
+
+// SaveInvitationRevoked marks the invitation of the user as revoked
+func (dbService *UserDBService) SaveInvitationRevoked(instanceID string, userID string, revokedAt int64) (models.User, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(userID)
+	filter := bson.M{"_id": _id, "invitation": bson.M{"$exists": true}}
+	update := bson.M{"$set": bson.M{"invitation.revokedAt": revokedAt}}
+
+	elem := models.User{}
+	rd := options.After
+	fro := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+	err := dbService.collectionRefUsers(instanceID).FindOneAndUpdate(ctx, filter, update, &fro).Decode(&elem)
+	return elem, err
+}
+
+// FindInvitedUsers returns the users who received an invitation, most recently invited first. Only open invitations
+// (neither accepted nor revoked) are considered unless includeClosed is set.
+func (dbService *UserDBService) FindInvitedUsers(instanceID string, includeClosed bool) (users []models.User, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"invitation": bson.M{"$exists": true}}
+	if !includeClosed {
+		filter["invitation.acceptedAt"] = bson.M{"$not": bson.M{"$gt": 0}}
+		filter["invitation.revokedAt"] = bson.M{"$not": bson.M{"$gt": 0}}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "invitation.lastSentAt", Value: -1}})
+
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return users, err
+	}
+	defer cur.Close(ctx)
+
+	users = []models.User{}
+	for cur.Next(ctx) {
+		var result models.User
+		if err := cur.Decode(&result); err != nil {
+			return users, err
+		}
+		users = append(users, result)
+	}
+	return users, cur.Err()
+}
@@ -2,6 +2,7 @@ package userdb
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// ErrUserExists is returned by AddUser if the account ID is already used in the instance
+var ErrUserExists = errors.New("user already exists")
+
 func (dbService *UserDBService) AddUser(instanceID string, user models.User) (id string, err error) {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -29,7 +33,7 @@ func (dbService *UserDBService) AddUser(instanceID string, user models.User) (id
 	}
 
 	if res.UpsertedCount < 1 {
-		err = errors.New("user already exists")
+		err = ErrUserExists
 		return
 	}
 
@@ -37,6 +41,18 @@ func (dbService *UserDBService) AddUser(instanceID string, user models.User) (id
 	return
 }
 
+// InsertUser adds the user keeping its ID, e.g. when moved from another instance
+func (dbService *UserDBService) InsertUser(instanceID string, user models.User) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if user.ID.IsZero() {
+		return errors.New("user id missing")
+	}
+	_, err := dbService.collectionRefUsers(instanceID).InsertOne(ctx, user)
+	return err
+}
+
 // low level find and replace
 func (dbService *UserDBService) _updateUserInDB(orgID string, user models.User) (models.User, error) {
 	ctx, cancel := dbService.getContext()
@@ -82,6 +98,48 @@ func (dbService *UserDBService) GetUserByAccountID(instanceID string, username s
 	return elem, err
 }
 
+// GetUserByLinkedIdentity finds the user who linked the identity of the external IdP
+func (dbService *UserDBService) GetUserByLinkedIdentity(instanceID string, idp string, subject string) (models.User, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	elem := models.User{}
+	filter := bson.M{"account.linkedIdentities": bson.M{"$elemMatch": bson.M{
+		"idp":     idp,
+		"subject": subject,
+	}}}
+	err := dbService.collectionRefUsers(instanceID).FindOne(ctx, filter).Decode(&elem)
+	return elem, err
+}
+
+// FindUsersByContactEmail returns all users having the address in their contact infos
+func (dbService *UserDBService) FindUsersByContactEmail(instanceID string, email string) (users []models.User, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"contactInfos.email": email}
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, filter)
+	if err != nil {
+		return users, err
+	}
+	defer cur.Close(ctx)
+
+	users = []models.User{}
+	for cur.Next(ctx) {
+		var result models.User
+		err := cur.Decode(&result)
+		if err != nil {
+			return users, err
+		}
+
+		users = append(users, result)
+	}
+	if err := cur.Err(); err != nil {
+		return users, err
+	}
+	return users, nil
+}
+
 func (dbService *UserDBService) UpdateUserPassword(instanceID string, userID string, newPassword string) error {
 	ctx, cancel := dbService.getContext()
 	defer cancel()
@@ -183,6 +241,16 @@ func (dbService *UserDBService) CountRecentlyCreatedUsers(instanceID string, int
 	return
 }
 
+// CountUsers counts the accounts of the instance, service accounts are left out
+func (dbService *UserDBService) CountUsers(instanceID string) (count int64, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"account.type": bson.M{"$ne": models.ACCOUNT_TYPE_SERVICE}}
+	count, err = dbService.collectionRefUsers(instanceID).CountDocuments(ctx, filter)
+	return
+}
+
 func (dbService *UserDBService) DeleteUser(instanceID string, id string) error {
 	_id, _ := primitive.ObjectIDFromHex(id)
 	filter := bson.M{"_id": _id}
@@ -204,6 +272,11 @@ func (dbService *UserDBService) DeleteUnverfiedUsers(instanceID string, createdB
 	filter["$and"] = bson.A{
 		bson.M{"account.accountConfirmedAt": 0},
 		bson.M{"timestamps.createdAt": bson.M{"$lt": createdBefore}},
+		// invited users are kept until their invitation expires or is revoked
+		bson.M{"$or": bson.A{
+			bson.M{"invitation.expiresAt": bson.M{"$not": bson.M{"$gt": time.Now().Unix()}}},
+			bson.M{"invitation.revokedAt": bson.M{"$gt": 0}},
+		}},
 	}
 
 	ctx, cancel := dbService.getContext()
@@ -255,57 +328,213 @@ func (dbService *UserDBService) FindNonParticipantUsers(instanceID string) (user
 }
 
 type UserFilter struct {
-	OnlyConfirmed   bool
-	ReminderWeekDay int32
+	OnlyConfirmed        bool
+	ReminderWeekDay      int32
+	SubscribedToTopic    string   // empty for any
+	SubscribedToAnyTopic []string // at least one of these
+	Roles                []string // any of these
+	PreferredLanguages   []string
+	AccountType          string
+	CreatedAfter         int64
+	CreatedBefore        int64
+	LastLoginAfter       int64
+	LastLoginBefore      int64
+	MinProfileCount      int32
+	MaxProfileCount      int32 // 0 for no limit
 }
 
-func (dbService *UserDBService) PerfomActionForUsers(
-	instanceID string,
-	filters UserFilter,
-	cbk func(instanceID string, user models.User, args ...interface{}) error,
-	args ...interface{},
-) (err error) {
-	ctx, cancel := dbService.getContext()
-	defer cancel()
+// UserProjectionFields maps the top level user fields that can be selected to the stored fields
+var UserProjectionFields = map[string][]string{
+	"account": {
+		// only what is exposed through the API
+		"account.type",
+		"account.accountID",
+		"account.accountConfirmedAt",
+		"account.preferredLanguage",
+	},
+	"roles":               {"roles"},
+	"timestamps":          {"timestamps"},
+	"profiles":            {"profiles"},
+	"contact_preferences": {"contactPreferences"},
+	"contact_infos":       {"contactInfos"},
+}
 
+func subscribedToTopicFilter(topic string) bson.M {
+	// built-in topics are kept in sync with the legacy flags, which also cover users without topic entries
+	switch topic {
+	case models.SUBSCRIPTION_TOPIC_NEWSLETTER:
+		return bson.M{"contactPreferences.subscribedToNewsletter": true}
+	case models.SUBSCRIPTION_TOPIC_WEEKLY:
+		return bson.M{"contactPreferences.subscribedToWeekly": true}
+	}
+	return bson.M{"contactPreferences.subscriptions": bson.M{"$elemMatch": bson.M{
+		"topic":      topic,
+		"subscribed": true,
+	}}}
+}
+
+func (filters UserFilter) toBson() bson.M {
 	filter := bson.M{}
+	conditions := bson.A{}
 	if filters.OnlyConfirmed {
 		filter["account.accountConfirmedAt"] = bson.M{"$gt": 0}
 	}
 	if filters.ReminderWeekDay > -1 {
 		filter["contactPreferences.receiveWeeklyMessageDayOfWeek"] = filters.ReminderWeekDay
 	}
+	if filters.SubscribedToTopic != "" {
+		conditions = append(conditions, subscribedToTopicFilter(filters.SubscribedToTopic))
+	}
+	if len(filters.SubscribedToAnyTopic) > 0 {
+		anyOf := bson.A{}
+		for _, topic := range filters.SubscribedToAnyTopic {
+			anyOf = append(anyOf, subscribedToTopicFilter(topic))
+		}
+		conditions = append(conditions, bson.M{"$or": anyOf})
+	}
+	if len(filters.Roles) > 0 {
+		filter["roles"] = bson.M{"$in": filters.Roles}
+	}
+	if len(filters.PreferredLanguages) > 0 {
+		filter["account.preferredLanguage"] = bson.M{"$in": filters.PreferredLanguages}
+	}
+	if filters.AccountType != "" {
+		filter["account.type"] = filters.AccountType
+	} else {
+		// service accounts have no contact infos to send messages to
+		filter["account.type"] = bson.M{"$ne": models.ACCOUNT_TYPE_SERVICE}
+	}
+	addRange(filter, "timestamps.createdAt", filters.CreatedAfter, filters.CreatedBefore)
+	addRange(filter, "timestamps.lastLogin", filters.LastLoginAfter, filters.LastLoginBefore)
 
-	batchSize := int32(32)
-	options := options.FindOptions{
-		NoCursorTimeout: &dbService.noCursorTimeout,
-		BatchSize:       &batchSize,
+	profileCount := bson.M{"$size": bson.M{"$ifNull": bson.A{"$profiles", bson.A{}}}}
+	if filters.MinProfileCount > 0 {
+		conditions = append(conditions, bson.M{"$expr": bson.M{"$gte": bson.A{profileCount, filters.MinProfileCount}}})
+	}
+	if filters.MaxProfileCount > 0 {
+		conditions = append(conditions, bson.M{"$expr": bson.M{"$lte": bson.A{profileCount, filters.MaxProfileCount}}})
 	}
 
-	cur, err := dbService.collectionRefUsers(instanceID).Find(
-		ctx,
-		filter,
-		&options,
+	if len(conditions) > 0 {
+		filter["$and"] = conditions
+	}
+	return filter
+}
+
+func userProjection(fields []string) (bson.M, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	projection := bson.M{}
+	for _, f := range fields {
+		stored, ok := UserProjectionFields[f]
+		if !ok {
+			return nil, fmt.Errorf("unknown field: %s", f)
+		}
+		for _, sf := range stored {
+			projection[sf] = 1
+		}
+	}
+	return projection, nil
+}
+
+// PerfomActionForUsers calls cbk for each user matching the filters. If fields is not empty, only these top level fields (see UserProjectionFields) are loaded.
+func (dbService *UserDBService) PerfomActionForUsers(
+	instanceID string,
+	filters UserFilter,
+	fields []string,
+	cbk func(instanceID string, user models.User, args ...interface{}) error,
+	args ...interface{},
+) (err error) {
+	return dbService.PerfomActionForUserBatches(instanceID, filters, fields, primitive.NilObjectID, 32,
+		func(instanceID string, users []models.User, lastID primitive.ObjectID) error {
+			for _, u := range users {
+				if err := cbk(instanceID, u, args...); err != nil {
+					log.Printf("PerfomActionForUsers: %v", err)
+				}
+			}
+			return nil
+		},
 	)
+}
+
+// PerfomActionForUserBatches calls cbk with batches of users matching the filters, ordered by ID and starting after the user with the ID startAfter (if not nil).
+// Each batch is loaded with a separate query, so that slow consumers don't run into the query timeout. Stops at the first error returned by cbk.
+// lastID is the ID of the last document read for the batch, which can be after the last user if a document couldn't be decoded.
+func (dbService *UserDBService) PerfomActionForUserBatches(
+	instanceID string,
+	filters UserFilter,
+	fields []string,
+	startAfter primitive.ObjectID,
+	batchSize int64,
+	cbk func(instanceID string, users []models.User, lastID primitive.ObjectID) error,
+) (err error) {
+	if batchSize < 1 {
+		return errors.New("batch size must be positive")
+	}
+	projection, err := userProjection(fields)
 	if err != nil {
 		return err
 	}
+
+	lastID := startAfter
+	for {
+		filter := filters.toBson()
+		if !lastID.IsZero() {
+			filter["_id"] = bson.M{"$gt": lastID}
+		}
+
+		users, fetched, last, err := dbService.findUserBatch(instanceID, filter, projection, batchSize)
+		if err != nil {
+			return err
+		}
+		if fetched > 0 {
+			if err := cbk(instanceID, users, last); err != nil {
+				return err
+			}
+		}
+		if fetched < batchSize {
+			return nil
+		}
+		lastID = last
+	}
+}
+
+// findUserBatch returns the decoded users, the number of documents read and the ID of the last one (also if it couldn't be decoded)
+func (dbService *UserDBService) findUserBatch(instanceID string, filter bson.M, projection bson.M, batchSize int64) (users []models.User, fetched int64, lastID primitive.ObjectID, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(batchSize).
+		SetNoCursorTimeout(dbService.noCursorTimeout)
+	if projection != nil {
+		opts.SetProjection(projection)
+	}
+
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return users, 0, lastID, err
+	}
 	defer cur.Close(ctx)
 
+	users = []models.User{}
 	for cur.Next(ctx) {
+		fetched++
+		if id, ok := cur.Current.Lookup("_id").ObjectIDOK(); ok {
+			lastID = id
+		}
 		var result models.User
 		err := cur.Decode(&result)
 		if err != nil {
 			log.Printf("wrong user model %v, %v", result, err)
 			continue
 		}
-
-		if err := cbk(instanceID, result, args...); err != nil {
-			log.Printf("PerfomActionForUsers: %v", err)
-		}
+		users = append(users, result)
 	}
 	if err := cur.Err(); err != nil {
-		return err
+		return users, fetched, lastID, err
 	}
-	return nil
+	return users, fetched, lastID, nil
 }
@@ -7,10 +7,12 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -220,6 +222,47 @@ func TestDbInterfaceMethods(t *testing.T) {
 	})
 }
 
+func TestDbFindUsersByContactEmail(t *testing.T) {
+	testUsers := []models.User{
+		{
+			Account:      models.Account{AccountID: "contact_search_1@test.com"},
+			ContactInfos: []models.ContactInfo{{Type: "email", Email: "contact_search_1@test.com"}},
+		},
+		{
+			Account:      models.Account{AccountID: "contact_search_2@test.com"},
+			ContactInfos: []models.ContactInfo{{Type: "email", Email: "contact_search_1@test.com"}},
+		},
+	}
+	for _, u := range testUsers {
+		_, err := testDBService.AddUser(testInstanceID, u)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	t.Run("address used by two users", func(t *testing.T) {
+		users, err := testDBService.FindUsersByContactEmail(testInstanceID, "contact_search_1@test.com")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 2 {
+			t.Errorf("unexpected number of users: %d", len(users))
+		}
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		users, err := testDBService.FindUsersByContactEmail(testInstanceID, "contact_search_3@test.com")
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 0 {
+			t.Errorf("unexpected number of users: %d", len(users))
+		}
+	})
+}
+
 func TestDbPerformActionForUsers(t *testing.T) {
 	testUsers := []models.User{
 		{Account: models.Account{AccountID: "1"}},
@@ -258,6 +301,7 @@ func TestDbPerformActionForUsers(t *testing.T) {
 			OnlyConfirmed:   false,
 			ReminderWeekDay: -1,
 		},
+		[]string{},
 		testCallback,
 		2,
 		"hello",
@@ -267,6 +311,225 @@ func TestDbPerformActionForUsers(t *testing.T) {
 	}
 }
 
+func TestDbPerformActionForUsersWithFilters(t *testing.T) {
+	now := time.Now().Unix()
+	testUsers := []models.User{
+		{
+			Account:    models.Account{Type: "email", AccountID: "filter_1", PreferredLanguage: "de"},
+			Roles:      []string{"PARTICIPANT"},
+			Timestamps: models.Timestamps{CreatedAt: now - 100},
+			Profiles:   []models.Profile{{Alias: "main"}},
+			ContactPreferences: models.ContactPreferences{
+				SubscribedToNewsletter: true,
+			},
+		},
+		{
+			Account:    models.Account{Type: "email", AccountID: "filter_2", PreferredLanguage: "fr"},
+			Roles:      []string{"PARTICIPANT", "RESEARCHER"},
+			Timestamps: models.Timestamps{CreatedAt: now - 50},
+			Profiles:   []models.Profile{{Alias: "main"}, {Alias: "child"}},
+			ContactPreferences: models.ContactPreferences{
+				Subscriptions: []models.TopicSubscription{{Topic: "study-news", Subscribed: true}},
+			},
+		},
+	}
+	for _, u := range testUsers {
+		_, err := testDBService.AddUser(testInstanceID, u)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	collect := func(filters UserFilter, fields []string) ([]models.User, error) {
+		users := []models.User{}
+		err := testDBService.PerfomActionForUsers(testInstanceID, filters, fields, func(instanceID string, user models.User, args ...interface{}) error {
+			if strings.HasPrefix(user.Account.AccountID, "filter_") {
+				users = append(users, user)
+			}
+			return nil
+		})
+		return users, err
+	}
+
+	testCases := []struct {
+		name     string
+		filters  UserFilter
+		expected []string
+	}{
+		{name: "role", filters: UserFilter{ReminderWeekDay: -1, Roles: []string{"RESEARCHER"}}, expected: []string{"filter_2"}},
+		{name: "language", filters: UserFilter{ReminderWeekDay: -1, PreferredLanguages: []string{"de", "nl"}}, expected: []string{"filter_1"}},
+		{name: "created before", filters: UserFilter{ReminderWeekDay: -1, CreatedBefore: now - 70}, expected: []string{"filter_1"}},
+		{name: "any topic", filters: UserFilter{ReminderWeekDay: -1, SubscribedToAnyTopic: []string{"newsletter", "study-news"}}, expected: []string{"filter_1", "filter_2"}},
+		{name: "min profile count", filters: UserFilter{ReminderWeekDay: -1, MinProfileCount: 2}, expected: []string{"filter_2"}},
+		{name: "max profile count", filters: UserFilter{ReminderWeekDay: -1, MaxProfileCount: 1}, expected: []string{"filter_1"}},
+		{name: "account type", filters: UserFilter{ReminderWeekDay: -1, AccountType: "phone"}, expected: []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			users, err := collect(tc.filters, []string{})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if len(users) != len(tc.expected) {
+				t.Errorf("unexpected number of users: %d instead of %d", len(users), len(tc.expected))
+				return
+			}
+			for i, u := range users {
+				if u.Account.AccountID != tc.expected[i] {
+					t.Errorf("unexpected user: %s", u.Account.AccountID)
+				}
+			}
+		})
+	}
+
+	t.Run("with projection", func(t *testing.T) {
+		users, err := collect(UserFilter{ReminderWeekDay: -1}, []string{"account"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 2 {
+			t.Errorf("unexpected number of users: %d", len(users))
+			return
+		}
+		if len(users[0].Profiles) > 0 || len(users[0].Roles) > 0 {
+			t.Errorf("unexpected fields loaded: %v", users[0])
+		}
+	})
+
+	t.Run("with unknown field", func(t *testing.T) {
+		_, err := collect(UserFilter{ReminderWeekDay: -1}, []string{"password"})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestDbPerformActionForUserBatches(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		_, err := testDBService.AddUser(testInstanceID, models.User{
+			Account: models.Account{AccountID: "batch_" + strconv.Itoa(i)},
+			Roles:   []string{"BATCHTEST"},
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	filters := UserFilter{ReminderWeekDay: -1, Roles: []string{"BATCHTEST"}}
+
+	batches := [][]models.User{}
+	lastIDs := []primitive.ObjectID{}
+	err := testDBService.PerfomActionForUserBatches(testInstanceID, filters, []string{}, primitive.NilObjectID, 2, func(instanceID string, users []models.User, lastID primitive.ObjectID) error {
+		batches = append(batches, users)
+		lastIDs = append(lastIDs, lastID)
+		return nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(batches) != 3 || len(batches[2]) != 1 {
+		t.Errorf("unexpected batches: %v", batches)
+		return
+	}
+	for i, batch := range batches {
+		if lastIDs[i] != batch[len(batch)-1].ID {
+			t.Errorf("unexpected last id for batch %d: %v", i, lastIDs[i])
+		}
+	}
+
+	t.Run("start after", func(t *testing.T) {
+		users := []models.User{}
+		err := testDBService.PerfomActionForUserBatches(testInstanceID, filters, []string{}, batches[0][1].ID, 10, func(instanceID string, batch []models.User, lastID primitive.ObjectID) error {
+			users = append(users, batch...)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 3 || users[0].ID != batches[1][0].ID {
+			t.Errorf("unexpected users: %v", users)
+		}
+	})
+
+	t.Run("last id of undecodable user", func(t *testing.T) {
+		brokenFilters := UserFilter{ReminderWeekDay: -1, Roles: []string{"BATCHTEST_BROKEN"}}
+		if _, err := testDBService.AddUser(testInstanceID, models.User{
+			Account: models.Account{AccountID: "batch_broken_valid"},
+			Roles:   []string{"BATCHTEST_BROKEN"},
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		ctx, cancel := testDBService.getContext()
+		defer cancel()
+		brokenID := primitive.NewObjectID()
+		if _, err := testDBService.collectionRefUsers(testInstanceID).InsertOne(ctx, bson.M{
+			"_id":     brokenID,
+			"account": "not a document",
+			"roles":   bson.A{"BATCHTEST_BROKEN"},
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		calls := 0
+		err := testDBService.PerfomActionForUserBatches(testInstanceID, brokenFilters, []string{}, primitive.NilObjectID, 10, func(instanceID string, batch []models.User, lastID primitive.ObjectID) error {
+			calls++
+			if len(batch) != 1 || lastID != brokenID {
+				t.Errorf("unexpected batch: %v, %v", batch, lastID)
+			}
+			return nil
+		})
+		if err != nil || calls != 1 {
+			t.Errorf("unexpected result: %v after %d calls", err, calls)
+		}
+	})
+
+	t.Run("stops at callback error", func(t *testing.T) {
+		calls := 0
+		err := testDBService.PerfomActionForUserBatches(testInstanceID, filters, []string{}, primitive.NilObjectID, 2, func(instanceID string, batch []models.User, lastID primitive.ObjectID) error {
+			calls++
+			return errors.New("stream closed")
+		})
+		if err == nil || calls != 1 {
+			t.Errorf("unexpected result: %v after %d calls", err, calls)
+		}
+	})
+}
+
+func TestDbInsertUser(t *testing.T) {
+	user := models.User{
+		ID:      primitive.NewObjectID(),
+		Account: models.Account{AccountID: "insert_with_id"},
+	}
+	t.Run("without id", func(t *testing.T) {
+		err := testDBService.InsertUser(testInstanceID, models.User{Account: models.Account{AccountID: "insert_without_id"}})
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with id", func(t *testing.T) {
+		if err := testDBService.InsertUser(testInstanceID, user); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		u, err := testDBService.GetUserByID(testInstanceID, user.ID.Hex())
+		if err != nil || u.Account.AccountID != "insert_with_id" {
+			t.Errorf("unexpected result: %v, %v", u, err)
+		}
+	})
+
+	t.Run("same id again", func(t *testing.T) {
+		if err := testDBService.InsertUser(testInstanceID, user); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
 func AssertNumberOfNonParticipantUsers(instanceID string, count int) error {
 	users, err := testDBService.FindNonParticipantUsers(instanceID)
 	if err != nil {
@@ -283,6 +546,12 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 		{Account: models.Account{AccountID: "delete_1"}, Roles: []string{"RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 100}},
 		{Account: models.Account{AccountID: "delete_2"}, Roles: []string{"RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 50}},
 		{Account: models.Account{AccountID: "delete_3"}, Roles: []string{"RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: time.Now().Unix()}},
+		{
+			Account:    models.Account{AccountID: "delete_invited"},
+			Roles:      []string{"RESEARCHER"},
+			Timestamps: models.Timestamps{CreatedAt: time.Now().Unix() - 100},
+			Invitation: &models.Invitation{ExpiresAt: time.Now().Unix() + 3600},
+		},
 	}
 	for _, u := range testUsers {
 		_, err := testDBService.AddUser(testInstanceID, u)
@@ -298,7 +567,7 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 			return
 		}
 		log.Printf("deleted %d users", count)
-		err = AssertNumberOfNonParticipantUsers(testInstanceID, 3)
+		err = AssertNumberOfNonParticipantUsers(testInstanceID, 4)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -312,7 +581,7 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 			return
 		}
 		log.Printf("deleted %d users", count)
-		err = AssertNumberOfNonParticipantUsers(testInstanceID, 2)
+		err = AssertNumberOfNonParticipantUsers(testInstanceID, 3)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
@@ -326,7 +595,7 @@ func TestDeleteUnverfiedUsers(t *testing.T) {
 			return
 		}
 		log.Printf("deleted %d users", count)
-		err = AssertNumberOfNonParticipantUsers(testInstanceID, 1)
+		err = AssertNumberOfNonParticipantUsers(testInstanceID, 2)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
 			return
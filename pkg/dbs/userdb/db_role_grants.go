@@ -0,0 +1,151 @@
+package userdb
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (dbService *UserDBService) AddRoleGrant(instanceID string, grant models.RoleGrant) (models.RoleGrant, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	res, err := dbService.collectionRefRoleGrants(instanceID).InsertOne(ctx, grant)
+	if err != nil {
+		return grant, err
+	}
+	grant.ID = res.InsertedID.(primitive.ObjectID)
+	return grant, nil
+}
+
+func (dbService *UserDBService) GetRoleGrant(instanceID string, id string) (models.RoleGrant, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	elem := models.RoleGrant{}
+	err := dbService.collectionRefRoleGrants(instanceID).FindOne(ctx, bson.M{"_id": _id}).Decode(&elem)
+	return elem, err
+}
+
+// FindRoleGrants returns the grants matching the non-empty arguments, most recent first
+func (dbService *UserDBService) FindRoleGrants(instanceID string, userID string, role string, status string) ([]models.RoleGrant, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["userID"] = userID
+	}
+	if role != "" {
+		filter["role"] = role
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	return dbService.findRoleGrants(instanceID, filter)
+}
+
+// FindExpiredRoleGrants returns the approved grants with a time limit before the given time
+func (dbService *UserDBService) FindExpiredRoleGrants(instanceID string, before int64) ([]models.RoleGrant, error) {
+	filter := bson.M{
+		"status":    models.ROLE_GRANT_STATUS_APPROVED,
+		"expiresAt": bson.M{"$gt": 0, "$lt": before},
+	}
+	return dbService.findRoleGrants(instanceID, filter)
+}
+
+func (dbService *UserDBService) findRoleGrants(instanceID string, filter bson.M) ([]models.RoleGrant, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "requestedAt", Value: -1}})
+	cur, err := dbService.collectionRefRoleGrants(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return []models.RoleGrant{}, err
+	}
+	defer cur.Close(ctx)
+
+	grants := []models.RoleGrant{}
+	for cur.Next(ctx) {
+		var result models.RoleGrant
+		if err := cur.Decode(&result); err != nil {
+			return grants, err
+		}
+		grants = append(grants, result)
+	}
+	return grants, cur.Err()
+}
+
+// UpdateRoleGrantStatus changes the status of the grant if it currently has fromStatus, mongo.ErrNoDocuments otherwise
+func (dbService *UserDBService) UpdateRoleGrantStatus(instanceID string, id string, fromStatus string, toStatus string, decidedBy string) (models.RoleGrant, error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": _id, "status": fromStatus}
+	set := bson.M{"status": toStatus}
+	if decidedBy != "" {
+		set["decidedBy"] = decidedBy
+		set["decidedAt"] = time.Now().Unix()
+	}
+
+	elem := models.RoleGrant{}
+	rd := options.After
+	fro := options.FindOneAndUpdateOptions{
+		ReturnDocument: &rd,
+	}
+	err := dbService.collectionRefRoleGrants(instanceID).FindOneAndUpdate(ctx, filter, bson.M{"$set": set}, &fro).Decode(&elem)
+	return elem, err
+}
+
+// EndRoleGrants sets the status of the approved grants of the role for the user, e.g. when the role is removed
+func (dbService *UserDBService) EndRoleGrants(instanceID string, userID string, role string, status string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	filter := bson.M{"userID": userID, "role": role, "status": models.ROLE_GRANT_STATUS_APPROVED}
+	update := bson.M{"$set": bson.M{"status": status}}
+	_, err := dbService.collectionRefRoleGrants(instanceID).UpdateMany(ctx, filter, update)
+	return err
+}
+
+// RemoveRoleFromUser removes the role and its scopes without loading the user
+func (dbService *UserDBService) RemoveRoleFromUser(instanceID string, userID string, role string) error {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	_id, _ := primitive.ObjectIDFromHex(userID)
+	filter := bson.M{"_id": _id}
+	update := bson.M{
+		"$pull":  bson.M{"roles": role},
+		"$unset": bson.M{"roleScopes." + role: ""},
+		"$set":   bson.M{"timestamps.updatedAt": time.Now().Unix()},
+	}
+	_, err := dbService.collectionRefUsers(instanceID).UpdateOne(ctx, filter, update)
+	return err
+}
+
+// MoveRoleGrantsToInstance keeps the grant history of a user who was moved to another instance
+func (dbService *UserDBService) MoveRoleGrantsToInstance(userID string, fromInstanceID string, toInstanceID string) (int64, error) {
+	grants, err := dbService.findRoleGrants(fromInstanceID, bson.M{"userID": userID})
+	if err != nil || len(grants) == 0 {
+		return 0, err
+	}
+
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	docs := make([]interface{}, len(grants))
+	for i, grant := range grants {
+		docs[i] = grant
+	}
+	if _, err := dbService.collectionRefRoleGrants(toInstanceID).InsertMany(ctx, docs); err != nil {
+		return 0, err
+	}
+	res, err := dbService.collectionRefRoleGrants(fromInstanceID).DeleteMany(ctx, bson.M{"userID": userID})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
@@ -0,0 +1,75 @@
+package userdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbRoleGrants(t *testing.T) {
+	now := time.Now().Unix()
+	var pendingID, expiredID string
+
+	t.Run("add grants", func(t *testing.T) {
+		grant, err := testDBService.AddRoleGrant(testInstanceID, models.RoleGrant{
+			UserID:      "grant_user_1",
+			Role:        "ADMIN",
+			Status:      models.ROLE_GRANT_STATUS_PENDING,
+			RequestedBy: "admin_1",
+			RequestedAt: now,
+		})
+		if err != nil || grant.ID.IsZero() {
+			t.Errorf("unexpected result: %v, %v", grant, err)
+			return
+		}
+		pendingID = grant.ID.Hex()
+
+		grant, err = testDBService.AddRoleGrant(testInstanceID, models.RoleGrant{
+			UserID:      "grant_user_2",
+			Role:        "RESEARCHER",
+			Status:      models.ROLE_GRANT_STATUS_APPROVED,
+			RequestedBy: "admin_1",
+			RequestedAt: now - 10,
+			ExpiresAt:   now - 5,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		expiredID = grant.ID.Hex()
+	})
+
+	t.Run("find grants", func(t *testing.T) {
+		grants, err := testDBService.FindRoleGrants(testInstanceID, "grant_user_1", "", models.ROLE_GRANT_STATUS_PENDING)
+		if err != nil || len(grants) != 1 || grants[0].ID.Hex() != pendingID {
+			t.Errorf("unexpected result: %v, %v", grants, err)
+		}
+		grants, err = testDBService.FindExpiredRoleGrants(testInstanceID, now)
+		if err != nil || len(grants) != 1 || grants[0].ID.Hex() != expiredID {
+			t.Errorf("unexpected result: %v, %v", grants, err)
+		}
+	})
+
+	t.Run("update status", func(t *testing.T) {
+		grant, err := testDBService.UpdateRoleGrantStatus(testInstanceID, pendingID, models.ROLE_GRANT_STATUS_PENDING, models.ROLE_GRANT_STATUS_APPROVED, "admin_2")
+		if err != nil || grant.Status != models.ROLE_GRANT_STATUS_APPROVED || grant.DecidedBy != "admin_2" || grant.DecidedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", grant, err)
+		}
+		_, err = testDBService.UpdateRoleGrantStatus(testInstanceID, pendingID, models.ROLE_GRANT_STATUS_PENDING, models.ROLE_GRANT_STATUS_REJECTED, "admin_2")
+		if err == nil {
+			t.Error("should only update pending grants")
+		}
+	})
+
+	t.Run("end grants", func(t *testing.T) {
+		if err := testDBService.EndRoleGrants(testInstanceID, "grant_user_2", "RESEARCHER", models.ROLE_GRANT_STATUS_REVOKED); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		grant, err := testDBService.GetRoleGrant(testInstanceID, expiredID)
+		if err != nil || grant.Status != models.ROLE_GRANT_STATUS_REVOKED {
+			t.Errorf("unexpected result: %v, %v", grant, err)
+		}
+	})
+}
@@ -0,0 +1,204 @@
+package userdb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"regexp"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	SEARCH_SORT_BY_ACCOUNT_ID = "accountId"
+	SEARCH_SORT_BY_CREATED_AT = "createdAt"
+	SEARCH_SORT_BY_LAST_LOGIN = "lastLogin"
+)
+
+// ErrInvalidCursor is returned if the cursor doesn't belong to a search with the same sort order
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// SearchSortFields maps the sort options to the document fields
+var SearchSortFields = map[string]string{
+	SEARCH_SORT_BY_ACCOUNT_ID: "account.accountID",
+	SEARCH_SORT_BY_CREATED_AT: "timestamps.createdAt",
+	SEARCH_SORT_BY_LAST_LOGIN: "timestamps.lastLogin",
+}
+
+// UserSearchQuery describes filters, sort order and page of an admin user search. Empty fields are ignored.
+type UserSearchQuery struct {
+	AccountIDPrefix string
+	Roles           []string // any of these
+	OnlyConfirmed   bool
+	OnlyUnconfirmed bool
+	CreatedAfter    int64
+	CreatedBefore   int64
+	LastLoginAfter  int64
+	LastLoginBefore int64
+	SortBy          string
+	SortDescending  bool
+	PageSize        int64
+	Cursor          string // returned by the previous page
+}
+
+// searchCursor is the position after the last user of a page (keyset pagination)
+type searchCursor struct {
+	SortValue interface{} `json:"v"`
+	ID        string      `json:"id"`
+}
+
+func (q UserSearchQuery) filter() bson.M {
+	filter := bson.M{}
+	if q.AccountIDPrefix != "" {
+		// anchored prefix regex can use the accountID index
+		filter["account.accountID"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(q.AccountIDPrefix)}
+	}
+	if len(q.Roles) > 0 {
+		filter["roles"] = bson.M{"$in": q.Roles}
+	}
+	if q.OnlyConfirmed {
+		filter["account.accountConfirmedAt"] = bson.M{"$gt": 0}
+	} else if q.OnlyUnconfirmed {
+		filter["account.accountConfirmedAt"] = bson.M{"$lte": 0}
+	}
+	addRange(filter, "timestamps.createdAt", q.CreatedAfter, q.CreatedBefore)
+	addRange(filter, "timestamps.lastLogin", q.LastLoginAfter, q.LastLoginBefore)
+	return filter
+}
+
+func addRange(filter bson.M, field string, after int64, before int64) {
+	r := bson.M{}
+	if after > 0 {
+		r["$gte"] = after
+	}
+	if before > 0 {
+		r["$lt"] = before
+	}
+	if len(r) > 0 {
+		filter[field] = r
+	}
+}
+
+func encodeSearchCursor(sortBy string, user models.User) (string, error) {
+	c := searchCursor{ID: user.ID.Hex()}
+	switch sortBy {
+	case SEARCH_SORT_BY_CREATED_AT:
+		c.SortValue = user.Timestamps.CreatedAt
+	case SEARCH_SORT_BY_LAST_LOGIN:
+		c.SortValue = user.Timestamps.LastLogin
+	default:
+		c.SortValue = user.Account.AccountID
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeSearchCursor(sortBy string, cursor string) (sortValue interface{}, id primitive.ObjectID, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, id, ErrInvalidCursor
+	}
+	c := searchCursor{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, id, ErrInvalidCursor
+	}
+	id, err = primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, id, ErrInvalidCursor
+	}
+
+	switch v := c.SortValue.(type) {
+	case string:
+		if sortBy != SEARCH_SORT_BY_ACCOUNT_ID {
+			return nil, id, ErrInvalidCursor
+		}
+		return v, id, nil
+	case float64:
+		if sortBy == SEARCH_SORT_BY_ACCOUNT_ID {
+			return nil, id, ErrInvalidCursor
+		}
+		return int64(v), id, nil
+	}
+	return nil, id, ErrInvalidCursor
+}
+
+// SearchUsers returns one page of users matching the query, the total number of matches and the cursor of the next page
+func (dbService *UserDBService) SearchUsers(instanceID string, q UserSearchQuery) (users []models.User, totalCount int64, nextCursor string, err error) {
+	ctx, cancel := dbService.getContext()
+	defer cancel()
+
+	if q.SortBy == "" {
+		q.SortBy = SEARCH_SORT_BY_ACCOUNT_ID
+	}
+	sortField, ok := SearchSortFields[q.SortBy]
+	if !ok {
+		return users, 0, "", errors.New("unknown sort field")
+	}
+	if q.PageSize < 1 {
+		return users, 0, "", errors.New("page size must be positive")
+	}
+
+	filter := q.filter()
+	totalCount, err = dbService.collectionRefUsers(instanceID).CountDocuments(ctx, filter)
+	if err != nil {
+		return users, 0, "", err
+	}
+
+	direction := 1
+	cmp := "$gt"
+	if q.SortDescending {
+		direction = -1
+		cmp = "$lt"
+	}
+
+	if q.Cursor != "" {
+		sortValue, lastID, err := decodeSearchCursor(q.SortBy, q.Cursor)
+		if err != nil {
+			return users, totalCount, "", err
+		}
+		filter = bson.M{"$and": bson.A{
+			filter,
+			bson.M{"$or": bson.A{
+				bson.M{sortField: bson.M{cmp: sortValue}},
+				bson.M{sortField: sortValue, "_id": bson.M{cmp: lastID}},
+			}},
+		}}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: direction}, {Key: "_id", Value: direction}}).
+		SetLimit(q.PageSize + 1) // one more to know if there is a next page
+	cur, err := dbService.collectionRefUsers(instanceID).Find(ctx, filter, opts)
+	if err != nil {
+		return users, totalCount, "", err
+	}
+	defer cur.Close(ctx)
+
+	users = []models.User{}
+	for cur.Next(ctx) {
+		var result models.User
+		err := cur.Decode(&result)
+		if err != nil {
+			return users, totalCount, "", err
+		}
+		users = append(users, result)
+	}
+	if err := cur.Err(); err != nil {
+		return users, totalCount, "", err
+	}
+
+	if int64(len(users)) > q.PageSize {
+		users = users[:q.PageSize]
+		nextCursor, err = encodeSearchCursor(q.SortBy, users[len(users)-1])
+		if err != nil {
+			return users, totalCount, "", err
+		}
+	}
+	return users, totalCount, nextCursor, nil
+}
@@ -0,0 +1,106 @@
+package userdb
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestDbSearchUsers(t *testing.T) {
+	now := time.Now().Unix()
+	testUsers := []models.User{
+		{Account: models.Account{AccountID: "search_a@test.com", AccountConfirmedAt: now}, Roles: []string{"PARTICIPANT"}, Timestamps: models.Timestamps{CreatedAt: now - 300}},
+		{Account: models.Account{AccountID: "search_b@test.com"}, Roles: []string{"PARTICIPANT"}, Timestamps: models.Timestamps{CreatedAt: now - 200}},
+		{Account: models.Account{AccountID: "search_c@test.com", AccountConfirmedAt: now}, Roles: []string{"PARTICIPANT", "RESEARCHER"}, Timestamps: models.Timestamps{CreatedAt: now - 100}},
+	}
+	for _, u := range testUsers {
+		_, err := testDBService.AddUser(testInstanceID, u)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := testDBService.CreateIndexes(testInstanceID); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	t.Run("first page by account id prefix", func(t *testing.T) {
+		users, total, next, err := testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			PageSize:        2,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if total != 3 || len(users) != 2 || next == "" {
+			t.Errorf("unexpected result: %d users, total %d, cursor '%s'", len(users), total, next)
+			return
+		}
+		if users[0].Account.AccountID != "search_a@test.com" {
+			t.Errorf("unexpected order: %s", users[0].Account.AccountID)
+		}
+
+		users, _, next, err = testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			PageSize:        2,
+			Cursor:          next,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 1 || next != "" || users[0].Account.AccountID != "search_c@test.com" {
+			t.Errorf("unexpected last page: %v, cursor '%s'", users, next)
+		}
+	})
+
+	t.Run("sorted by creation date descending", func(t *testing.T) {
+		users, _, _, err := testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			SortBy:          SEARCH_SORT_BY_CREATED_AT,
+			SortDescending:  true,
+			PageSize:        10,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(users) != 3 || users[0].Account.AccountID != "search_c@test.com" {
+			t.Errorf("unexpected result: %v", users)
+		}
+	})
+
+	t.Run("filter by role and confirmation", func(t *testing.T) {
+		users, total, _, err := testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			Roles:           []string{"RESEARCHER"},
+			OnlyConfirmed:   true,
+			PageSize:        10,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if total != 1 || len(users) != 1 {
+			t.Errorf("unexpected result: %v", users)
+		}
+	})
+
+	t.Run("cursor of other sort order", func(t *testing.T) {
+		_, _, next, _ := testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			PageSize:        1,
+		})
+		_, _, _, err := testDBService.SearchUsers(testInstanceID, UserSearchQuery{
+			AccountIDPrefix: "search_",
+			SortBy:          SEARCH_SORT_BY_CREATED_AT,
+			PageSize:        1,
+			Cursor:          next,
+		})
+		if err != ErrInvalidCursor {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -43,8 +43,11 @@ func (s *userManagementServer) ChangePassword(ctx context.Context, req *api.Pass
 	if req == nil || utils.IsTokenEmpty(req.Token) {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
 
-	if !utils.CheckPasswordFormat(req.NewPassword) {
+	if !s.checkPassword(req.Token.InstanceId, req.NewPassword) {
 		return nil, status.Error(codes.InvalidArgument, "new password too weak")
 	}
 
@@ -100,6 +103,9 @@ func (s *userManagementServer) ChangeAccountIDEmail(ctx context.Context, req *ap
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.NewEmail == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
 
 	req.NewEmail = utils.SanitizeEmail(req.NewEmail)
 	if !utils.CheckEmailFormat(req.NewEmail) {
@@ -243,10 +249,123 @@ func (s *userManagementServer) ChangeAccountIDEmail(ctx context.Context, req *ap
 	return updUser.ToAPI(), nil
 }
 
+func (s *userManagementServer) PromoteContactInfoToAccountID(ctx context.Context, req *api.PromoteContactInfoMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfoId == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+
+	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, req.Password)
+	if err != nil || !match {
+		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, "promote contact info endpoint")
+		return nil, status.Error(codes.InvalidArgument, "action failed")
+	}
+
+	if user.Account.Type != models.ACCOUNT_TYPE_EMAIL {
+		return nil, status.Error(codes.Internal, "account is not email type")
+	}
+
+	newCI, found := user.FindContactInfoById(req.ContactInfoId)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "contact not found")
+	}
+	if newCI.Type != "email" || newCI.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "wrong contact type")
+	}
+	if newCI.ConfirmedAt <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "contact not confirmed")
+	}
+	if newCI.Email == user.Account.AccountID {
+		return nil, status.Error(codes.InvalidArgument, "contact is already the account id")
+	}
+
+	// is email address still free to use?
+	_, err = s.userDBservice.GetUserByAccountID(req.Token.InstanceId, newCI.Email)
+	if err == nil {
+		return nil, status.Error(codes.Internal, "action failed")
+	}
+
+	oldAccountID := user.Account.AccountID
+	oldCI, oldFound := user.FindContactInfoByTypeAndAddr("email", oldAccountID)
+
+	if user.Account.AccountConfirmedAt > 0 {
+		// Old AccountID was confirmed, let the owner of the old address restore it
+		tempTokenInfos := models.TempToken{
+			UserID:     user.ID.Hex(),
+			InstanceID: req.Token.InstanceId,
+			Purpose:    constants.TOKEN_PURPOSE_RESTORE_ACCOUNT_ID,
+			Info: map[string]string{
+				"oldEmail": oldAccountID,
+				"newEmail": newCI.Email,
+			},
+			Expiration: tokens.GetExpirationTime(time.Hour * 24 * 7),
+		}
+		tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// ---> Trigger message sending
+		_, err = s.clients.MessagingService.SendInstantEmail(ctx, &messageAPI.SendEmailReq{
+			InstanceId:        req.Token.InstanceId,
+			To:                []string{oldAccountID},
+			MessageType:       constants.EMAIL_TYPE_ACCOUNT_ID_CHANGED,
+			PreferredLanguage: user.Account.PreferredLanguage,
+			ContentInfos: map[string]string{
+				"restoreToken": tempToken,
+				"validUntil":   strconv.Itoa(24 * 7 * 60),
+				"newEmail":     newCI.Email,
+			},
+			UseLowPrio: true,
+		})
+		if err != nil {
+			log.Printf("PromoteContactInfoToAccountID: %s", err.Error())
+		}
+		// <---
+	} else {
+		// contact info is confirmed, so the account is confirmed with it
+		user.Account.AccountConfirmedAt = newCI.ConfirmedAt
+	}
+
+	if user.Profiles[0].Alias == oldAccountID {
+		user.Profiles[0].Alias = newCI.Email
+	}
+	user.Account.AccountID = newCI.Email
+
+	if oldFound {
+		user.ReplaceContactInfoInContactPreferences(oldCI.ID.Hex(), newCI.ID.Hex())
+		if !req.KeepOldEmail {
+			if err := user.RemoveContactInfo(oldCI.ID.Hex()); err != nil {
+				log.Println(err.Error())
+			}
+		}
+	}
+
+	// Save user:
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, updUser.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ID_CHANGED, "promoted contact info: "+oldAccountID+" -> "+updUser.Account.AccountID)
+
+	return updUser.ToAPI(), nil
+}
+
 func (s *userManagementServer) DeleteAccount(ctx context.Context, req *api.UserReference) (*api.ServiceStatus, error) {
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
 
 	// TODO: check if user auth is from admin - to remove user by admin
 	if req.Token.Id != req.UserId {
@@ -366,10 +485,33 @@ func (s *userManagementServer) UpdateContactPreferences(ctx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
 
-	user, err := s.userDBservice.UpdateContactPreferences(req.Token.InstanceId, req.Token.Id, models.ContactPreferencesFromAPI(req.ContactPreferences))
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+
+	if req.ContactPreferences.SendVerificationCodeTo != "" {
+		ci, found := user.FindContactInfoById(req.ContactPreferences.SendVerificationCodeTo)
+		if !found || ci.Type != models.CONTACT_TYPE_PHONE || ci.ConfirmedAt <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "verification code channel must be a confirmed phone number")
+		}
+	}
+
+	prefs, pendingTopics, err := s.mergeContactPreferences(req.Token.InstanceId, user, models.ContactPreferencesFromAPI(req.ContactPreferences))
+	if err != nil {
+		return nil, err
+	}
+
+	user, err = s.userDBservice.UpdateContactPreferences(req.Token.InstanceId, req.Token.Id, prefs)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	for _, topic := range pendingTopics {
+		if err := s.sendSubscriptionConfirmation(req.Token.InstanceId, user, topic); err != nil {
+			log.Printf("UpdateContactPreferences: %s", err.Error())
+		}
+	}
 	return user.ToAPI(), nil
 }
 
@@ -389,13 +531,16 @@ func (s *userManagementServer) UseUnsubscribeToken(ctx context.Context, req *api
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	user.ContactPreferences.SubscribedToNewsletter = false
+	topic := getUnsubscribeTopic(*tokenInfos)
+	user.ContactPreferences.SetSubscription(topic, false, nil)
 
 	_, err = s.userDBservice.UpdateContactPreferences(tokenInfos.InstanceID, user.ID.Hex(), user.ContactPreferences)
 	if err != nil {
 		log.Printf("UseUnsubscribeToken: %s", err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	s.SaveLogEvent(tokenInfos.InstanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventUnsubscribed, topic)
 	return &api.ServiceStatus{
 		Status: api.ServiceStatus_NORMAL,
 		Msg:    "unsubscribed",
@@ -406,6 +551,9 @@ func (s *userManagementServer) AddEmail(ctx context.Context, req *api.ContactInf
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfo == nil {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
 
 	if req.ContactInfo.Type != "email" {
 		return nil, status.Error(codes.InvalidArgument, "wrong contact type")
@@ -467,6 +615,9 @@ func (s *userManagementServer) RemoveEmail(ctx context.Context, req *api.Contact
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfo == nil {
 		return nil, status.Error(codes.InvalidArgument, "missing argument")
 	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
 	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "user not found")
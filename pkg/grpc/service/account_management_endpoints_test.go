@@ -564,6 +564,171 @@ func TestChangeAccountIDEmailEndpoint(t *testing.T) {
 	})
 }
 
+func TestPromoteContactInfoToAccountIDEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockMessagingClient := messageMock.NewMockMessagingServiceApiClient(mockCtrl)
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			MessagingService: mockMessagingClient,
+			LoggingService:   mockLoggingClient,
+		},
+	}
+
+	testPw := "test234-TESt??"
+	hashPw, _ := pwhash.HashPassword(testPw)
+	mainContactID := primitive.NewObjectID()
+	confirmedContactID := primitive.NewObjectID()
+	unconfirmedContactID := primitive.NewObjectID()
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:               "email",
+				AccountID:          "promote_contact_0@test.com",
+				AccountConfirmedAt: 1231239192,
+				Password:           hashPw,
+			},
+			Profiles: []models.Profile{
+				{ID: primitive.NewObjectID(), Alias: "promote_contact_0@test.com"},
+			},
+			ContactInfos: []models.ContactInfo{
+				{
+					ID:          mainContactID,
+					Type:        "email",
+					Email:       "promote_contact_0@test.com",
+					ConfirmedAt: 1231239192,
+				},
+				{
+					ID:          confirmedContactID,
+					Type:        "email",
+					Email:       "promote_contact_0_new@test.com",
+					ConfirmedAt: 1231239192,
+				},
+				{
+					ID:          unconfirmedContactID,
+					Type:        "email",
+					Email:       "promote_contact_0_unconfirmed@test.com",
+					ConfirmedAt: 0,
+				},
+			},
+			ContactPreferences: models.ContactPreferences{
+				SendNewsletterTo: []string{mainContactID.Hex()},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.PromoteContactInfoToAccountID(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with empty payload", func(t *testing.T) {
+		req := &api.PromoteContactInfoMsg{}
+		_, err := s.PromoteContactInfoToAccountID(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong password", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		req := &api.PromoteContactInfoMsg{
+			Token:         token,
+			ContactInfoId: confirmedContactID.Hex(),
+			Password:      "wrong",
+		}
+		_, err := s.PromoteContactInfoToAccountID(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "action failed")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown contact info", func(t *testing.T) {
+		req := &api.PromoteContactInfoMsg{
+			Token:         token,
+			ContactInfoId: primitive.NewObjectID().Hex(),
+			Password:      testPw,
+		}
+		_, err := s.PromoteContactInfoToAccountID(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "contact not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unconfirmed contact info", func(t *testing.T) {
+		req := &api.PromoteContactInfoMsg{
+			Token:         token,
+			ContactInfoId: unconfirmedContactID.Hex(),
+			Password:      testPw,
+		}
+		_, err := s.PromoteContactInfoToAccountID(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "contact not confirmed")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with confirmed contact info", func(t *testing.T) {
+		mockMessagingClient.EXPECT().SendInstantEmail(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		req := &api.PromoteContactInfoMsg{
+			Token:         token,
+			ContactInfoId: confirmedContactID.Hex(),
+			Password:      testPw,
+		}
+		resp, err := s.PromoteContactInfoToAccountID(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Account.AccountId != "promote_contact_0_new@test.com" {
+			t.Errorf("unexpected accountID: %s", resp.Account.AccountId)
+		}
+		if resp.Account.AccountConfirmedAt != 1231239192 {
+			t.Errorf("unexpected AccountConfirmedAt: %d", resp.Account.AccountConfirmedAt)
+		}
+		if len(resp.ContactInfos) != 2 {
+			t.Errorf("unexpected number of contact infos: %d", len(resp.ContactInfos))
+		}
+		if len(resp.ContactPreferences.SendNewsletterTo) != 1 || resp.ContactPreferences.SendNewsletterTo[0] != confirmedContactID.Hex() {
+			t.Errorf("unexpected newsletter references: %v", resp.ContactPreferences.SendNewsletterTo)
+		}
+	})
+}
+
 func TestDeleteAccountEndpoint(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -1004,10 +1169,67 @@ func TestUpdateContactPreferencesEndpoint(t *testing.T) {
 		if len(resp.ContactPreferences.SendNewsletterTo) != 1 || resp.ContactPreferences.SubscribedToNewsletter {
 			t.Errorf("wrong response: %s", resp)
 		}
+		if len(resp.ContactPreferences.Subscriptions) < 1 || resp.ContactPreferences.Subscriptions[0].UnsubscribedAt <= 0 {
+			t.Errorf("withdrawal of consent should be recorded: %s", resp)
+		}
+	})
+
+	t.Run("with unknown topic", func(t *testing.T) {
+		req := &api.ContactPreferencesMsg{
+			Token: &token,
+			ContactPreferences: &api.ContactPreferences{
+				Subscriptions: []*api.TopicSubscription{
+					{Topic: "not-defined", Subscribed: true},
+				},
+			},
+		}
+		_, err := s.UpdateContactPreferences(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown subscription topic: not-defined")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("subscribe to instance topic", func(t *testing.T) {
+		_, err := testGlobalDBService.SaveSubscriptionTopic(models.SubscriptionTopic{
+			InstanceID: testInstanceID,
+			Key:        "results-digest",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		req := &api.ContactPreferencesMsg{
+			Token: &token,
+			ContactPreferences: &api.ContactPreferences{
+				Subscriptions: []*api.TopicSubscription{
+					{Topic: "results-digest", Subscribed: true},
+				},
+			},
+		}
+		resp, err := s.UpdateContactPreferences(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		found := false
+		for _, sub := range resp.ContactPreferences.Subscriptions {
+			if sub.Topic == "results-digest" && sub.Subscribed && sub.SubscribedAt > 0 {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("wrong response: %s", resp)
+		}
 	})
 }
 
 func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
 		globalDBService: testGlobalDBService,
@@ -1015,6 +1237,9 @@ func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
 			TokenExpiryInterval:      time.Second * 2,
 			VerificationCodeLifetime: 60,
 		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
 	}
 	testUsers, err := addTestUsers([]models.User{
 		{
@@ -1024,6 +1249,7 @@ func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
 			},
 			ContactPreferences: models.ContactPreferences{
 				SubscribedToNewsletter: true,
+				SubscribedToWeekly:     true,
 			},
 			ContactInfos: []models.ContactInfo{
 				{
@@ -1081,6 +1307,11 @@ func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
 	})
 
 	t.Run("with valid token", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
 		req := &api.TempToken{
 			Token: unsubscribeToken,
 		}
@@ -1098,6 +1329,43 @@ func TestUseUnsubscribeTokenEndpoint(t *testing.T) {
 		if user.ContactPreferences.SubscribedToNewsletter {
 			t.Error("should be unsubscribed")
 		}
+		if !user.ContactPreferences.SubscribedToWeekly {
+			t.Error("should still be subscribed to weekly messages")
+		}
+	})
+
+	t.Run("with token for weekly topic", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		weeklyToken, err := s.globalDBService.AddTempToken(models.TempToken{
+			InstanceID: testInstanceID,
+			UserID:     testUsers[0].ID.Hex(),
+			Purpose:    "unsubscribe-newsletter",
+			Info:       map[string]string{"topic": "weekly"},
+			Expiration: time.Now().Unix() + 5000000,
+		})
+		if err != nil {
+			t.Errorf("failed to create test token: %s", err.Error())
+			return
+		}
+
+		_, err = s.UseUnsubscribeToken(context.Background(), &api.TempToken{Token: weeklyToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		user, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected token: %v", err)
+			return
+		}
+		if user.ContactPreferences.SubscribedToWeekly {
+			t.Error("should be unsubscribed")
+		}
 	})
 }
 
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// appTokenMetadataKey is the metadata key clients send their app token with
+	appTokenMetadataKey = "app-token"
+	// defaultInstanceID is used by the public endpoints when the request has no instance id
+	defaultInstanceID = "default"
+)
+
+// appTokenCheckedMethods are the public endpoints that take the instance from the request (and fall back to the default
+// instance). Other endpoints get the instance from a user token or temp token, or are called by other services.
+var appTokenCheckedMethods = map[string]bool{
+	"/influenzanet.user_management_api.UserManagementApi/SendVerificationCode":  true,
+	"/influenzanet.user_management_api.UserManagementApi/LoginWithEmail":        true,
+	"/influenzanet.user_management_api.UserManagementApi/LoginWithExternalIDP":  true,
+	"/influenzanet.user_management_api.UserManagementApi/SignupWithEmail":       true,
+	"/influenzanet.user_management_api.UserManagementApi/InitiatePasswordReset": true,
+	"/influenzanet.user_management_api.UserManagementApi/LoginWithAPIKey":       true,
+}
+
+type instanceRequest interface {
+	GetInstanceId() string
+}
+
+// appTokenInterceptor rejects requests to the public endpoints without a valid app token in the metadata, or for an
+// instance the app token is not valid for (also the default instance if the request has none)
+func appTokenInterceptor(globalDBService *globaldb.GlobalDBService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !appTokenCheckedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(appTokenMetadataKey)
+		if len(values) == 0 || values[0] == "" {
+			return nil, status.Error(codes.Unauthenticated, "missing app token")
+		}
+		appToken, err := validateAppToken(globalDBService, values[0])
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid app token")
+		}
+
+		instanceID := defaultInstanceID
+		if r, ok := req.(instanceRequest); ok && r.GetInstanceId() != "" {
+			instanceID = r.GetInstanceId()
+		}
+		for _, allowed := range appToken.Instances {
+			if allowed == instanceID {
+				return handler(ctx, req)
+			}
+		}
+		log.Printf("%s: app token %s (%s) not valid for instance %s", info.FullMethod, appToken.ID.Hex(), appToken.AppName, instanceID)
+		return nil, status.Error(codes.PermissionDenied, "instance not allowed for app token")
+	}
+}
+
+// validateAppToken looks up an active app token and records its use (at most every appTokenUsageUpdateInterval)
+func validateAppToken(globalDBService *globaldb.GlobalDBService, token string) (models.AppToken, error) {
+	appToken, err := globalDBService.FindAppToken(token)
+	if err != nil {
+		return appToken, err
+	}
+	now := time.Now().Unix()
+	if !appToken.IsActive(now) {
+		return appToken, status.Error(codes.InvalidArgument, "invalid app token")
+	}
+	if now-appToken.LastUsedAt > appTokenUsageUpdateInterval {
+		if err := globalDBService.SaveAppTokenUsed(appToken.ID, now); err != nil {
+			log.Printf("app token usage not saved: %v", err)
+		}
+	}
+	return appToken, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAppTokenInterceptor(t *testing.T) {
+	_, err := testGlobalDBService.AddAppToken(models.AppToken{
+		AppName:   "interceptor-app",
+		Instances: []string{testInstanceID},
+		Tokens:    []string{"interceptor-token"},
+	})
+	if err != nil {
+		t.Errorf("unexpected error when creating app token: %s", err.Error())
+		return
+	}
+
+	interceptor := appTokenInterceptor(testGlobalDBService)
+	loginInfo := &grpc.UnaryServerInfo{FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithEmail"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "handled", nil
+	}
+	withAppToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("app-token", token))
+	}
+
+	t.Run("other methods are not checked", func(t *testing.T) {
+		resp, err := interceptor(context.Background(), &api.JWTRequest{}, &grpc.UnaryServerInfo{FullMethod: "/influenzanet.user_management_api.UserManagementApi/ValidateJWT"}, handler)
+		if err != nil || resp != "handled" {
+			t.Errorf("unexpected result: %v, %v", resp, err)
+		}
+	})
+
+	t.Run("without app token", func(t *testing.T) {
+		_, err := interceptor(context.Background(), &api.LoginWithEmailMsg{InstanceId: testInstanceID}, loginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong app token", func(t *testing.T) {
+		_, err := interceptor(withAppToken("wrong"), &api.LoginWithEmailMsg{InstanceId: testInstanceID}, loginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("other instance", func(t *testing.T) {
+		_, err := interceptor(withAppToken("interceptor-token"), &api.LoginWithEmailMsg{InstanceId: "other-instance"}, loginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance not allowed for app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("no default fallback", func(t *testing.T) {
+		_, err := interceptor(withAppToken("interceptor-token"), &api.LoginWithEmailMsg{}, loginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance not allowed for app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	apiKeyLoginInfo := &grpc.UnaryServerInfo{FullMethod: "/influenzanet.user_management_api.UserManagementApi/LoginWithAPIKey"}
+	t.Run("api key login without app token", func(t *testing.T) {
+		_, err := interceptor(context.Background(), &api.LoginWithAPIKeyMsg{InstanceId: testInstanceID, ApiKey: "key"}, apiKeyLoginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("api key login for other instance", func(t *testing.T) {
+		_, err := interceptor(withAppToken("interceptor-token"), &api.LoginWithAPIKeyMsg{InstanceId: "other-instance", ApiKey: "key"}, apiKeyLoginInfo, handler)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance not allowed for app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("api key login with app token", func(t *testing.T) {
+		resp, err := interceptor(withAppToken("interceptor-token"), &api.LoginWithAPIKeyMsg{InstanceId: testInstanceID, ApiKey: "key"}, apiKeyLoginInfo, handler)
+		if err != nil || resp != "handled" {
+			t.Errorf("unexpected result: %v, %v", resp, err)
+		}
+	})
+
+	t.Run("allowed instance", func(t *testing.T) {
+		resp, err := interceptor(withAppToken("interceptor-token"), &api.LoginWithEmailMsg{InstanceId: testInstanceID}, loginInfo, handler)
+		if err != nil || resp != "handled" {
+			t.Errorf("unexpected result: %v, %v", resp, err)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"log"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+)
+
+// authorize checks if the token grants the permission in its instance. Tokens without permissions claim (issued before
+// the claim existed or by other services) are checked against the current definitions of their roles.
+func (s *userManagementServer) authorize(t *api_types.TokenInfos, permission string) bool {
+	if t == nil {
+		return false
+	}
+	permissions, ok := authz.PermissionsFromPayload(t.Payload)
+	if !ok {
+		var err error
+		permissions, err = s.permissionsForRoles(t.InstanceId, tokens.GetRolesFromPayload(t.Payload))
+		if err != nil {
+			log.Printf("authorize: %v", err)
+			return false
+		}
+	}
+	return authz.HasPermission(permissions, permission)
+}
+
+// permissionsForRoles resolves the permissions of the roles with the role definitions of the instance
+func (s *userManagementServer) permissionsForRoles(instanceID string, roles []string) ([]string, error) {
+	defs, err := s.globalDBService.GetRoleDefinitions(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return authz.ResolvePermissions(roles, defs), nil
+}
+
+// tokenPermissions returns the permissions to embed in a new access token, none if they can't be resolved (checked
+// again on each request then)
+func (s *userManagementServer) tokenPermissions(instanceID string, roles []string) []string {
+	permissions, err := s.permissionsForRoles(instanceID, roles)
+	if err != nil {
+		log.Printf("permissions for token could not be resolved: %v", err)
+		return nil
+	}
+	return permissions
+}
+
+// canAssignRole checks the additional permission some roles need to be given or taken away
+func (s *userManagementServer) canAssignRole(t *api_types.TokenInfos, role string) bool {
+	permission := authz.RequiredPermissionToAssign(role)
+	return permission == "" || s.authorize(t, permission)
+}
+
+// isImpersonated checks if the token was obtained by impersonation, such tokens must not be used for sensitive actions
+func isImpersonated(t *api_types.TokenInfos) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.Payload[authz.PAYLOAD_KEY_IMPERSONATOR]
+	return ok
+}
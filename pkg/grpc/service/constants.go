@@ -14,4 +14,50 @@ const (
 	userCreationTimestampOffset = 7 * 24 * 3600 // consider user deletion only after this time, when created by admin
 
 	maximumProfilesAllowed = 6
+
+	defaultSearchPageSize = 20
+	maximumSearchPageSize = 100
+	maximumImportRows     = 20000
+
+	invitationResendCooldown = 10 * 60 // Minimum delay between 2 invitations sent to the same user, in seconds
+
+	impersonationTokenLifetime = 15 * 60 // validity of access tokens obtained by impersonation, in seconds
+
+	appTokenUsageUpdateInterval = 60 // Minimum delay between 2 updates of the last use of an app token, in seconds
+
+	defaultStreamBatchSize = 32
+	maximumStreamBatchSize = 500
+)
+
+const (
+	unsubscribeTokenLifetime               = 60 * 24 * 3600 // validity of unsubscribe links, in seconds
+	subscriptionConfirmationLifetime       = 30 * 24 * 3600 // validity of double opt-in links, in seconds
+	subscriptionConfirmationResendCooldown = 10 * 60        // minimum delay between 2 confirmation emails of a pending subscription, in seconds
+	softBounceLimit                        = 3              // number of soft bounces after which an address is considered undeliverable
+
+	// log events not defined in go-utils
+	logEventUnsubscribed          = "UNSUBSCRIBED"
+	logEventSubscriptionConfirmed = "SUBSCRIPTION CONFIRMED"
+	logEventEmailUndeliverable    = "EMAIL UNDELIVERABLE"
+	logEventUserMoved             = "USER MOVED"
+	logEventAccountsMerged        = "ACCOUNTS MERGED"
+	logEventIdentityLinked        = "IDENTITY LINKED"
+	logEventIdentityUnlinked      = "IDENTITY UNLINKED"
+	logEventInvitationResent      = "INVITATION RESENT"
+	logEventInvitationRevoked     = "INVITATION REVOKED"
+	logEventRoleGrantRequested    = "ROLE GRANT REQUESTED"
+	logEventRoleGrantRejected     = "ROLE GRANT REJECTED"
+	logEventRoleScopeAdded        = "ROLE SCOPE ADDED"
+	logEventRoleScopeRemoved      = "ROLE SCOPE REMOVED"
+	logEventImpersonationStarted  = "IMPERSONATION STARTED"
+	logEventServiceAccountCreated = "SERVICE ACCOUNT CREATED"
+	logEventAPIKeyCreated         = "API KEY CREATED"
+	logEventAPIKeyRevoked         = "API KEY REVOKED"
+	logEventAPIKeyRejected        = "API KEY REJECTED"
+	logEventAppTokenCreated       = "APP TOKEN CREATED"
+	logEventAppTokenRotated       = "APP TOKEN ROTATED"
+	logEventAppTokenRevoked       = "APP TOKEN REVOKED"
+	logEventInstanceCreated       = "INSTANCE CREATED"
+	logEventInstanceUpdated       = "INSTANCE SETTINGS UPDATED"
+	logEventInstanceArchived      = "INSTANCE ARCHIVED"
 )
@@ -2,8 +2,14 @@ package service
 
 import (
 	"context"
+	"time"
 
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -12,11 +18,136 @@ func (s *userManagementServer) ValidateAppToken(ctx context.Context, req *api.Ap
 	if req == nil || req.Token == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid app token")
 	}
-	tokenInfos, err := s.globalDBService.FindAppToken(req.Token)
+	tokenInfos, err := validateAppToken(s.globalDBService, req.Token)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid app token")
 	}
 	return &api.AppTokenValidation{
 		Instances: tokenInfos.Instances,
+		AppName:   tokenInfos.AppName,
 	}, nil
 }
+
+// CreateAppToken creates a token for an app of the admin's instance. Tokens for several instances are created with the
+// app-tokens tool.
+func (s *userManagementServer) CreateAppToken(ctx context.Context, req *api.CreateAppTokenReq) (*api.AppTokenSecret, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.AppName == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_APPTOKENS_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	now := time.Now().Unix()
+	if req.ExpiresAt != 0 && req.ExpiresAt < now {
+		return nil, status.Error(codes.InvalidArgument, "expiration time in the past")
+	}
+
+	secret, hash, err := tokens.GenerateAppToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	appToken, err := s.globalDBService.AddAppToken(models.AppToken{
+		AppName:   req.AppName,
+		TokenHash: hash,
+		Instances: []string{req.Token.InstanceId},
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventAppTokenCreated, appToken.ID.Hex()+" - "+appToken.AppName)
+	return &api.AppTokenSecret{
+		AppToken: appToken.ToAPI(),
+		Secret:   secret,
+	}, nil
+}
+
+func (s *userManagementServer) GetAppTokens(ctx context.Context, req *api.GetAppTokensReq) (*api.AppTokenList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_APPTOKENS_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	appTokens, err := s.globalDBService.GetAppTokens(req.Token.InstanceId, req.IncludeRevoked)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.AppTokenList{
+		AppTokens: make([]*api.AppToken, len(appTokens)),
+	}
+	for i, t := range appTokens {
+		resp.AppTokens[i] = t.ToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) RotateAppToken(ctx context.Context, req *api.AppTokenMsg) (*api.AppTokenSecret, error) {
+	if _, err := s.getManagedAppToken(req); err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != 0 && req.ExpiresAt < time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "expiration time in the past")
+	}
+
+	secret, hash, err := tokens.GenerateAppToken()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	appToken, err := s.globalDBService.RotateAppToken(req.Id, hash, req.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "app token revoked")
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventAppTokenRotated, appToken.ID.Hex()+" - "+appToken.AppName)
+	return &api.AppTokenSecret{
+		AppToken: appToken.ToAPI(),
+		Secret:   secret,
+	}, nil
+}
+
+func (s *userManagementServer) RevokeAppToken(ctx context.Context, req *api.AppTokenMsg) (*api.AppToken, error) {
+	if _, err := s.getManagedAppToken(req); err != nil {
+		return nil, err
+	}
+
+	appToken, err := s.globalDBService.RevokeAppToken(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "app token already revoked")
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventAppTokenRevoked, appToken.ID.Hex()+" - "+appToken.AppName)
+	return appToken.ToAPI(), nil
+}
+
+// getManagedAppToken checks that the admin can change the app token: only tokens of the admin's instance alone, tokens
+// shared with other instances are managed with the app-tokens tool
+func (s *userManagementServer) getManagedAppToken(req *api.AppTokenMsg) (models.AppToken, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Id == "" {
+		return models.AppToken{}, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_APPTOKENS_MANAGE) {
+		return models.AppToken{}, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	appToken, err := s.globalDBService.GetAppTokenByID(req.Id)
+	if err != nil {
+		return appToken, status.Error(codes.NotFound, "app token not found")
+	}
+	ofInstance := false
+	for _, instanceID := range appToken.Instances {
+		if instanceID == req.Token.InstanceId {
+			ofInstance = true
+		}
+	}
+	if !ofInstance {
+		return appToken, status.Error(codes.NotFound, "app token not found")
+	}
+	if len(appToken.Instances) > 1 {
+		return appToken, status.Error(codes.PermissionDenied, "app token shared with other instances")
+	}
+	return appToken, nil
+}
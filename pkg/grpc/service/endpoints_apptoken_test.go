@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
 	"google.golang.org/grpc/status"
 )
 
@@ -25,7 +28,7 @@ func TestValidateAppTokenEndpoint(t *testing.T) {
 		Instances: []string{testInstanceID},
 		Tokens:    []string{"test1", "test2"},
 	}
-	err := testGlobalDBService.AddAppToken(appToken)
+	_, err := testGlobalDBService.AddAppToken(appToken)
 	if err != nil {
 		t.Errorf("unexpected error when creating app token: %s", err.Error())
 		return
@@ -74,8 +77,138 @@ func TestValidateAppTokenEndpoint(t *testing.T) {
 			t.Errorf("unexpected error: %s", err.Error())
 			return
 		}
-		if len(resp.Instances) < 1 || resp.Instances[0] != testInstanceID {
+		if len(resp.Instances) < 1 || resp.Instances[0] != testInstanceID || resp.AppName != "testapp" {
 			t.Error("wrong response")
 		}
 	})
 }
+
+func TestAppTokenManagementEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	shared, err := testGlobalDBService.AddAppToken(models.AppToken{
+		AppName:   "shared-app",
+		Instances: []string{testInstanceID, "other-instance"},
+		Tokens:    []string{"shared-token"},
+	})
+	if err != nil {
+		t.Errorf("unexpected error when creating app token: %s", err.Error())
+		return
+	}
+
+	var created *api.AppTokenSecret
+	t.Run("create app token", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		created, err = s.CreateAppToken(context.Background(), &api.CreateAppTokenReq{Token: adminToken, AppName: "managed-app"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if created.Secret == "" || len(created.AppToken.Instances) != 1 || created.AppToken.Instances[0] != testInstanceID {
+			t.Errorf("unexpected response: %v", created)
+			return
+		}
+		resp, err := s.ValidateAppToken(context.Background(), &api.AppTokenRequest{Token: created.Secret})
+		if err != nil || resp.AppName != "managed-app" {
+			t.Errorf("unexpected validation: %v, %v", resp, err)
+		}
+	})
+
+	t.Run("list app tokens", func(t *testing.T) {
+		resp, err := s.GetAppTokens(context.Background(), &api.GetAppTokensReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		found := false
+		for _, a := range resp.AppTokens {
+			if a.Id == created.AppToken.Id && a.LastUsedAt > 0 {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("unexpected app tokens: %v", resp.AppTokens)
+		}
+	})
+
+	t.Run("rotate shared app token", func(t *testing.T) {
+		_, err := s.RotateAppToken(context.Background(), &api.AppTokenMsg{Token: adminToken, Id: shared.ID.Hex()})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "app token shared with other instances")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("rotate app token", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		rotated, err := s.RotateAppToken(context.Background(), &api.AppTokenMsg{Token: adminToken, Id: created.AppToken.Id})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if rotated.Secret == created.Secret || rotated.AppToken.RotatedAt == 0 {
+			t.Errorf("unexpected response: %v", rotated)
+		}
+		if _, err := s.ValidateAppToken(context.Background(), &api.AppTokenRequest{Token: created.Secret}); err == nil {
+			t.Error("previous token should be invalid")
+		}
+		created = rotated
+	})
+
+	t.Run("revoke app token", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		revoked, err := s.RevokeAppToken(context.Background(), &api.AppTokenMsg{Token: adminToken, Id: created.AppToken.Id})
+		if err != nil || revoked.RevokedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", revoked, err)
+			return
+		}
+		_, err = s.ValidateAppToken(context.Background(), &api.AppTokenRequest{Token: created.Secret})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("expired app token", func(t *testing.T) {
+		_, err := testGlobalDBService.AddAppToken(models.AppToken{
+			AppName:   "expired-app",
+			Instances: []string{testInstanceID},
+			Tokens:    []string{"expired-token"},
+			ExpiresAt: time.Now().Unix() - 10,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		_, err = s.ValidateAppToken(context.Background(), &api.AppTokenRequest{Token: "expired-token"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid app token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
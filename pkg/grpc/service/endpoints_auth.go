@@ -38,7 +38,10 @@ func (s *userManagementServer) SendVerificationCode(ctx context.Context, req *ap
 	}
 
 	if req.InstanceId == "" {
-		req.InstanceId = "default"
+		req.InstanceId = defaultInstanceID
+	}
+	if err := s.checkInstanceActive(req.InstanceId); err != nil {
+		return nil, err
 	}
 
 	req.Email = utils.SanitizeEmail(req.Email)
@@ -137,7 +140,7 @@ func (s *userManagementServer) AutoValidateTempToken(ctx context.Context, req *a
 
 	user.Account.VerificationCode = models.VerificationCode{
 		Code:      vc,
-		ExpiresAt: time.Now().Unix() + s.Intervals.VerificationCodeLifetime,
+		ExpiresAt: time.Now().Unix() + s.instanceConfig(tokenInfos.InstanceID).Intervals.VerificationCodeLifetime,
 	}
 	user, err = s.userDBservice.UpdateUser(tokenInfos.InstanceID, user)
 	if err != nil {
@@ -145,6 +148,11 @@ func (s *userManagementServer) AutoValidateTempToken(ctx context.Context, req *a
 		return nil, status.Error(codes.Internal, "user couldn't be updated")
 	}
 
+	if tokenInfos.Purpose == constants.TOKEN_PURPOSE_INVITATION {
+		if err := s.userDBservice.SaveInvitationAccepted(tokenInfos.InstanceID, user.ID.Hex()); err != nil {
+			log.Printf("AutoValidateTempToken: %s", err.Error())
+		}
+	}
 	if err := s.globalDBService.DeleteAllTempTokenForUser(tokenInfos.InstanceID, user.ID.Hex(), constants.TOKEN_PURPOSE_INVITATION); err != nil {
 		log.Printf("AutoValidateTempToken: %s", err.Error())
 	}
@@ -161,7 +169,10 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 	}
 
 	if req.InstanceId == "" {
-		req.InstanceId = "default"
+		req.InstanceId = defaultInstanceID
+	}
+	if err := s.checkInstanceActive(req.InstanceId); err != nil {
+		return nil, err
 	}
 
 	req.Email = utils.SanitizeEmail(req.Email)
@@ -271,13 +282,16 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 	mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(user)
 
 	// Access Token
+	tokenLifetime := s.instanceConfig(req.InstanceId).Intervals.TokenExpiryInterval
 	token, err := tokens.GenerateNewToken(
 		apiUser.Id,
 		apiUser.Account.AccountConfirmedAt > 0,
 		mainProfileID,
 		currentRoles,
+		s.tokenPermissions(req.InstanceId, currentRoles),
+		user.ScopesForRoles(currentRoles),
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		tokenLifetime,
 		username,
 		nil,
 		otherProfileIDs,
@@ -316,12 +330,13 @@ func (s *userManagementServer) LoginWithEmail(ctx context.Context, req *api.Logi
 		Token: &api.TokenResponse{
 			AccessToken:       token,
 			RefreshToken:      rt,
-			ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+			ExpiresIn:         int32(tokenLifetime / time.Minute),
 			Profiles:          apiUser.Profiles,
 			SelectedProfileId: mainProfileID,
 			PreferredLanguage: apiUser.Account.PreferredLanguage,
 		},
-		User: user.ToAPI(),
+		User:                    user.ToAPI(),
+		UndeliverableContactIds: user.UndeliverableContactInfoIDs(),
 	}
 	return response, nil
 
@@ -332,9 +347,12 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		log.Printf("[ERROR] LoginWithExternalIDP: invalid request - %v", req)
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if err := s.checkInstanceActive(req.InstanceId); err != nil {
+		return nil, err
+	}
 
 	req.Email = utils.SanitizeEmail(req.Email)
-	user, err := s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
+	user, err := s.findUserForExternalLogin(req)
 	if err != nil {
 		// user does not exists - create user
 		randomPW, err := tokens.GenerateUniqueTokenString()
@@ -384,7 +402,7 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		user.ID, _ = primitive.ObjectIDFromHex(id)
 
 	} else {
-		if user.Account.Type != models.ACCOUNT_TYPE_EXTERNAL {
+		if user.Account.Type != models.ACCOUNT_TYPE_EXTERNAL && !user.Account.HasLinkedIdentity(req.Idp, req.Subject) {
 			log.Printf("[ERROR] LoginWithExternalIDP: wrong account type '%s' for %v", user.Account.Type, req)
 			s.SaveLogEvent(req.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_ERROR, constants.LOG_EVENT_AUTH_WRONG_ACCOUNT_ID, "wrong account type for external login: "+user.Account.Type)
 			return nil, status.Error(codes.PermissionDenied, "wrong account type")
@@ -403,13 +421,16 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 	mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(user)
 
 	// Access Token
+	tokenLifetime := s.instanceConfig(req.InstanceId).Intervals.TokenExpiryInterval
 	token, err := tokens.GenerateNewToken(
 		apiUser.Id,
 		apiUser.Account.AccountConfirmedAt > 0,
 		mainProfileID,
 		currentRoles,
+		s.tokenPermissions(req.InstanceId, currentRoles),
+		user.ScopesForRoles(currentRoles),
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		tokenLifetime,
 		username,
 		nil,
 		otherProfileIDs,
@@ -430,6 +451,7 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 	user.Account.VerificationCode = models.VerificationCode{}
 	user.Account.FailedLoginAttempts = utils.RemoveAttemptsOlderThan(user.Account.FailedLoginAttempts, 3600)
 	user.Account.PasswordResetTriggers = utils.RemoveAttemptsOlderThan(user.Account.PasswordResetTriggers, 7200)
+	user.Account.SetLinkedIdentityUsed(req.Idp, req.Subject)
 
 	user, err = s.userDBservice.UpdateUser(req.InstanceId, user)
 	if err != nil {
@@ -449,12 +471,13 @@ func (s *userManagementServer) LoginWithExternalIDP(ctx context.Context, req *ap
 		Token: &api.TokenResponse{
 			AccessToken:       token,
 			RefreshToken:      rt,
-			ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+			ExpiresIn:         int32(tokenLifetime / time.Minute),
 			Profiles:          apiUser.Profiles,
 			SelectedProfileId: mainProfileID,
 			PreferredLanguage: apiUser.Account.PreferredLanguage,
 		},
-		User: user.ToAPI(),
+		User:                    user.ToAPI(),
+		UndeliverableContactIds: user.UndeliverableContactInfoIDs(),
 	}
 	return response, nil
 }
@@ -471,19 +494,31 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	if !utils.CheckLanguageCode(req.PreferredLanguage) {
 		return nil, status.Error(codes.InvalidArgument, "language code wrong")
 	}
-	if !utils.CheckPasswordFormat(req.Password) {
-		return nil, status.Error(codes.InvalidArgument, "password too weak")
+	if req.InstanceId == "" {
+		req.InstanceId = defaultInstanceID
+	}
+	if err := s.checkInstanceActive(req.InstanceId); err != nil {
+		return nil, err
 	}
 
-	if req.InstanceId == "" {
-		req.InstanceId = "default"
+	conf := s.instanceConfig(req.InstanceId)
+	settings := conf.Settings
+	if settings.SignupClosed {
+		return nil, status.Error(codes.FailedPrecondition, "signup closed")
+	}
+	if !settings.AllowsLanguage(req.PreferredLanguage) {
+		return nil, status.Error(codes.InvalidArgument, "language not supported")
+	}
+	minLength, minCharClasses := settings.PasswordPolicy.Requirements()
+	if !utils.CheckPasswordRequirements(req.Password, minLength, minCharClasses) {
+		return nil, status.Error(codes.InvalidArgument, "password too weak")
 	}
 
 	newUserCount, err := s.userDBservice.CountRecentlyCreatedUsers(req.InstanceId, signupRateLimitWindow)
 	if err != nil {
 		log.Printf("ERROR: signup - unexpected error when counting: %v", err)
 	} else {
-		if newUserCount > s.newUserCountLimit {
+		if newUserCount > conf.NewUserCountLimit {
 			log.Println("ERROR: user creation blocked due to too many registations")
 			return nil, status.Error(codes.Internal, "user creation failed, please try in some minutes again")
 		}
@@ -525,11 +560,15 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	}
 
 	if req.WantsNewsletter {
-		newUser.ContactPreferences.SubscribedToNewsletter = true
-		newUser.ContactPreferences.SendNewsletterTo = []string{newUser.ContactInfos[0].ID.Hex()}
+		sendTo := []string{newUser.ContactInfos[0].ID.Hex()}
+		if s.requiresDoubleOptIn(req.InstanceId, models.SUBSCRIPTION_TOPIC_NEWSLETTER) {
+			newUser.ContactPreferences.RequestSubscription(models.SUBSCRIPTION_TOPIC_NEWSLETTER, sendTo, subscriptionConfirmationResendCooldown)
+		} else {
+			newUser.ContactPreferences.SetSubscription(models.SUBSCRIPTION_TOPIC_NEWSLETTER, true, sendTo)
+		}
 	}
 	// on which weekday the user will receive the reminder emails
-	newUser.ContactPreferences.SubscribedToWeekly = true
+	newUser.ContactPreferences.SetSubscription(models.SUBSCRIPTION_TOPIC_WEEKLY, true, nil)
 	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(rand.Intn(7))
 
 	id, err := s.userDBservice.AddUser(req.InstanceId, newUser)
@@ -573,6 +612,12 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	}(req.InstanceId, newUser.Account.AccountID, tempToken, newUser.Account.PreferredLanguage)
 	// <---
 
+	if newUser.ContactPreferences.IsSubscriptionPending(models.SUBSCRIPTION_TOPIC_NEWSLETTER) {
+		if err := s.sendSubscriptionConfirmation(req.InstanceId, newUser, models.SUBSCRIPTION_TOPIC_NEWSLETTER); err != nil {
+			log.Printf("SignupWithEmail: %s", err.Error())
+		}
+	}
+
 	var username string
 	if len(newUser.Roles) > 1 || len(newUser.Roles) == 1 && newUser.Roles[0] != "PARTICIPANT" {
 		username = newUser.Account.AccountID
@@ -580,13 +625,16 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	apiUser := newUser.ToAPI()
 
 	// Access Token
+	tokenLifetime := s.instanceConfig(req.InstanceId).Intervals.TokenExpiryInterval
 	token, err := tokens.GenerateNewToken(
 		apiUser.Id,
 		apiUser.Account.AccountConfirmedAt > 0,
 		apiUser.Profiles[0].Id,
 		newUser.Roles,
+		s.tokenPermissions(req.InstanceId, newUser.Roles),
+		nil,
 		req.InstanceId,
-		s.Intervals.TokenExpiryInterval,
+		tokenLifetime,
 		username,
 		nil,
 		[]string{},
@@ -616,7 +664,7 @@ func (s *userManagementServer) SignupWithEmail(ctx context.Context, req *api.Sig
 	response := &api.TokenResponse{
 		AccessToken:       token,
 		RefreshToken:      rt,
-		ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+		ExpiresIn:         int32(tokenLifetime / time.Minute),
 		Profiles:          apiUser.Profiles,
 		SelectedProfileId: apiUser.Profiles[0].Id,
 		PreferredLanguage: apiUser.Account.PreferredLanguage,
@@ -659,6 +707,11 @@ func (s *userManagementServer) VerifyContact(ctx context.Context, req *api.TempT
 		user.Account.AccountConfirmedAt = time.Now().Unix()
 	}
 	user, err = s.userDBservice.UpdateUser(tokenInfos.InstanceID, user)
+	if err == nil && tokenInfos.Purpose == constants.TOKEN_PURPOSE_INVITATION {
+		if err := s.userDBservice.SaveInvitationAccepted(tokenInfos.InstanceID, tokenInfos.UserID); err != nil {
+			log.Printf("VerifyContact: %s", err.Error())
+		}
+	}
 
 	s.SaveLogEvent(tokenInfos.InstanceID, tokenInfos.UserID, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_CONTACT_VERIFIED, email)
 	return user.ToAPI(), err
@@ -675,6 +728,26 @@ func (s *userManagementServer) ResendContactVerification(ctx context.Context, re
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if req.Type == models.CONTACT_TYPE_PHONE {
+		ci, found := user.FindContactInfoByTypeAndAddr(models.CONTACT_TYPE_PHONE, utils.SanitizePhoneNumber(req.Address))
+		if !found {
+			return nil, status.Error(codes.InvalidArgument, "address not found")
+		}
+		msg, err := s.generatePhoneVerificationCode(req.Token.InstanceId, &user, ci)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		go s.sendSMS(msg)
+		return &api.ServiceStatus{
+			Status:  api.ServiceStatus_NORMAL,
+			Msg:     "message sent",
+			Version: apiVersion,
+		}, nil
+	}
+
 	ci, found := user.FindContactInfoByTypeAndAddr("email", req.Address)
 	if !found {
 		return nil, status.Error(codes.InvalidArgument, "address not found")
@@ -259,7 +259,7 @@ func TestAutoValidateTempToken(t *testing.T) {
 
 	t.Run("correct temptoken with access token same user", func(t *testing.T) {
 		accessToken, err := tokens.GenerateNewToken(
-			testUser.ID.Hex(), true, "profid", []string{}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
+			testUser.ID.Hex(), true, "profid", []string{}, nil, nil, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
 		)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
@@ -286,7 +286,7 @@ func TestAutoValidateTempToken(t *testing.T) {
 
 	t.Run("correct temptoken with access token different user", func(t *testing.T) {
 		accessToken, err := tokens.GenerateNewToken(
-			"different", true, "profid", []string{}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
+			"different", true, "profid", []string{}, nil, nil, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{},
 		)
 		if err != nil {
 			t.Errorf("unexpected error: %v", err)
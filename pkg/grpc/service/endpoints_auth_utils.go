@@ -8,6 +8,7 @@ import (
 	constants "github.com/influenzanet/go-utils/pkg/constants"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/sms"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,7 +25,7 @@ func (s *userManagementServer) generateAndSendVerificationCode(instanceID string
 		Code:      vc,
 		Attempts:  0,
 		CreatedAt: time.Now().Unix(),
-		ExpiresAt: time.Now().Unix() + s.Intervals.VerificationCodeLifetime,
+		ExpiresAt: time.Now().Unix() + s.instanceConfig(instanceID).Intervals.VerificationCodeLifetime,
 	}
 	user, err = s.userDBservice.UpdateUser(instanceID, user)
 	if err != nil {
@@ -33,10 +34,34 @@ func (s *userManagementServer) generateAndSendVerificationCode(instanceID string
 	}
 
 	// ---> Trigger message sending
+	if phone, ok := getVerificationCodePhone(user); ok && s.clients.SMSSender != nil {
+		go s.sendSMS(sms.Message{
+			InstanceID:  instanceID,
+			To:          phone,
+			MessageType: sms.SMS_TYPE_AUTH_VERIFICATION,
+			ContentInfos: map[string]string{
+				"verificationCode": vc,
+			},
+			PreferredLanguage: user.Account.PreferredLanguage,
+		})
+		return nil
+	}
 	go s.sendVerificationEmail(instanceID, user.Account.AccountID, vc, user.Account.PreferredLanguage)
 	return nil
 }
 
+// getVerificationCodePhone returns the confirmed phone number the user selected to receive verification codes
+func getVerificationCodePhone(user models.User) (string, bool) {
+	if user.ContactPreferences.SendVerificationCodeTo == "" {
+		return "", false
+	}
+	ci, found := user.FindContactInfoById(user.ContactPreferences.SendVerificationCodeTo)
+	if !found || ci.Type != models.CONTACT_TYPE_PHONE || ci.ConfirmedAt <= 0 {
+		return "", false
+	}
+	return ci.Phone, true
+}
+
 func (s *userManagementServer) sendVerificationEmail(instanceID string, accountID string, code string, preferredLang string) {
 	if s.clients.MessagingService == nil {
 		return
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReportEmailDeliveryEvent is called by the messaging service (or a webhook adapter of the email provider) for bounces
+// and complaints, with the token of a service account allowed to report them. Unknown addresses are not revealed.
+func (s *userManagementServer) ReportEmailDeliveryEvent(ctx context.Context, req *api.DeliveryEventMsg) (*api.ServiceStatus, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Email == "" || req.Event == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_DELIVERY_REPORT) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	instanceID := req.Token.InstanceId
+	switch req.Event {
+	case models.DELIVERY_EVENT_HARD_BOUNCE, models.DELIVERY_EVENT_SOFT_BOUNCE, models.DELIVERY_EVENT_COMPLAINT:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown delivery event")
+	}
+
+	email := utils.SanitizeEmail(req.Email)
+	users, err := s.userDBservice.FindUsersByContactEmail(instanceID, email)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, user := range users {
+		becameUndeliverable, err := user.ReportDeliveryEvent(email, req.Event, req.Details, softBounceLimit)
+		if err != nil {
+			log.Printf("ReportEmailDeliveryEvent: %s", err.Error())
+			continue
+		}
+		if _, err := s.userDBservice.UpdateUser(instanceID, user); err != nil {
+			log.Printf("ReportEmailDeliveryEvent: unexpected error when saving user -> %v", err)
+			return nil, status.Error(codes.Internal, "user couldn't be updated")
+		}
+		if becameUndeliverable {
+			s.SaveLogEvent(instanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventEmailUndeliverable, email+" ("+req.Event+")")
+		}
+	}
+
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "delivery event reported",
+	}, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestReportEmailDeliveryEventEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_bounces@test.com",
+			},
+			ContactInfos: []models.ContactInfo{
+				{
+					ID:    primitive.NewObjectID(),
+					Type:  "email",
+					Email: "test_for_bounces@test.com",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	serviceToken := &api_types.TokenInfos{
+		Id:         "testservice",
+		InstanceId: testInstanceID,
+		Payload:    map[string]string{"roles": "SERVICE", "permissions": authz.PERMISSION_DELIVERY_REPORT},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.ReportEmailDeliveryEvent(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		req := &api.DeliveryEventMsg{
+			Token: &api_types.TokenInfos{
+				Id:         testUsers[0].ID.Hex(),
+				InstanceId: testInstanceID,
+				Payload:    map[string]string{"roles": "PARTICIPANT", "permissions": ""},
+			},
+			Email: "test_for_bounces@test.com",
+			Event: models.DELIVERY_EVENT_HARD_BOUNCE,
+		}
+		_, err := s.ReportEmailDeliveryEvent(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown event", func(t *testing.T) {
+		req := &api.DeliveryEventMsg{
+			Token: serviceToken,
+			Email: "test_for_bounces@test.com",
+			Event: "opened",
+		}
+		_, err := s.ReportEmailDeliveryEvent(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown delivery event")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown address", func(t *testing.T) {
+		req := &api.DeliveryEventMsg{
+			Token: serviceToken,
+			Email: "not_existing_for_bounces@test.com",
+			Event: models.DELIVERY_EVENT_HARD_BOUNCE,
+		}
+		resp, err := s.ReportEmailDeliveryEvent(context.Background(), req)
+		if err != nil {
+			t.Errorf("unknown addresses should not be revealed: %s", err.Error())
+			return
+		}
+		if resp.Msg != "delivery event reported" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+	})
+
+	t.Run("soft bounce below limit", func(t *testing.T) {
+		req := &api.DeliveryEventMsg{
+			Token: serviceToken,
+			Email: "Test_for_bounces@test.com",
+			Event: models.DELIVERY_EVENT_SOFT_BOUNCE,
+		}
+		_, err := s.ReportEmailDeliveryEvent(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if user.ContactInfos[0].DeliveryStatus == nil || user.ContactInfos[0].IsUndeliverable() {
+			t.Errorf("unexpected delivery status: %v", user.ContactInfos[0].DeliveryStatus)
+		}
+	})
+
+	t.Run("hard bounce", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		req := &api.DeliveryEventMsg{
+			Token:   serviceToken,
+			Email:   "test_for_bounces@test.com",
+			Event:   models.DELIVERY_EVENT_HARD_BOUNCE,
+			Details: "550 mailbox unavailable",
+		}
+		_, err := s.ReportEmailDeliveryEvent(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !user.ContactInfos[0].IsUndeliverable() {
+			t.Errorf("address should be undeliverable: %v", user.ContactInfos[0].DeliveryStatus)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ImpersonateUser gives support staff a short-lived access token of another user, e.g. to reproduce what a
+// participant sees. The token has no refresh token, names the impersonator and is refused for sensitive actions.
+func (s *userManagementServer) ImpersonateUser(ctx context.Context, req *api.ImpersonateUserReq) (*api.TokenResponse, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" || req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument(s)")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_IMPERSONATE) || isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.Token.Id == req.UserId {
+		return nil, status.Error(codes.InvalidArgument, "cannot impersonate yourself")
+	}
+
+	instanceID := req.Token.InstanceId
+	user, err := s.userDBservice.GetUserByID(instanceID, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(user)
+
+	lifetime := time.Duration(impersonationTokenLifetime) * time.Second
+	token, err := tokens.GenerateImpersonationToken(
+		req.Token.Id,
+		user.ID.Hex(),
+		user.Account.AccountConfirmedAt > 0,
+		mainProfileID,
+		instanceID,
+		lifetime,
+		otherProfileIDs,
+	)
+	if err != nil {
+		log.Printf("ImpersonateUser: unexpected error during token generation -> %v", err)
+		return nil, status.Error(codes.Internal, "token generation error")
+	}
+
+	// logged for both, so that the impersonation shows up in the participant's history too
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventImpersonationStarted, "as "+user.Account.AccountID+"("+user.ID.Hex()+"): "+req.Reason)
+	s.SaveLogEvent(instanceID, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, logEventImpersonationStarted, "by "+req.Token.Id+": "+req.Reason)
+
+	apiUser := user.ToAPI()
+	return &api.TokenResponse{
+		AccessToken:       token,
+		ExpiresIn:         int32(lifetime / time.Minute),
+		Profiles:          apiUser.Profiles,
+		SelectedProfileId: mainProfileID,
+		PreferredLanguage: apiUser.Account.PreferredLanguage,
+	}, nil
+}
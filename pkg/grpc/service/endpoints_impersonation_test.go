@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestImpersonateUserEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{Type: "email", AccountID: "test_impersonation_1@test.com", AccountConfirmedAt: time.Now().Unix()},
+			Roles:   []string{"PARTICIPANT", "RESEARCHER"},
+			Profiles: []models.Profile{
+				{Alias: "main", MainProfile: true},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	userID := testUsers[0].ID.Hex()
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("without reason", func(t *testing.T) {
+		_, err := s.ImpersonateUser(context.Background(), &api.ImpersonateUserReq{Token: adminToken, UserId: userID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument(s)")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without permission", func(t *testing.T) {
+		_, err := s.ImpersonateUser(context.Background(), &api.ImpersonateUserReq{
+			Token: &api_types.TokenInfos{
+				Id:         "testsupport",
+				InstanceId: testInstanceID,
+				Payload:    map[string]string{"roles": "PARTICIPANT", "permissions": authz.PERMISSION_USERS_READ},
+			},
+			UserId: userID,
+			Reason: "ticket 42",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	var impersonationToken *api_types.TokenInfos
+	t.Run("impersonate user", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		resp, err := s.ImpersonateUser(context.Background(), &api.ImpersonateUserReq{Token: adminToken, UserId: userID, Reason: "ticket 42"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.RefreshToken != "" || resp.AccessToken == "" {
+			t.Errorf("unexpected response: %v", resp)
+			return
+		}
+
+		impersonationToken, err = s.ValidateJWT(context.Background(), &api.JWTRequest{Token: resp.AccessToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		roles := tokens.GetRolesFromPayload(impersonationToken.Payload)
+		if impersonationToken.Id != userID || impersonationToken.Payload["impersonator"] != "testadmin" || len(roles) != 1 || roles[0] != "PARTICIPANT" {
+			t.Errorf("unexpected token infos: %v", impersonationToken)
+		}
+	})
+
+	t.Run("sensitive actions are blocked", func(t *testing.T) {
+		if impersonationToken == nil {
+			t.Skip("no impersonation token")
+		}
+		_, err := s.ChangePassword(context.Background(), &api.PasswordChangeMsg{Token: impersonationToken, OldPassword: "x", NewPassword: "SuperSecret123!"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.DeleteAccount(context.Background(), &api.UserReference{Token: impersonationToken, UserId: userID})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.AddEmail(context.Background(), &api.ContactInfoMsg{Token: impersonationToken, ContactInfo: &api.ContactInfo{Type: "email", Address: &api.ContactInfo_Email{Email: "other@test.com"}}})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.RemoveEmail(context.Background(), &api.ContactInfoMsg{Token: impersonationToken, ContactInfo: &api.ContactInfo{Id: "id"}})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.AddPhone(context.Background(), &api.ContactInfoMsg{Token: impersonationToken, ContactInfo: &api.ContactInfo{Type: "phone", Address: &api.ContactInfo_Phone{Phone: "+4915112345678"}}})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.RemovePhone(context.Background(), &api.ContactInfoMsg{Token: impersonationToken, ContactInfo: &api.ContactInfo{Id: "id"}})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.VerifyPhone(context.Background(), &api.PhoneVerificationMsg{Token: impersonationToken, ContactInfoId: "id", VerificationCode: "123456"})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.LinkExternalIdentity(context.Background(), &api.LinkedIdentityMsg{Token: impersonationToken, Password: "x", Idp: "idp", Subject: "sub"})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.UnlinkExternalIdentity(context.Background(), &api.LinkedIdentityMsg{Token: impersonationToken, Password: "x", Idp: "idp", Subject: "sub"})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "not allowed while impersonating")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.ImpersonateUser(context.Background(), &api.ImpersonateUserReq{Token: impersonationToken, UserId: "other", Reason: "chain"})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
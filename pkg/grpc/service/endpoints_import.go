@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// status of a row in the import report
+const (
+	importStatusNew     = "new"
+	importStatusCreated = "created"
+	importStatusExists  = "exists"
+	importStatusInvalid = "invalid"
+	importStatusFailed  = "failed"
+)
+
+func (s *userManagementServer) ImportUsers(stream api.UserManagementApi_ImportUsersServer) error {
+	var options *api.ImportUsersMsg
+	rows := []*api.ImportUserRow{}
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if options == nil {
+			if utils.IsTokenEmpty(msg.Token) {
+				return status.Error(codes.InvalidArgument, "missing arguments")
+			}
+			if !s.authorize(msg.Token, authz.PERMISSION_USERS_CREATE) {
+				return status.Error(codes.PermissionDenied, "permission denied")
+			}
+			options = msg
+		}
+		rows = append(rows, msg.Rows...)
+		if len(rows) > maximumImportRows {
+			return status.Error(codes.InvalidArgument, "too many rows")
+		}
+	}
+	if options == nil {
+		return status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	instanceID := options.Token.InstanceId
+	invitationLifetime := s.instanceConfig(instanceID).Intervals.InvitationLifetime
+	if options.InvitationInterval < 0 {
+		return status.Error(codes.InvalidArgument, "invitation interval not valid")
+	}
+	// the last invitation has to be sent before the first imported users can be cleaned up
+	if options.SendInvitations && time.Duration(options.InvitationInterval)*time.Second*time.Duration(len(rows)) > invitationLifetime {
+		return status.Error(codes.InvalidArgument, "invitation interval too long for the number of rows")
+	}
+
+	report, err := s.validateImportRows(options.Token, rows)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	report.DryRun = options.DryRun
+	if options.DryRun {
+		return stream.SendAndClose(report)
+	}
+	if report.Invalid > 0 {
+		return status.Error(codes.InvalidArgument, "import contains invalid rows")
+	}
+
+	invitations := []invitee{}
+	for i, row := range rows {
+		result := report.Results[i]
+		if result.Status != importStatusNew {
+			continue
+		}
+		newUser, err := s.createImportedUser(instanceID, row, invitationLifetime)
+		if err != nil {
+			if err == userdb.ErrUserExists {
+				// created in the meantime
+				result.Status = importStatusExists
+				report.Existing += 1
+				continue
+			}
+			log.Printf("ImportUsers: row %d: %v", row.Row, err)
+			result.Status = importStatusFailed
+			result.Error = err.Error()
+			continue
+		}
+		result.Status = importStatusCreated
+		result.UserId = newUser.ID.Hex()
+		report.Created += 1
+		s.SaveLogEvent(instanceID, options.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "by admin import - "+newUser.ID.Hex()+" - "+newUser.Account.AccountID)
+
+		if options.SendInvitations {
+			invitations = append(invitations, inviteeOf(newUser))
+		}
+	}
+
+	if len(invitations) > 0 {
+		report.InvitationsScheduled = int32(len(invitations))
+		go s.sendInvitations(instanceID, invitations, time.Duration(options.InvitationInterval)*time.Second)
+	}
+	return stream.SendAndClose(report)
+}
+
+// validateImportRows checks all rows (also against existing accounts) without changing anything, rows are sanitized in place
+func (s *userManagementServer) validateImportRows(t *api_types.TokenInfos, rows []*api.ImportUserRow) (*api.ImportUsersReport, error) {
+	instanceID := t.InstanceId
+	defs, err := s.globalDBService.GetRoleDefinitions(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	report := &api.ImportUsersReport{
+		Total:   int32(len(rows)),
+		Results: make([]*api.ImportUsersReport_RowResult, len(rows)),
+	}
+	seen := map[string]bool{}
+	for i, row := range rows {
+		row.Email = utils.SanitizeEmail(row.Email)
+		result := &api.ImportUsersReport_RowResult{
+			Row:   row.Row,
+			Email: row.Email,
+		}
+		report.Results[i] = result
+
+		if err := checkImportRow(row); err != "" {
+			result.Status = importStatusInvalid
+			result.Error = err
+			report.Invalid += 1
+			continue
+		}
+		if err := s.checkRolesOfNewUser(t, row.Roles, defs); err != nil {
+			result.Status = importStatusInvalid
+			result.Error = err.Error()
+			report.Invalid += 1
+			continue
+		}
+		if seen[row.Email] {
+			result.Status = importStatusInvalid
+			result.Error = "duplicate email"
+			report.Invalid += 1
+			continue
+		}
+		seen[row.Email] = true
+
+		if existing, err := s.userDBservice.GetUserByAccountID(instanceID, row.Email); err == nil {
+			result.Status = importStatusExists
+			result.UserId = existing.ID.Hex()
+			report.Existing += 1
+			continue
+		}
+		result.Status = importStatusNew
+	}
+	return report, nil
+}
+
+// checkImportRow returns the reason why the row can't be imported, empty if valid
+func checkImportRow(row *api.ImportUserRow) string {
+	if !utils.CheckEmailFormat(row.Email) {
+		return "email not valid"
+	}
+	if row.PreferredLanguage != "" && !utils.CheckLanguageCode(row.PreferredLanguage) {
+		return "language code not valid"
+	}
+	for _, r := range row.Roles {
+		if strings.TrimSpace(r) == "" {
+			return "empty role"
+		}
+	}
+	if len(row.ProfileNames) > maximumProfilesAllowed {
+		return "too many profiles"
+	}
+	for _, pn := range row.ProfileNames {
+		if strings.TrimSpace(pn) == "" {
+			return "empty profile name"
+		}
+	}
+	if row.CreatedAt < 0 || row.CreatedAt > time.Now().Unix() {
+		return "creation time not valid"
+	}
+	return ""
+}
+
+// createImportedUser adds the user with an invitation that is not sent yet: it keeps the unconfirmed account from being
+// cleaned up until the invitation expires, also if the invitations are sent later on with ResendInvitation
+func (s *userManagementServer) createImportedUser(instanceID string, row *api.ImportUserRow, invitationLifetime time.Duration) (models.User, error) {
+	// users set their password with the invitation, this one is never revealed
+	randomPassword, err := tokens.GenerateUniqueTokenString()
+	if err != nil {
+		return models.User{}, err
+	}
+	password, err := pwhash.HashPassword(randomPassword)
+	if err != nil {
+		return models.User{}, err
+	}
+
+	createdAt := time.Now().Unix() + userCreationTimestampOffset
+	if row.CreatedAt > 0 {
+		createdAt = row.CreatedAt
+	}
+	roles := row.Roles
+	if len(roles) == 0 {
+		roles = []string{constants.USER_ROLE_PARTICIPANT}
+	}
+
+	newUser := newUserCreatedByAdmin(row.Email, password, row.PreferredLanguage, roles, row.ProfileNames, createdAt, 0)
+	newUser.Invitation = &models.Invitation{
+		ExpiresAt: tokens.GetExpirationTime(invitationLifetime),
+	}
+	id, err := s.userDBservice.AddUser(instanceID, newUser)
+	if err != nil {
+		return newUser, err
+	}
+	newUser.ID, err = primitive.ObjectIDFromHex(id)
+	return newUser, err
+}
+
+// sendInvitations sends the invitation emails one after the other, waiting interval between two messages
+func (s *userManagementServer) sendInvitations(instanceID string, invitees []invitee, interval time.Duration) {
+	for i, u := range invitees {
+		if i > 0 && interval > 0 {
+			time.Sleep(interval)
+		}
+		if err := s.sendInvitation(context.Background(), instanceID, u); err != nil {
+			log.Printf("sendInvitations: %s: %v", u.UserID, err)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"google.golang.org/grpc"
+)
+
+type UserManagementServiceAPI_ImportUsers struct {
+	grpc.ServerStream
+	Msgs   []*api.ImportUsersMsg
+	Report *api.ImportUsersReport
+}
+
+func (_m *UserManagementServiceAPI_ImportUsers) Recv() (*api.ImportUsersMsg, error) {
+	if len(_m.Msgs) == 0 {
+		return nil, io.EOF
+	}
+	msg := _m.Msgs[0]
+	_m.Msgs = _m.Msgs[1:]
+	return msg, nil
+}
+
+func (_m *UserManagementServiceAPI_ImportUsers) SendAndClose(report *api.ImportUsersReport) error {
+	_m.Report = report
+	return nil
+}
+
+func TestImportUsersEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+			InvitationLifetime:       time.Hour,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	_, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_import_existing@test.com",
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	validRows := []*api.ImportUserRow{
+		{Row: 1, Email: "Test_For_Import_1@test.com", PreferredLanguage: "de", ProfileNames: []string{"main", "child"}},
+		{Row: 2, Email: "test_for_import_2@test.com", Roles: []string{"PARTICIPANT", "RESEARCHER"}},
+		{Row: 3, Email: "test_for_import_existing@test.com"},
+	}
+
+	t.Run("without messages", func(t *testing.T) {
+		err := s.ImportUsers(&UserManagementServiceAPI_ImportUsers{})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without admin role", func(t *testing.T) {
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{
+					Token: &api_types.TokenInfos{
+						Id:         "testuser",
+						InstanceId: testInstanceID,
+						Payload: map[string]string{
+							"roles": "PARTICIPANT",
+						},
+					},
+				},
+			},
+		}
+		err := s.ImportUsers(stream)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid rows", func(t *testing.T) {
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, Rows: []*api.ImportUserRow{{Row: 1, Email: "test_for_import_3@test.com"}}},
+				{Rows: []*api.ImportUserRow{
+					{Row: 2, Email: "not-an-email"},
+					{Row: 3, Email: "test_for_import_3@test.com"},
+				}},
+			},
+		}
+		err := s.ImportUsers(stream)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "import contains invalid rows")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with roles not allowed", func(t *testing.T) {
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, DryRun: true, Rows: []*api.ImportUserRow{
+					{Row: 1, Email: "test_for_import_admin@test.com", Roles: []string{"PARTICIPANT", "ADMIN"}},
+					{Row: 2, Email: "test_for_import_unknown_role@test.com", Roles: []string{"NOT_DEFINED"}},
+					{Row: 3, Email: "test_for_import_super_admin@test.com", Roles: []string{"SUPER_ADMIN"}},
+				}},
+			},
+		}
+		err := s.ImportUsers(stream)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		r := stream.Report
+		if r.Invalid != 3 {
+			t.Errorf("unexpected report: %v", r)
+			return
+		}
+		if r.Results[0].Error != "role requires approval, grant it with GrantRole: ADMIN" || r.Results[1].Error != "role not assignable: NOT_DEFINED" ||
+			r.Results[2].Error != "permission denied to assign role: SUPER_ADMIN" {
+			t.Errorf("unexpected row results: %v", r.Results)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, DryRun: true, Rows: validRows},
+			},
+		}
+		err := s.ImportUsers(stream)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		r := stream.Report
+		if !r.DryRun || r.Total != 3 || r.Existing != 1 || r.Created != 0 || r.Invalid != 0 {
+			t.Errorf("unexpected report: %v", r)
+			return
+		}
+		if r.Results[0].Status != importStatusNew || r.Results[0].Email != "test_for_import_1@test.com" {
+			t.Errorf("unexpected row result: %v", r.Results[0])
+		}
+		if _, err := testUserDBService.GetUserByAccountID(testInstanceID, "test_for_import_1@test.com"); err == nil {
+			t.Error("dry run should not create users")
+		}
+	})
+
+	t.Run("import twice", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, Rows: validRows},
+			},
+		}
+		err := s.ImportUsers(stream)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if stream.Report.Created != 2 || stream.Report.Existing != 1 {
+			t.Errorf("unexpected report: %v", stream.Report)
+			return
+		}
+		user, err := testUserDBService.GetUserByAccountID(testInstanceID, "test_for_import_1@test.com")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(user.Profiles) != 2 || user.Account.PreferredLanguage != "de" || !user.HasRole("PARTICIPANT") {
+			t.Errorf("unexpected user: %v", user)
+		}
+
+		stream = &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, Rows: validRows},
+			},
+		}
+		err = s.ImportUsers(stream)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if stream.Report.Created != 0 || stream.Report.Existing != 3 {
+			t.Errorf("unexpected report: %v", stream.Report)
+		}
+	})
+
+	t.Run("invitation interval too long", func(t *testing.T) {
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, DryRun: true, SendInvitations: true, InvitationInterval: 1800, Rows: validRows},
+			},
+		}
+		err := s.ImportUsers(stream)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invitation interval too long for the number of rows")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("imported user with past creation time survives cleanup", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		createdAt := time.Now().Unix() - 1000*24*3600
+		stream := &UserManagementServiceAPI_ImportUsers{
+			Msgs: []*api.ImportUsersMsg{
+				{Token: adminToken, Rows: []*api.ImportUserRow{
+					{Row: 1, Email: "test_for_import_old@test.com", CreatedAt: createdAt},
+				}},
+			},
+		}
+		if err := s.ImportUsers(stream); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if stream.Report.Created != 1 {
+			t.Errorf("unexpected report: %v", stream.Report)
+			return
+		}
+
+		// only users created before the imported one are candidates
+		if _, err := testUserDBService.DeleteUnverfiedUsers(testInstanceID, createdAt+1); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		user, err := testUserDBService.GetUserByAccountID(testInstanceID, "test_for_import_old@test.com")
+		if err != nil {
+			t.Errorf("imported user should not be cleaned up: %v", err)
+			return
+		}
+		if user.Invitation == nil || user.Invitation.ExpiresAt <= time.Now().Unix() || user.Invitation.SendCount != 0 {
+			t.Errorf("unexpected invitation: %v", user.Invitation)
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) CreateInstance(ctx context.Context, req *api.InstanceMsg) (*api.Instance, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.InstanceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_INSTANCES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if !utils.CheckInstanceID(req.InstanceId) {
+		return nil, status.Error(codes.InvalidArgument, "instance id not valid")
+	}
+	settings := models.InstanceSettingsFromAPI(req.Settings)
+	if err := validateInstanceSettings(settings); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	instance, err := s.globalDBService.CreateInstance(models.Instance{
+		InstanceID: req.InstanceId,
+		Name:       req.Name,
+		Settings:   settings,
+		CreatedAt:  time.Now().Unix(),
+	})
+	if err == globaldb.ErrInstanceExists {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.userDBservice.CreateIndexes(instance.InstanceID); err != nil {
+		log.Printf("CreateInstance: indexes of %s could not be created: %v", instance.InstanceID, err)
+	}
+	s.reloadInstanceConfigs()
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventInstanceCreated, instance.InstanceID)
+	return instance.ToAPI(), nil
+}
+
+func (s *userManagementServer) UpdateInstanceSettings(ctx context.Context, req *api.InstanceMsg) (*api.Instance, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.InstanceId == "" || req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_INSTANCES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	settings := models.InstanceSettingsFromAPI(req.Settings)
+	if err := validateInstanceSettings(settings); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	instance, err := s.globalDBService.UpdateInstanceSettings(req.InstanceId, req.Name, settings)
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.NotFound, "instance not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.reloadInstanceConfigs()
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventInstanceUpdated, instance.InstanceID)
+	return instance.ToAPI(), nil
+}
+
+func (s *userManagementServer) GetInstances(ctx context.Context, req *api.GetInstancesReq) (*api.InstanceList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_INSTANCES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	instances, err := s.globalDBService.GetInstances(req.IncludeArchived)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.InstanceList{
+		Instances: make([]*api.Instance, len(instances)),
+	}
+	for i, instance := range instances {
+		resp.Instances[i] = instance.ToAPI()
+		if instance.ArchivedAt > 0 {
+			continue
+		}
+		count, err := s.userDBservice.CountUsers(instance.InstanceID)
+		if err != nil {
+			log.Printf("GetInstances: users of %s could not be counted: %v", instance.InstanceID, err)
+			continue
+		}
+		resp.Instances[i].UserCount = count
+	}
+	return resp, nil
+}
+
+// ArchiveInstance retires the instance: it is left out of the instances in use and the collections of its users database
+// are moved to an archive database. Can be called again to finish an archiving that failed.
+func (s *userManagementServer) ArchiveInstance(ctx context.Context, req *api.InstanceMsg) (*api.Instance, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.InstanceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_INSTANCES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.InstanceId == req.Token.InstanceId {
+		return nil, status.Error(codes.FailedPrecondition, "own instance cannot be archived")
+	}
+
+	instance, err := s.globalDBService.ArchiveInstance(req.InstanceId, time.Now().Unix())
+	if err == mongo.ErrNoDocuments {
+		return nil, status.Error(codes.NotFound, "instance not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	archiveDB, err := s.userDBservice.ArchiveUserDB(instance.InstanceID, instance.ArchivedAt)
+	if err != nil {
+		log.Printf("ArchiveInstance: users database of %s could not be archived: %v", instance.InstanceID, err)
+		return nil, status.Error(codes.Internal, "users database could not be archived, retry to finish archiving")
+	}
+	s.reloadInstanceConfigs()
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventInstanceArchived, instance.InstanceID+" - users moved to "+archiveDB)
+	return instance.ToAPI(), nil
+}
+
+func validateInstanceSettings(settings models.InstanceSettings) error {
+	for _, lang := range settings.Languages {
+		if !utils.CheckLanguageCode(lang) {
+			return fmt.Errorf("language code wrong: %s", lang)
+		}
+	}
+	if settings.PasswordPolicy.MinLength < 0 || settings.PasswordPolicy.MinLength > 512 {
+		return errors.New("password min length not valid")
+	}
+	if settings.PasswordPolicy.MinCharClasses < 0 || settings.PasswordPolicy.MinCharClasses > 4 {
+		return errors.New("password min char classes not valid")
+	}
+	if settings.TokenLifetimes.AccessToken < 0 || settings.TokenLifetimes.VerificationCode < 0 || settings.TokenLifetimes.Invitation < 0 {
+		return errors.New("token lifetime not valid")
+	}
+	if settings.NewUserCountLimit < 0 || settings.CleanUpUnverifiedUsersAfter < 0 {
+		return errors.New("limits not valid")
+	}
+	return nil
+}
+
+// instanceConfig returns the config of the instance, the defaults of the server if it has no instance config provider
+func (s *userManagementServer) instanceConfig(instanceID string) instanceconfig.Config {
+	if s.instanceConfigs == nil {
+		return instanceconfig.Config{
+			Intervals:         s.Intervals,
+			NewUserCountLimit: s.newUserCountLimit,
+		}
+	}
+	return s.instanceConfigs.Get(instanceID)
+}
+
+// checkInstanceActive refuses requests for instances that are archived or have no entry in the global DB, so that they
+// don't create a new users database. Not checked without instance config provider.
+func (s *userManagementServer) checkInstanceActive(instanceID string) error {
+	if s.instanceConfigs == nil || s.instanceConfigs.IsActive(instanceID) {
+		return nil
+	}
+	return status.Error(codes.NotFound, "instance not found")
+}
+
+// reloadInstanceConfigs applies changed settings right away in this service instance, other ones reload periodically
+func (s *userManagementServer) reloadInstanceConfigs() {
+	if s.instanceConfigs == nil {
+		return
+	}
+	if err := s.instanceConfigs.Reload(); err != nil {
+		log.Printf("instance settings could not be reloaded: %v", err)
+	}
+}
+
+// checkPassword checks the password against the default rules and the password policy of the instance
+func (s *userManagementServer) checkPassword(instanceID string, password string) bool {
+	minLength, minCharClasses := s.instanceConfig(instanceID).Settings.PasswordPolicy.Requirements()
+	return utils.CheckPasswordRequirements(password, minLength, minCharClasses)
+}
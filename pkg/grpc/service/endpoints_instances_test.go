@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestInstanceManagementEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	intervals := models.Intervals{
+		TokenExpiryInterval:      time.Second * 2,
+		VerificationCodeLifetime: 60,
+	}
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		instanceConfigs: instanceconfig.NewProvider(testGlobalDBService, instanceconfig.Config{
+			Intervals:         intervals,
+			NewUserCountLimit: 100,
+		}),
+		Intervals: intervals,
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	superAdminToken := &api_types.TokenInfos{
+		Id:         "testsuperadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,SUPER_ADMIN",
+		},
+	}
+	instanceID := testInstanceID + "_managed"
+
+	t.Run("without super admin role", func(t *testing.T) {
+		_, err := s.CreateInstance(context.Background(), &api.InstanceMsg{Token: adminToken, InstanceId: instanceID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid instance id", func(t *testing.T) {
+		_, err := s.CreateInstance(context.Background(), &api.InstanceMsg{Token: superAdminToken, InstanceId: "with space"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance id not valid")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("create instance", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		instance, err := s.CreateInstance(context.Background(), &api.InstanceMsg{
+			Token:      superAdminToken,
+			InstanceId: instanceID,
+			Name:       "Managed",
+			Settings:   &api.InstanceSettings{Languages: []string{"en"}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if instance.InstanceId != instanceID || instance.CreatedAt == 0 {
+			t.Errorf("unexpected instance: %v", instance)
+		}
+
+		_, err = s.CreateInstance(context.Background(), &api.InstanceMsg{Token: superAdminToken, InstanceId: instanceID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance already exists")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("signup with language of the instance", func(t *testing.T) {
+		_, err := s.SignupWithEmail(context.Background(), &api.SignupWithEmailMsg{
+			InstanceId:        instanceID,
+			Email:             "instance-signup@test.com",
+			Password:          "SuperSecurePassword123!§$",
+			PreferredLanguage: "de",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "language not supported")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("update settings", func(t *testing.T) {
+		_, err := s.UpdateInstanceSettings(context.Background(), &api.InstanceMsg{
+			Token:      superAdminToken,
+			InstanceId: instanceID,
+			Settings:   &api.InstanceSettings{PasswordPolicy: &api.PasswordPolicy{MinCharClasses: 5}},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "password min char classes not valid")
+		if !ok {
+			t.Error(msg)
+		}
+
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+		instance, err := s.UpdateInstanceSettings(context.Background(), &api.InstanceMsg{
+			Token:      superAdminToken,
+			InstanceId: instanceID,
+			Settings: &api.InstanceSettings{
+				SignupClosed:      true,
+				NewUserCountLimit: 5000,
+				TokenLifetimes:    &api.TokenLifetimes{AccessToken: 10},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if instance.Name != "Managed" || !instance.Settings.SignupClosed {
+			t.Errorf("unexpected instance: %v", instance)
+		}
+		conf := s.instanceConfig(instanceID)
+		if conf.NewUserCountLimit != 5000 || conf.Intervals.TokenExpiryInterval != 10*time.Minute || conf.Intervals.VerificationCodeLifetime != 60 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+
+		_, err = s.SignupWithEmail(context.Background(), &api.SignupWithEmailMsg{
+			InstanceId:        instanceID,
+			Email:             "instance-signup@test.com",
+			Password:          "SuperSecurePassword123!§$",
+			PreferredLanguage: "en",
+		})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "signup closed")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("list instances", func(t *testing.T) {
+		resp, err := s.GetInstances(context.Background(), &api.GetInstancesReq{Token: superAdminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		found := false
+		for _, instance := range resp.Instances {
+			if instance.InstanceId == instanceID {
+				found = true
+				if instance.UserCount != 0 {
+					t.Errorf("unexpected user count: %d", instance.UserCount)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("instance not listed: %v", resp.Instances)
+		}
+	})
+
+	t.Run("archive own instance", func(t *testing.T) {
+		_, err := s.ArchiveInstance(context.Background(), &api.InstanceMsg{Token: superAdminToken, InstanceId: testInstanceID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "own instance cannot be archived")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("archive instance", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		instance, err := s.ArchiveInstance(context.Background(), &api.InstanceMsg{Token: superAdminToken, InstanceId: instanceID})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if instance.ArchivedAt == 0 {
+			t.Errorf("unexpected instance: %v", instance)
+		}
+		defer testUserDBService.DBClient.Database(testDBNamePrefix + instanceID + "_users_archived_" + strconv.FormatInt(instance.ArchivedAt, 10)).Drop(context.Background())
+
+		resp, err := s.GetInstances(context.Background(), &api.GetInstancesReq{Token: superAdminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		for _, i := range resp.Instances {
+			if i.InstanceId == instanceID {
+				t.Error("archived instance should not be listed")
+			}
+		}
+
+		_, err = s.SignupWithEmail(context.Background(), &api.SignupWithEmailMsg{
+			InstanceId:        instanceID,
+			Email:             "instance-signup@test.com",
+			Password:          "SuperSecurePassword123!§$",
+			PreferredLanguage: "en",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance not found")
+		if !ok {
+			t.Error(msg)
+		}
+		_, err = s.LoginWithEmail(context.Background(), &api.LoginWithEmailMsg{
+			InstanceId: instanceID,
+			Email:      "instance-signup@test.com",
+			Password:   "SuperSecurePassword123!§$",
+		})
+		ok, msg = shouldHaveGrpcErrorStatus(err, "instance not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("unknown instance", func(t *testing.T) {
+		_, err := s.InitiatePasswordReset(context.Background(), &api.InitiateResetPasswordMsg{
+			InstanceId: testInstanceID + "_unknown",
+			AccountId:  "instance-signup@test.com",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "instance not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
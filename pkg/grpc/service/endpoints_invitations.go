@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) GetInvitations(ctx context.Context, req *api.GetInvitationsReq) (*api.InvitationList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_READ) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	users, err := s.userDBservice.FindInvitedUsers(req.Token.InstanceId, req.IncludeClosed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.InvitationList{
+		Invitations: make([]*api.Invitation, len(users)),
+	}
+	for i, u := range users {
+		resp.Invitations[i] = u.InvitationToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) ResendInvitation(ctx context.Context, req *api.InvitationMsg) (*api.Invitation, error) {
+	user, err := s.getInvitedUser(req)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := req.Token.InstanceId
+
+	if user.Invitation.LastSentAt > time.Now().Unix()-invitationResendCooldown {
+		return nil, status.Error(codes.ResourceExhausted, "cannot send invitation so often")
+	}
+
+	// only the latest invitation link should be usable
+	if err := s.globalDBService.DeleteAllTempTokenForUser(instanceID, req.UserId, constants.TOKEN_PURPOSE_INVITATION); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.sendInvitation(ctx, instanceID, inviteeOf(user)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	user, err = s.userDBservice.GetUserByID(instanceID, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventInvitationResent, "by admin - "+user.ID.Hex()+" - "+user.Account.AccountID)
+	return user.InvitationToAPI(), nil
+}
+
+func (s *userManagementServer) RevokeInvitation(ctx context.Context, req *api.InvitationMsg) (*api.Invitation, error) {
+	user, err := s.getInvitedUser(req)
+	if err != nil {
+		return nil, err
+	}
+	instanceID := req.Token.InstanceId
+
+	if err := s.globalDBService.DeleteAllTempTokenForUser(instanceID, req.UserId, constants.TOKEN_PURPOSE_INVITATION); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if user.Invitation.RevokedAt == 0 {
+		user, err = s.userDBservice.SaveInvitationRevoked(instanceID, req.UserId, time.Now().Unix())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventInvitationRevoked, "by admin - "+user.ID.Hex()+" - "+user.Account.AccountID)
+	return user.InvitationToAPI(), nil
+}
+
+// getInvitedUser checks the request of an admin about an invitation, which must not be accepted yet
+func (s *userManagementServer) getInvitedUser(req *api.InvitationMsg) (models.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" {
+		return models.User{}, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_CREATE) {
+		return models.User{}, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.UserId)
+	if err != nil {
+		return user, status.Error(codes.NotFound, "user not found")
+	}
+	if user.Invitation == nil {
+		return user, status.Error(codes.NotFound, "user has not been invited")
+	}
+	if user.Invitation.AcceptedAt > 0 {
+		return user, status.Error(codes.FailedPrecondition, "invitation already accepted")
+	}
+	return user, nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	messageMock "github.com/influenzanet/user-management-service/test/mocks/messaging_service"
+)
+
+func TestInvitationEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockMessagingClient := messageMock.NewMockMessagingServiceApiClient(mockCtrl)
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+			InvitationLifetime:       time.Hour,
+		},
+		clients: &models.APIClients{
+			MessagingService: mockMessagingClient,
+			LoggingService:   mockLoggingClient,
+		},
+	}
+
+	now := time.Now().Unix()
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{Type: "email", AccountID: "test_invitation_pending@test.com"},
+			Invitation: &models.Invitation{
+				FirstSentAt: now - 3600,
+				LastSentAt:  now - 3600,
+				SendCount:   1,
+				ExpiresAt:   now + 3600,
+			},
+		},
+		{
+			Account: models.Account{Type: "email", AccountID: "test_invitation_accepted@test.com"},
+			Invitation: &models.Invitation{
+				FirstSentAt: now - 3600,
+				LastSentAt:  now - 3600,
+				SendCount:   1,
+				ExpiresAt:   now + 3600,
+				AcceptedAt:  now - 60,
+			},
+		},
+		{
+			Account: models.Account{Type: "email", AccountID: "test_invitation_recent@test.com"},
+			Invitation: &models.Invitation{
+				FirstSentAt: now - 10,
+				LastSentAt:  now - 10,
+				SendCount:   1,
+				ExpiresAt:   now + 3600,
+			},
+		},
+		{
+			Account: models.Account{Type: "email", AccountID: "test_invitation_none@test.com"},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	pendingID := testUsers[0].ID.Hex()
+	acceptedID := testUsers[1].ID.Hex()
+	recentID := testUsers[2].ID.Hex()
+	notInvitedID := testUsers[3].ID.Hex()
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	participantToken := &api_types.TokenInfos{
+		Id:         "testuser",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT",
+		},
+	}
+
+	findInvitation := func(list *api.InvitationList, userID string) *api.Invitation {
+		for _, inv := range list.Invitations {
+			if inv.UserId == userID {
+				return inv
+			}
+		}
+		return nil
+	}
+
+	t.Run("get invitations without admin role", func(t *testing.T) {
+		_, err := s.GetInvitations(context.Background(), &api.GetInvitationsReq{Token: participantToken})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("get pending invitations", func(t *testing.T) {
+		resp, err := s.GetInvitations(context.Background(), &api.GetInvitationsReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		inv := findInvitation(resp, pendingID)
+		if inv == nil || inv.Status != models.INVITATION_STATUS_PENDING {
+			t.Errorf("unexpected invitation: %v", inv)
+		}
+		if findInvitation(resp, acceptedID) != nil || findInvitation(resp, notInvitedID) != nil {
+			t.Errorf("unexpected invitations: %v", resp.Invitations)
+		}
+	})
+
+	t.Run("get all invitations", func(t *testing.T) {
+		resp, err := s.GetInvitations(context.Background(), &api.GetInvitationsReq{Token: adminToken, IncludeClosed: true})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		inv := findInvitation(resp, acceptedID)
+		if inv == nil || inv.Status != models.INVITATION_STATUS_ACCEPTED || inv.AcceptedAt != now-60 {
+			t.Errorf("unexpected invitation: %v", inv)
+		}
+	})
+
+	t.Run("resend without invitation", func(t *testing.T) {
+		_, err := s.ResendInvitation(context.Background(), &api.InvitationMsg{Token: adminToken, UserId: notInvitedID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "user has not been invited")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("resend accepted invitation", func(t *testing.T) {
+		_, err := s.ResendInvitation(context.Background(), &api.InvitationMsg{Token: adminToken, UserId: acceptedID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invitation already accepted")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("resend too early", func(t *testing.T) {
+		_, err := s.ResendInvitation(context.Background(), &api.InvitationMsg{Token: adminToken, UserId: recentID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "cannot send invitation so often")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("resend invitation", func(t *testing.T) {
+		mockMessagingClient.EXPECT().SendInstantEmail(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		inv, err := s.ResendInvitation(context.Background(), &api.InvitationMsg{Token: adminToken, UserId: pendingID})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if inv.SendCount != 2 || inv.FirstSentAt != now-3600 || inv.LastSentAt < now || inv.Status != models.INVITATION_STATUS_PENDING {
+			t.Errorf("unexpected invitation: %v", inv)
+		}
+		tokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, pendingID, "invitation")
+		if err != nil || len(tokens) != 1 {
+			t.Errorf("unexpected tokens: %v, %v", tokens, err)
+		}
+	})
+
+	t.Run("revoke invitation", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		inv, err := s.RevokeInvitation(context.Background(), &api.InvitationMsg{Token: adminToken, UserId: pendingID})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if inv.Status != models.INVITATION_STATUS_REVOKED || inv.RevokedAt == 0 {
+			t.Errorf("unexpected invitation: %v", inv)
+		}
+		tokens, err := testGlobalDBService.GetTempTokenForUser(testInstanceID, pendingID, "invitation")
+		if err != nil || len(tokens) != 0 {
+			t.Errorf("unexpected tokens: %v, %v", tokens, err)
+		}
+	})
+}
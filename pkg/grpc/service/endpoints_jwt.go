@@ -8,6 +8,7 @@ import (
 
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 	"github.com/influenzanet/user-management-service/pkg/utils"
 	"google.golang.org/grpc/codes"
@@ -27,12 +28,31 @@ func (s *userManagementServer) ValidateJWT(ctx context.Context, req *api.JWTRequ
 		return nil, status.Error(codes.InvalidArgument, "invalid token")
 	}
 
+	// TokenInfos has no field for the permissions, scopes and act claims, they are passed on in the payload
+	payload := map[string]string{}
+	for k, v := range parsedToken.Payload {
+		payload[k] = v
+	}
+	if parsedToken.Permissions != nil {
+		payload[authz.PAYLOAD_KEY_PERMISSIONS] = strings.Join(parsedToken.Permissions, ",")
+	}
+	if len(parsedToken.Scopes) > 0 {
+		scopes, err := authz.ScopesToPayload(parsedToken.Scopes)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		payload[authz.PAYLOAD_KEY_SCOPES] = scopes
+	}
+	if parsedToken.Actor != nil {
+		payload[authz.PAYLOAD_KEY_IMPERSONATOR] = parsedToken.Actor.ID
+	}
+
 	return &api_types.TokenInfos{
 		Id:               parsedToken.ID,
 		InstanceId:       parsedToken.InstanceID,
 		IssuedAt:         parsedToken.IssuedAt,
 		AccountConfirmed: parsedToken.AccountConfirmed,
-		Payload:          parsedToken.Payload,
+		Payload:          payload,
 		ProfilId:         parsedToken.ProfileID,
 		OtherProfileIds:  parsedToken.OtherProfileIDs,
 		TempToken:        parsedToken.TempTokenInfos.ToAPI(),
@@ -50,6 +70,9 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 		log.Printf("renew token error: %v", err.Error())
 		return nil, status.Error(codes.PermissionDenied, "wrong access token")
 	}
+	if parsedToken.Actor != nil {
+		return nil, status.Error(codes.PermissionDenied, "impersonation token cannot be renewed")
+	}
 
 	user, err := s.userDBservice.GetUserByID(parsedToken.InstanceID, parsedToken.ID)
 	if err != nil {
@@ -71,7 +94,8 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	mainProfileID, otherProfileIDs := utils.GetMainAndOtherProfiles(user)
 
 	// Generate new access token:
-	newToken, err := tokens.GenerateNewToken(parsedToken.ID, user.Account.AccountConfirmedAt > 0, mainProfileID, roles, parsedToken.InstanceID, s.Intervals.TokenExpiryInterval, username, nil, otherProfileIDs)
+	tokenLifetime := s.instanceConfig(parsedToken.InstanceID).Intervals.TokenExpiryInterval
+	newToken, err := tokens.GenerateNewToken(parsedToken.ID, user.Account.AccountConfirmedAt > 0, mainProfileID, roles, s.tokenPermissions(parsedToken.InstanceID, roles), user.ScopesForRoles(roles), parsedToken.InstanceID, tokenLifetime, username, nil, otherProfileIDs)
 	if err != nil {
 		log.Printf("renew token error: %v", err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
@@ -94,7 +118,7 @@ func (s *userManagementServer) RenewJWT(ctx context.Context, req *api.RefreshJWT
 	return &api.TokenResponse{
 		AccessToken:       newToken,
 		RefreshToken:      newRefreshToken,
-		ExpiresIn:         int32(s.Intervals.TokenExpiryInterval / time.Minute),
+		ExpiresIn:         int32(tokenLifetime / time.Minute),
 		SelectedProfileId: parsedToken.ProfileID,
 		Profiles:          user.ToAPI().Profiles,
 		PreferredLanguage: user.Account.PreferredLanguage,
@@ -8,6 +8,7 @@ import (
 	"github.com/golang/mock/gomock"
 	api_types "github.com/influenzanet/go-utils/pkg/api_types"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
 	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
@@ -41,12 +42,14 @@ func TestValidateJWT(t *testing.T) {
 		}
 	})
 
-	adminToken, err1 := tokens.GenerateNewToken("test-admin-id", true, "testprofid", []string{"PARTICIPANT", "ADMIN"}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
+	adminToken, err1 := tokens.GenerateNewToken("test-admin-id", true, "testprofid", []string{"PARTICIPANT", "ADMIN"}, []string{"users.read"}, map[string][]string{"ADMIN": {"study1"}}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
 	userToken, err2 := tokens.GenerateNewToken(
 		"test-user-id",
 		true,
 		"testprofid",
 		[]string{"PARTICIPANT"},
+		nil,
+		nil,
 		testInstanceID,
 		s.Intervals.TokenExpiryInterval,
 		"",
@@ -106,6 +109,13 @@ func TestValidateJWT(t *testing.T) {
 			t.Errorf("unexpected response: %s", resp)
 			return
 		}
+		if resp.Payload["permissions"] != "users.read" {
+			t.Errorf("unexpected permissions: %s", resp.Payload)
+		}
+		scopes, err := authz.ScopesFromPayload(resp.Payload)
+		if err != nil || len(scopes["ADMIN"]) != 1 || scopes["ADMIN"][0] != "study1" {
+			t.Errorf("unexpected scopes: %s", resp.Payload)
+		}
 	})
 
 	if testing.Short() {
@@ -161,7 +171,7 @@ func TestRenewJWT(t *testing.T) {
 		t.Errorf("failed to create testusers: %s", err.Error())
 		return
 	}
-	userToken, err := tokens.GenerateNewToken(testUsers[0].ID.Hex(), true, "testprofid", []string{"PARTICIPANT"}, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
+	userToken, err := tokens.GenerateNewToken(testUsers[0].ID.Hex(), true, "testprofid", []string{"PARTICIPANT"}, nil, nil, testInstanceID, s.Intervals.TokenExpiryInterval, "", nil, []string{})
 	if err != nil {
 		t.Errorf("unexpected error: %s", err)
 		return
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LinkExternalIdentity adds an IdP identity to the user's account, the caller has to make sure the user authenticated with this identity
+func (s *userManagementServer) LinkExternalIdentity(ctx context.Context, req *api.LinkedIdentityMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Password == "" || req.Idp == "" || req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	user, err := s.getUserWithPassword(req.Token, req.Password, "link identity endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	if other, err := s.userDBservice.GetUserByLinkedIdentity(req.Token.InstanceId, req.Idp, req.Subject); err == nil && other.ID != user.ID {
+		return nil, status.Error(codes.AlreadyExists, "identity linked to another account")
+	}
+	if err := user.Account.LinkIdentity(req.Idp, req.Subject); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventIdentityLinked, req.Idp)
+	return updUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) UnlinkExternalIdentity(ctx context.Context, req *api.LinkedIdentityMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Password == "" || req.Idp == "" || req.Subject == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	user, err := s.getUserWithPassword(req.Token, req.Password, "unlink identity endpoint")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.Account.UnlinkIdentity(req.Idp, req.Subject); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventIdentityUnlinked, req.Idp)
+	return updUser.ToAPI(), nil
+}
+
+// getUserWithPassword loads the token's user if the password matches
+func (s *userManagementServer) getUserWithPassword(token *api_types.TokenInfos, password string, logContext string) (models.User, error) {
+	user, err := s.userDBservice.GetUserByID(token.InstanceId, token.Id)
+	if err != nil {
+		return user, status.Error(codes.Internal, "user not found")
+	}
+
+	match, err := pwhash.ComparePasswordWithHash(user.Account.Password, password)
+	if err != nil || !match {
+		s.SaveLogEvent(token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_PASSWORD, logContext)
+		return user, status.Error(codes.InvalidArgument, "action failed")
+	}
+	return user, nil
+}
+
+// findUserForExternalLogin looks up the user by the linked identity first, then by the email
+func (s *userManagementServer) findUserForExternalLogin(req *api.LoginWithExternalIDPMsg) (models.User, error) {
+	if req.Idp != "" && req.Subject != "" {
+		user, err := s.userDBservice.GetUserByLinkedIdentity(req.InstanceId, req.Idp, req.Subject)
+		if err == nil {
+			return user, nil
+		}
+	}
+	return s.userDBservice.GetUserByAccountID(req.InstanceId, req.Email)
+}
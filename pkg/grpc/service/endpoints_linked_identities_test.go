@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/pwhash"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestLinkExternalIdentityEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+	mockLoggingClient.EXPECT().SaveLogEvent(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, nil).AnyTimes()
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testPw := "test234-TESt??"
+	hashPw, _ := pwhash.HashPassword(testPw)
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:               "email",
+				AccountID:          "link_identity_0@test.com",
+				AccountConfirmedAt: time.Now().Unix(),
+				Password:           hashPw,
+			},
+			Profiles: []models.Profile{
+				{ID: primitive.NewObjectID(), Alias: "main", MainProfile: true},
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "link_identity_1@test.com",
+				Password:  hashPw,
+				LinkedIdentities: []models.LinkedIdentity{
+					{IDP: "test-idp", Subject: "taken-subject"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.LinkExternalIdentity(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong password", func(t *testing.T) {
+		req := &api.LinkedIdentityMsg{
+			Token:    token,
+			Password: "wrong-PW-123",
+			Idp:      "test-idp",
+			Subject:  "subject-0",
+		}
+		_, err := s.LinkExternalIdentity(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "action failed")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with identity of other account", func(t *testing.T) {
+		req := &api.LinkedIdentityMsg{
+			Token:    token,
+			Password: testPw,
+			Idp:      "test-idp",
+			Subject:  "taken-subject",
+		}
+		_, err := s.LinkExternalIdentity(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "identity linked to another account")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("link identity", func(t *testing.T) {
+		req := &api.LinkedIdentityMsg{
+			Token:    token,
+			Password: testPw,
+			Idp:      "test-idp",
+			Subject:  "subject-0",
+		}
+		resp, err := s.LinkExternalIdentity(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Account.LinkedIdentities) != 1 || resp.Account.LinkedIdentities[0].LinkedAt <= 0 {
+			t.Errorf("unexpected identities: %v", resp.Account.LinkedIdentities)
+		}
+
+		_, err = s.LinkExternalIdentity(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "identity already linked")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("login with linked identity", func(t *testing.T) {
+		req := &api.LoginWithExternalIDPMsg{
+			InstanceId: testInstanceID,
+			Email:      "other-address@idp.test.com",
+			Role:       "PARTICIPANT",
+			Idp:        "test-idp",
+			Subject:    "subject-0",
+		}
+		resp, err := s.LoginWithExternalIDP(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.User.Id != testUsers[0].ID.Hex() {
+			t.Errorf("logged in as wrong user: %s", resp.User.Id)
+		}
+	})
+
+	t.Run("login by email without linked identity", func(t *testing.T) {
+		req := &api.LoginWithExternalIDPMsg{
+			InstanceId: testInstanceID,
+			Email:      "link_identity_0@test.com",
+			Role:       "PARTICIPANT",
+			Idp:        "other-idp",
+			Subject:    "subject-0",
+		}
+		_, err := s.LoginWithExternalIDP(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "wrong account type")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("unlink identity", func(t *testing.T) {
+		req := &api.LinkedIdentityMsg{
+			Token:    token,
+			Password: testPw,
+			Idp:      "test-idp",
+			Subject:  "subject-0",
+		}
+		resp, err := s.UnlinkExternalIdentity(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Account.LinkedIdentities) != 0 {
+			t.Errorf("unexpected identities: %v", resp.Account.LinkedIdentities)
+		}
+
+		_, err = s.UnlinkExternalIdentity(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "identity not linked")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) MergeAccounts(ctx context.Context, req *api.MergeAccountsMsg) (*api.MergeAccountsResponse, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.SourceUserId == "" || req.TargetUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_UPDATE) || !s.authorize(req.Token, authz.PERMISSION_USERS_DELETE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if req.SourceUserId == req.TargetUserId {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge account with itself")
+	}
+	instanceID := req.Token.InstanceId
+
+	source, err := s.userDBservice.GetUserByID(instanceID, req.SourceUserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "source user not found")
+	}
+	target, err := s.userDBservice.GetUserByID(instanceID, req.TargetUserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "target user not found")
+	}
+
+	defs, err := s.globalDBService.GetRoleDefinitions(instanceID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// roles that require approval are not taken over, so that merging doesn't bypass the approval of a second admin
+	profileMapping, rolesNotMerged, err := target.MergeFrom(source, maximumProfilesAllowed, func(role string) bool {
+		return s.canAssignRole(req.Token, role) && !authz.RequiresApproval(role, defs)
+	})
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if req.DryRun {
+		return &api.MergeAccountsResponse{
+			DryRun:           true,
+			User:             target.ToAPI(),
+			ProfileIdMapping: profileMapping,
+			RolesNotMerged:   rolesNotMerged,
+		}, nil
+	}
+
+	target, err = s.userDBservice.UpdateUser(instanceID, target)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.userDBservice.DeleteUser(instanceID, source.ID.Hex()); err != nil {
+		log.Printf("MergeAccounts: source user %s merged into %s but not deleted: %v", source.ID.Hex(), target.ID.Hex(), err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.globalDBService.DeleteAllTempTokenForUser(instanceID, source.ID.Hex(), ""); err != nil {
+		log.Printf("MergeAccounts: %s", err.Error())
+	}
+
+	mapping := []string{}
+	for from, to := range profileMapping {
+		mapping = append(mapping, from+"->"+to)
+	}
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventAccountsMerged,
+		source.ID.Hex()+" ("+source.Account.AccountID+") into "+target.ID.Hex()+" ("+target.Account.AccountID+") - profiles: "+strings.Join(mapping, ", ")+" - roles not merged: "+strings.Join(rolesNotMerged, ", "))
+	s.SaveLogEvent(instanceID, source.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_DELETED, "merged into "+target.ID.Hex()+" by admin "+req.Token.Id)
+
+	return &api.MergeAccountsResponse{
+		User:             target.ToAPI(),
+		ProfileIdMapping: profileMapping,
+		RolesNotMerged:   rolesNotMerged,
+	}, nil
+}
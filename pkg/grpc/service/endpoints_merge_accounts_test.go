@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMergeAccountsEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	sourceMain := primitive.NewObjectID()
+	sourceChild := primitive.NewObjectID()
+	targetMain := primitive.NewObjectID()
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_merge_source@test.com",
+				LinkedIdentities: []models.LinkedIdentity{
+					{IDP: "test-idp", Subject: "test_for_merge_source"},
+				},
+			},
+			Roles: []string{"PARTICIPANT", "RESEARCHER", "ADMIN", "SUPER_ADMIN"},
+			Profiles: []models.Profile{
+				{ID: sourceMain, Alias: "main", MainProfile: true},
+				{ID: sourceChild, Alias: "child"},
+			},
+			ContactInfos: []models.ContactInfo{
+				{ID: primitive.NewObjectID(), Type: "email", Email: "test_for_merge_source@test.com", ConfirmedAt: time.Now().Unix()},
+				{ID: primitive.NewObjectID(), Type: "email", Email: "test_for_merge_target@test.com", ConfirmedAt: time.Now().Unix()},
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_merge_target@test.com",
+			},
+			Roles: []string{"PARTICIPANT"},
+			Profiles: []models.Profile{
+				{ID: targetMain, Alias: "main", MainProfile: true},
+			},
+			ContactInfos: []models.ContactInfo{
+				{ID: primitive.NewObjectID(), Type: "email", Email: "test_for_merge_target@test.com"},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+	sourceID := testUsers[0].ID.Hex()
+	targetID := testUsers[1].ID.Hex()
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.MergeAccounts(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without admin role", func(t *testing.T) {
+		req := &api.MergeAccountsMsg{
+			Token: &api_types.TokenInfos{
+				Id:         targetID,
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+			SourceUserId: sourceID,
+			TargetUserId: targetID,
+		}
+		_, err := s.MergeAccounts(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with same account", func(t *testing.T) {
+		req := &api.MergeAccountsMsg{
+			Token:        adminToken,
+			SourceUserId: targetID,
+			TargetUserId: targetID,
+		}
+		_, err := s.MergeAccounts(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "cannot merge account with itself")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		req := &api.MergeAccountsMsg{
+			Token:        adminToken,
+			SourceUserId: sourceID,
+			TargetUserId: targetID,
+			DryRun:       true,
+		}
+		resp, err := s.MergeAccounts(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !resp.DryRun || len(resp.User.Profiles) != 2 || len(resp.User.ContactInfos) != 2 || len(resp.User.Roles) != 2 {
+			t.Errorf("unexpected preview: %v", resp.User)
+		}
+		if len(resp.RolesNotMerged) != 2 || resp.RolesNotMerged[0] != "ADMIN" || resp.RolesNotMerged[1] != "SUPER_ADMIN" {
+			t.Errorf("unexpected roles not merged: %v", resp.RolesNotMerged)
+		}
+		if resp.ProfileIdMapping[sourceMain.Hex()] != targetMain.Hex() || resp.ProfileIdMapping[sourceChild.Hex()] != sourceChild.Hex() {
+			t.Errorf("unexpected profile mapping: %v", resp.ProfileIdMapping)
+		}
+		if _, err := testUserDBService.GetUserByID(testInstanceID, sourceID); err != nil {
+			t.Error("dry run should not delete the source")
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+
+		req := &api.MergeAccountsMsg{
+			Token:        adminToken,
+			SourceUserId: sourceID,
+			TargetUserId: targetID,
+		}
+		resp, err := s.MergeAccounts(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.DryRun || len(resp.ProfileIdMapping) != 2 {
+			t.Errorf("unexpected response: %v", resp)
+		}
+		if _, err := testUserDBService.GetUserByID(testInstanceID, sourceID); err == nil {
+			t.Error("source should be deleted")
+		}
+		target, err := testUserDBService.GetUserByID(testInstanceID, targetID)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(target.Profiles) != 2 || !target.HasRole("RESEARCHER") || target.HasRole("ADMIN") || target.HasRole("SUPER_ADMIN") {
+			t.Errorf("unexpected target: %v", target)
+		}
+		if !target.Account.HasLinkedIdentity("test-idp", "test_for_merge_source") {
+			t.Error("linked identity of the source should be kept")
+		}
+		ci, _ := target.FindContactInfoByTypeAndAddr("email", "test_for_merge_target@test.com")
+		if ci.ConfirmedAt <= 0 {
+			t.Error("confirmation of the source should be kept")
+		}
+	})
+}
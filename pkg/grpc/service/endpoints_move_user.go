@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) MoveUserToInstance(ctx context.Context, req *api.MoveUserMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" || req.TargetInstanceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	// the user leaves the caller's instance, only admins of all instances can do this
+	if !s.authorize(req.Token, authz.PERMISSION_INSTANCES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	sourceInstanceID := req.Token.InstanceId
+	targetInstanceID := req.TargetInstanceId
+	if sourceInstanceID == targetInstanceID {
+		return nil, status.Error(codes.InvalidArgument, "user already in target instance")
+	}
+	if err := s.checkInstanceActive(targetInstanceID); err != nil {
+		return nil, status.Error(codes.NotFound, "target instance not found")
+	}
+
+	user, err := s.userDBservice.GetUserByID(sourceInstanceID, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if _, err := s.userDBservice.GetUserByAccountID(targetInstanceID, user.Account.AccountID); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "account id already used in target instance")
+	}
+
+	// sessions of the old instance must not be renewed
+	user.Account.RefreshTokens = []string{}
+
+	if err := s.userDBservice.InsertUser(targetInstanceID, user); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.userDBservice.DeleteUser(sourceInstanceID, user.ID.Hex()); err != nil {
+		// keep a single copy of the user
+		if rbErr := s.userDBservice.DeleteUser(targetInstanceID, user.ID.Hex()); rbErr != nil {
+			log.Printf("MoveUserToInstance: user %s exists in %s and %s: %v", user.ID.Hex(), sourceInstanceID, targetInstanceID, rbErr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if _, err := s.globalDBService.MoveTempTokensToInstance(user.ID.Hex(), sourceInstanceID, targetInstanceID); err != nil {
+		log.Printf("MoveUserToInstance: %s", err.Error())
+	}
+	if _, err := s.userDBservice.MoveRoleGrantsToInstance(user.ID.Hex(), sourceInstanceID, targetInstanceID); err != nil {
+		log.Printf("MoveUserToInstance: %s", err.Error())
+	}
+	// like the sessions, API keys were issued for the old instance
+	if _, err := s.userDBservice.DeleteAPIKeysOfUser(sourceInstanceID, user.ID.Hex()); err != nil {
+		log.Printf("MoveUserToInstance: %s", err.Error())
+	}
+
+	s.SaveLogEvent(sourceInstanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventUserMoved, "to "+targetInstanceID+" - "+user.ID.Hex()+" - "+user.Account.AccountID)
+	s.SaveLogEvent(targetInstanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventUserMoved, "from "+sourceInstanceID+" by admin "+req.Token.Id)
+
+	return user.ToAPI(), nil
+}
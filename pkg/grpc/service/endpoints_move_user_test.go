@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestMoveUserToInstanceEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+		instanceConfigs: instanceconfig.NewProvider(testGlobalDBService, instanceconfig.Config{}),
+	}
+
+	targetInstanceID := testInstanceID + "_move_target"
+	if _, err := testGlobalDBService.CreateInstance(models.Instance{InstanceID: targetInstanceID}); err != nil {
+		t.Errorf("failed to create target instance: %s", err.Error())
+		return
+	}
+	defer testUserDBService.DBClient.Database(testDBNamePrefix + targetInstanceID + "_users").Drop(context.Background())
+	if err := s.instanceConfigs.Reload(); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:          "email",
+				AccountID:     "test_for_move_user@test.com",
+				RefreshTokens: []string{"refresh1", "refresh2"},
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_move_user_collision@test.com",
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN,SUPER_ADMIN",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.MoveUserToInstance(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without admin role", func(t *testing.T) {
+		req := &api.MoveUserMsg{
+			Token: &api_types.TokenInfos{
+				Id:         testUsers[0].ID.Hex(),
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+			UserId:           testUsers[0].ID.Hex(),
+			TargetInstanceId: "other",
+		}
+		_, err := s.MoveUserToInstance(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with admin role of the instance only", func(t *testing.T) {
+		req := &api.MoveUserMsg{
+			Token: &api_types.TokenInfos{
+				Id:         "testadmin",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			UserId:           testUsers[0].ID.Hex(),
+			TargetInstanceId: targetInstanceID,
+		}
+		_, err := s.MoveUserToInstance(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("to same instance", func(t *testing.T) {
+		req := &api.MoveUserMsg{
+			Token:            adminToken,
+			UserId:           testUsers[0].ID.Hex(),
+			TargetInstanceId: testInstanceID,
+		}
+		_, err := s.MoveUserToInstance(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "user already in target instance")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("to unknown instance", func(t *testing.T) {
+		req := &api.MoveUserMsg{
+			Token:            adminToken,
+			UserId:           testUsers[0].ID.Hex(),
+			TargetInstanceId: "unknown-instance",
+		}
+		_, err := s.MoveUserToInstance(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "target instance not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("account id used in target instance", func(t *testing.T) {
+		if _, err := testUserDBService.AddUser(targetInstanceID, models.User{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: testUsers[1].Account.AccountID,
+			},
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		req := &api.MoveUserMsg{
+			Token:            adminToken,
+			UserId:           testUsers[1].ID.Hex(),
+			TargetInstanceId: targetInstanceID,
+		}
+		_, err := s.MoveUserToInstance(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "account id already used in target instance")
+		if !ok {
+			t.Error(msg)
+		}
+		if _, err := testUserDBService.GetUserByID(testInstanceID, testUsers[1].ID.Hex()); err != nil {
+			t.Errorf("user should stay in source instance: %v", err)
+		}
+	})
+
+	t.Run("move user", func(t *testing.T) {
+		tempToken, err := testGlobalDBService.AddTempToken(models.TempToken{
+			UserID:     testUsers[0].ID.Hex(),
+			InstanceID: testInstanceID,
+			Purpose:    constants.TOKEN_PURPOSE_PASSWORD_RESET,
+			Expiration: tokens.GetExpirationTime(time.Hour),
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testUserDBService.AddRoleGrant(testInstanceID, models.RoleGrant{
+			UserID: testUsers[0].ID.Hex(),
+			Role:   "RESEARCHER",
+			Status: models.ROLE_GRANT_STATUS_APPROVED,
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if _, err := testUserDBService.AddAPIKey(testInstanceID, models.APIKey{
+			UserID:  testUsers[0].ID.Hex(),
+			KeyHash: "test_move_user_key",
+		}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		req := &api.MoveUserMsg{
+			Token:            adminToken,
+			UserId:           testUsers[0].ID.Hex(),
+			TargetInstanceId: targetInstanceID,
+		}
+		if _, err := s.MoveUserToInstance(context.Background(), req); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		if _, err := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex()); err == nil {
+			t.Error("user should be removed from source instance")
+		}
+		moved, err := testUserDBService.GetUserByID(targetInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("user not found in target instance: %v", err)
+			return
+		}
+		if moved.Account.AccountID != testUsers[0].Account.AccountID {
+			t.Errorf("unexpected user: %v", moved.Account)
+		}
+		if len(moved.Account.RefreshTokens) > 0 {
+			t.Errorf("refresh tokens should be revoked: %v", moved.Account.RefreshTokens)
+		}
+
+		tt, err := testGlobalDBService.GetTempToken(tempToken)
+		if err != nil || tt.InstanceID != targetInstanceID {
+			t.Errorf("temp token should be moved: %v, %v", tt, err)
+		}
+
+		grants, err := testUserDBService.FindRoleGrants(targetInstanceID, testUsers[0].ID.Hex(), "", "")
+		if err != nil || len(grants) != 1 {
+			t.Errorf("role grants should be moved: %v, %v", grants, err)
+		}
+		grants, err = testUserDBService.FindRoleGrants(testInstanceID, testUsers[0].ID.Hex(), "", "")
+		if err != nil || len(grants) != 0 {
+			t.Errorf("role grants should be removed from source instance: %v, %v", grants, err)
+		}
+		keys, err := testUserDBService.FindAPIKeys(testInstanceID, testUsers[0].ID.Hex(), true)
+		if err != nil || len(keys) != 0 {
+			t.Errorf("api keys should be deleted: %v, %v", keys, err)
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/sms"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) AddPhone(ctx context.Context, req *api.ContactInfoMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfo == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	if req.ContactInfo.Type != models.CONTACT_TYPE_PHONE {
+		return nil, status.Error(codes.InvalidArgument, "wrong contact type")
+	}
+
+	phone := utils.SanitizePhoneNumber(req.ContactInfo.GetPhone())
+	if !utils.CheckPhoneFormat(phone) {
+		return nil, status.Error(codes.InvalidArgument, "phone number not valid")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+
+	if _, found := user.FindContactInfoByTypeAndAddr(models.CONTACT_TYPE_PHONE, phone); found {
+		return nil, status.Error(codes.InvalidArgument, "phone number already added")
+	}
+
+	user.AddNewPhone(phone, false)
+	ci, _ := user.FindContactInfoByTypeAndAddr(models.CONTACT_TYPE_PHONE, phone)
+
+	msg, err := s.generatePhoneVerificationCode(req.Token.InstanceId, &user, ci)
+	if err != nil {
+		return nil, err
+	}
+
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	go s.sendSMS(msg)
+	return updUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) RemovePhone(ctx context.Context, req *api.ContactInfoMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfo == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+
+	ci, found := user.FindContactInfoById(req.ContactInfo.Id)
+	if !found {
+		return nil, status.Error(codes.Internal, "contact not found")
+	}
+	if ci.Type != models.CONTACT_TYPE_PHONE {
+		return nil, status.Error(codes.InvalidArgument, "wrong contact type")
+	}
+
+	if err := user.RemoveContactInfo(req.ContactInfo.Id); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return updUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) VerifyPhone(ctx context.Context, req *api.PhoneVerificationMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.ContactInfoId == "" || req.VerificationCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if isImpersonated(req.Token) {
+		return nil, status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	user, err := s.userDBservice.GetUserByID(req.Token.InstanceId, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "user not found")
+	}
+
+	ci, found := user.FindContactInfoById(req.ContactInfoId)
+	if !found || ci.Type != models.CONTACT_TYPE_PHONE {
+		return nil, status.Error(codes.InvalidArgument, "contact not found")
+	}
+	if ci.ConfirmedAt > 0 {
+		return user.ToAPI(), nil
+	}
+
+	vc := ci.VerificationCode
+	if vc == nil || vc.ExpiresAt < time.Now().Unix() || vc.Attempts >= allowedVerificationCodeAttempts {
+		return nil, status.Error(codes.InvalidArgument, "verification code expired")
+	}
+
+	if vc.Code != req.VerificationCode {
+		log.Printf("SECURITY WARNING: phone verification attempt with wrong code for %s", user.ID.Hex())
+		s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_SECURITY, constants.LOG_EVENT_AUTH_WRONG_VERIFICATION_CODE, "phone verification")
+		vc.Attempts += 1
+		if err := user.SetContactInfoVerificationCode(ci.ID.Hex(), *vc); err != nil {
+			log.Printf("VerifyPhone: %s", err.Error())
+		}
+		if _, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user); err != nil {
+			log.Printf("VerifyPhone: unexpected error when saving user -> %v", err)
+		}
+		return nil, status.Error(codes.InvalidArgument, "wrong verfication code")
+	}
+
+	if err := user.ConfirmContactInfo(models.CONTACT_TYPE_PHONE, ci.Phone); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	updUser, err := s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, user.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_CONTACT_VERIFIED, ci.Phone)
+	return updUser.ToAPI(), nil
+}
+
+// generatePhoneVerificationCode stores a new code on the contact info and returns the SMS with the code. The caller
+// has to save the user before sending the SMS, otherwise the code could be received but not be accepted.
+func (s *userManagementServer) generatePhoneVerificationCode(instanceID string, user *models.User, ci models.ContactInfo) (sms.Message, error) {
+	if s.clients == nil || s.clients.SMSSender == nil {
+		return sms.Message{}, status.Error(codes.Unimplemented, "sms sending not configured")
+	}
+
+	if ci.ConfirmationLinkSentAt > time.Now().Unix()-contactVerificationMessageCooldown {
+		return sms.Message{}, status.Error(codes.InvalidArgument, "cannot send verification so often")
+	}
+
+	vc, err := tokens.GenerateVerificationCode(6)
+	if err != nil {
+		log.Printf("unexpected error while generating verification code: %v", err)
+		return sms.Message{}, status.Error(codes.Internal, "error while generating verification code")
+	}
+
+	if err := user.SetContactInfoVerificationCode(ci.ID.Hex(), models.VerificationCode{
+		Code:      vc,
+		Attempts:  0,
+		CreatedAt: time.Now().Unix(),
+		ExpiresAt: time.Now().Unix() + s.instanceConfig(instanceID).Intervals.VerificationCodeLifetime,
+	}); err != nil {
+		return sms.Message{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return sms.Message{
+		InstanceID:  instanceID,
+		To:          ci.Phone,
+		MessageType: sms.SMS_TYPE_CONTACT_VERIFICATION,
+		ContentInfos: map[string]string{
+			"verificationCode": vc,
+		},
+		PreferredLanguage: user.Account.PreferredLanguage,
+	}, nil
+}
+
+func (s *userManagementServer) sendSMS(msg sms.Message) {
+	if s.clients.SMSSender == nil {
+		return
+	}
+	if err := s.clients.SMSSender.SendSMS(context.TODO(), msg); err != nil {
+		log.Printf("sendSMS: %s", err.Error())
+	}
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/sms"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// storedCodeSender reports if the code of an SMS was already stored for the user when the SMS is sent
+type storedCodeSender struct {
+	instanceID string
+	userID     string
+	result     chan bool
+}
+
+func (c *storedCodeSender) SendSMS(ctx context.Context, msg sms.Message) error {
+	user, err := testUserDBService.GetUserByID(c.instanceID, c.userID)
+	if err != nil {
+		c.result <- false
+		return err
+	}
+	ci, found := user.FindContactInfoByTypeAndAddr(models.CONTACT_TYPE_PHONE, msg.To)
+	c.result <- found && ci.VerificationCode != nil && ci.VerificationCode.Code == msg.ContentInfos["verificationCode"]
+	return nil
+}
+
+func TestAddPhoneEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			SMSSender: sms.NewFileSender(ioutil.Discard),
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_add_phone@test.com",
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.AddPhone(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong contact type", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token: token,
+			ContactInfo: &api.ContactInfo{
+				Type:    "email",
+				Address: &api.ContactInfo_Email{Email: "test@test.com"},
+			},
+		}
+		_, err := s.AddPhone(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "wrong contact type")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid number", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token: token,
+			ContactInfo: &api.ContactInfo{
+				Type:    "phone",
+				Address: &api.ContactInfo_Phone{Phone: "0470 12 34 56"},
+			},
+		}
+		_, err := s.AddPhone(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "phone number not valid")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with valid number", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token: token,
+			ContactInfo: &api.ContactInfo{
+				Type:    "phone",
+				Address: &api.ContactInfo_Phone{Phone: "0032 470 12 34 56"},
+			},
+		}
+		resp, err := s.AddPhone(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.ContactInfos) != 1 || resp.ContactInfos[0].GetPhone() != "+32470123456" {
+			t.Errorf("unexpected contact infos: %v", resp.ContactInfos)
+		}
+		if resp.ContactInfos[0].ConfirmedAt > 0 {
+			t.Error("phone should not be confirmed yet")
+		}
+	})
+
+	t.Run("with already added number", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token: token,
+			ContactInfo: &api.ContactInfo{
+				Type:    "phone",
+				Address: &api.ContactInfo_Phone{Phone: "+32470123456"},
+			},
+		}
+		_, err := s.AddPhone(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "phone number already added")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("code saved before sms is sent", func(t *testing.T) {
+		sender := &storedCodeSender{
+			instanceID: testInstanceID,
+			userID:     testUsers[0].ID.Hex(),
+			result:     make(chan bool, 1),
+		}
+		checked := s
+		checked.clients = &models.APIClients{SMSSender: sender}
+
+		req := &api.ContactInfoMsg{
+			Token: token,
+			ContactInfo: &api.ContactInfo{
+				Type:    "phone",
+				Address: &api.ContactInfo_Phone{Phone: "+32470654321"},
+			},
+		}
+		if _, err := checked.AddPhone(context.Background(), req); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		select {
+		case stored := <-sender.result:
+			if !stored {
+				t.Error("code should be stored before the sms is sent")
+			}
+		case <-time.After(time.Second * 2):
+			t.Error("sms not sent")
+		}
+	})
+}
+
+func TestVerifyPhoneEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	phoneID := primitive.NewObjectID()
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_verify_phone@test.com",
+			},
+			ContactInfos: []models.ContactInfo{
+				{
+					ID:    phoneID,
+					Type:  "phone",
+					Phone: "+32470123456",
+					VerificationCode: &models.VerificationCode{
+						Code:      "123456",
+						CreatedAt: time.Now().Unix(),
+						ExpiresAt: time.Now().Unix() + 60,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.VerifyPhone(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong code", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		req := &api.PhoneVerificationMsg{
+			Token:            token,
+			ContactInfoId:    phoneID.Hex(),
+			VerificationCode: "654321",
+		}
+		_, err := s.VerifyPhone(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "wrong verfication code")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with correct code", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		req := &api.PhoneVerificationMsg{
+			Token:            token,
+			ContactInfoId:    phoneID.Hex(),
+			VerificationCode: "123456",
+		}
+		resp, err := s.VerifyPhone(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.ContactInfos[0].ConfirmedAt <= 0 {
+			t.Error("phone should be confirmed")
+		}
+	})
+}
+
+func TestRemovePhoneEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	emailID := primitive.NewObjectID()
+	phoneID := primitive.NewObjectID()
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_remove_phone@test.com",
+			},
+			ContactInfos: []models.ContactInfo{
+				{
+					ID:    emailID,
+					Type:  "email",
+					Email: "test_for_remove_phone@test.com",
+				},
+				{
+					ID:          phoneID,
+					Type:        "phone",
+					Phone:       "+32470123456",
+					ConfirmedAt: time.Now().Unix(),
+				},
+			},
+			ContactPreferences: models.ContactPreferences{
+				SendVerificationCodeTo: phoneID.Hex(),
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.RemovePhone(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with email contact", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token:       token,
+			ContactInfo: &api.ContactInfo{Id: emailID.Hex()},
+		}
+		_, err := s.RemovePhone(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "wrong contact type")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with phone contact", func(t *testing.T) {
+		req := &api.ContactInfoMsg{
+			Token:       token,
+			ContactInfo: &api.ContactInfo{Id: phoneID.Hex()},
+		}
+		resp, err := s.RemovePhone(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.ContactInfos) != 1 {
+			t.Errorf("unexpected contact infos: %v", resp.ContactInfos)
+		}
+		if resp.ContactPreferences.SendVerificationCodeTo != "" {
+			t.Error("verification code channel should be reset")
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) GetRoleDefinitions(ctx context.Context, req *api.GetRoleDefinitionsReq) (*api.RoleDefinitionList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	defs, err := s.globalDBService.GetRoleDefinitions(req.Token.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defs = append(authz.BuiltInRoleDefinitions(), defs...)
+
+	resp := &api.RoleDefinitionList{
+		RoleDefinitions:      make([]*api.RoleDefinition, len(defs)),
+		AvailablePermissions: authz.AllPermissions,
+	}
+	for i, d := range defs {
+		resp.RoleDefinitions[i] = d.ToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) SaveRoleDefinition(ctx context.Context, req *api.RoleDefinitionMsg) (*api.RoleDefinition, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.RoleDefinition == nil || req.RoleDefinition.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if authz.IsBuiltInRole(req.RoleDefinition.Role) {
+		return nil, status.Error(codes.InvalidArgument, "role reserved")
+	}
+	for _, p := range req.RoleDefinition.Permissions {
+		if !authz.IsKnownPermission(p) {
+			return nil, status.Error(codes.InvalidArgument, "unknown permission: "+p)
+		}
+	}
+
+	def := models.RoleDefinitionFromAPI(req.RoleDefinition)
+	def.InstanceID = req.Token.InstanceId
+	def, err := s.globalDBService.SaveRoleDefinition(def)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return def.ToAPI(), nil
+}
+
+func (s *userManagementServer) RemoveRoleDefinition(ctx context.Context, req *api.RoleDefinitionMsg) (*api.ServiceStatus, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.RoleDefinition == nil || req.RoleDefinition.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if authz.IsBuiltInRole(req.RoleDefinition.Role) {
+		return nil, status.Error(codes.InvalidArgument, "role reserved")
+	}
+
+	if err := s.globalDBService.DeleteRoleDefinition(req.Token.InstanceId, req.RoleDefinition.Role); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "role definition removed",
+	}, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+func TestRoleDefinitionEndpoints(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+	supportToken := &api_types.TokenInfos{
+		Id:         "testsupport",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,SUPPORT_TEST",
+		},
+	}
+
+	t.Run("without permission", func(t *testing.T) {
+		_, err := s.SaveRoleDefinition(context.Background(), &api.RoleDefinitionMsg{
+			Token:          supportToken,
+			RoleDefinition: &api.RoleDefinition{Role: "SUPPORT_TEST", Permissions: []string{authz.PERMISSION_ROLES_MANAGE}},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("built-in role", func(t *testing.T) {
+		_, err := s.SaveRoleDefinition(context.Background(), &api.RoleDefinitionMsg{
+			Token:          adminToken,
+			RoleDefinition: &api.RoleDefinition{Role: "ADMIN"},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role reserved")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("unknown permission", func(t *testing.T) {
+		_, err := s.SaveRoleDefinition(context.Background(), &api.RoleDefinitionMsg{
+			Token:          adminToken,
+			RoleDefinition: &api.RoleDefinition{Role: "SUPPORT_TEST", Permissions: []string{"users.everything"}},
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown permission: users.everything")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("define role", func(t *testing.T) {
+		def, err := s.SaveRoleDefinition(context.Background(), &api.RoleDefinitionMsg{
+			Token:          adminToken,
+			RoleDefinition: &api.RoleDefinition{Role: "SUPPORT_TEST", Permissions: []string{authz.PERMISSION_USERS_READ}},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if def.Role != "SUPPORT_TEST" || len(def.Permissions) != 1 {
+			t.Errorf("unexpected definition: %v", def)
+		}
+
+		resp, err := s.GetRoleDefinitions(context.Background(), &api.GetRoleDefinitionsReq{Token: adminToken})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.RoleDefinitions) != len(authz.BuiltInRoleDefinitions())+1 || !resp.RoleDefinitions[0].BuiltIn || len(resp.AvailablePermissions) != len(authz.AllPermissions) {
+			t.Errorf("unexpected definitions: %v", resp)
+		}
+	})
+
+	t.Run("permissions of defined role", func(t *testing.T) {
+		if !s.authorize(supportToken, authz.PERMISSION_USERS_READ) {
+			t.Error("should be allowed")
+		}
+		if s.authorize(supportToken, authz.PERMISSION_USERS_DELETE) {
+			t.Error("should not be allowed")
+		}
+	})
+
+	t.Run("permissions claim is used if present", func(t *testing.T) {
+		token := &api_types.TokenInfos{
+			Id:         "testsupport",
+			InstanceId: testInstanceID,
+			Payload: map[string]string{
+				"roles":       "PARTICIPANT,ADMIN",
+				"permissions": authz.PERMISSION_USERS_READ,
+			},
+		}
+		if s.authorize(token, authz.PERMISSION_ROLES_MANAGE) {
+			t.Error("should not be allowed")
+		}
+	})
+
+	t.Run("remove role definition", func(t *testing.T) {
+		_, err := s.RemoveRoleDefinition(context.Background(), &api.RoleDefinitionMsg{
+			Token:          adminToken,
+			RoleDefinition: &api.RoleDefinition{Role: "SUPPORT_TEST"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if s.authorize(supportToken, authz.PERMISSION_USERS_READ) {
+			t.Error("should not be allowed anymore")
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) GrantRole(ctx context.Context, req *api.RoleMsg) (*api.RoleGrant, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.Role == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	grant, _, err := s.requestRoleGrant(req, true)
+	if err != nil {
+		return nil, err
+	}
+	return grant.ToAPI(), nil
+}
+
+func (s *userManagementServer) GetRoleGrants(ctx context.Context, req *api.GetRoleGrantsReq) (*api.RoleGrantList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	grants, err := s.userDBservice.FindRoleGrants(req.Token.InstanceId, "", "", req.Status)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.RoleGrantList{
+		Grants: make([]*api.RoleGrant, len(grants)),
+	}
+	for i, g := range grants {
+		resp.Grants[i] = g.ToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) ApproveRoleGrant(ctx context.Context, req *api.RoleGrantDecisionMsg) (*api.RoleGrant, error) {
+	grant, err := s.getPendingRoleGrant(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Token.Id == grant.RequestedBy || req.Token.Id == grant.UserID {
+		return nil, status.Error(codes.PermissionDenied, "grant has to be approved by another admin")
+	}
+	if grant.ExpiresAt > 0 && grant.ExpiresAt < time.Now().Unix() {
+		return nil, status.Error(codes.FailedPrecondition, "time limit of the grant already passed")
+	}
+
+	grant, err = s.applyRoleGrant(req.Token.InstanceId, grant, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return grant.ToAPI(), nil
+}
+
+func (s *userManagementServer) RejectRoleGrant(ctx context.Context, req *api.RoleGrantDecisionMsg) (*api.RoleGrant, error) {
+	grant, err := s.getPendingRoleGrant(req)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err = s.userDBservice.UpdateRoleGrantStatus(req.Token.InstanceId, req.GrantId, models.ROLE_GRANT_STATUS_PENDING, models.ROLE_GRANT_STATUS_REJECTED, req.Token.Id)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "grant not pending")
+	}
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRoleGrantRejected, grant.AccountID+"("+grant.UserID+") + "+grant.Role)
+	return grant.ToAPI(), nil
+}
+
+// requestRoleGrant checks the role and gives it to the user or, if the role requires approval, creates a pending grant
+// (fails if allowPending is not set)
+func (s *userManagementServer) requestRoleGrant(req *api.RoleMsg, allowPending bool) (models.RoleGrant, models.User, error) {
+	instanceID := req.Token.InstanceId
+	if req.ExpiresAt != 0 && req.ExpiresAt < time.Now().Unix() {
+		return models.RoleGrant{}, models.User{}, status.Error(codes.InvalidArgument, "expiration time in the past")
+	}
+
+	defs, err := s.globalDBService.GetRoleDefinitions(instanceID)
+	if err != nil {
+		return models.RoleGrant{}, models.User{}, status.Error(codes.Internal, err.Error())
+	}
+	if !authz.IsAssignableRole(req.Role, defs) {
+		return models.RoleGrant{}, models.User{}, status.Error(codes.InvalidArgument, "role not assignable")
+	}
+	if !s.canAssignRole(req.Token, req.Role) {
+		return models.RoleGrant{}, models.User{}, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	requiresApproval := authz.RequiresApproval(req.Role, defs)
+	if requiresApproval && !allowPending {
+		return models.RoleGrant{}, models.User{}, status.Error(codes.FailedPrecondition, "role requires approval")
+	}
+
+	user, err := s.userDBservice.GetUserByAccountID(instanceID, req.AccountId)
+	if err != nil {
+		return models.RoleGrant{}, user, status.Error(codes.Internal, err.Error())
+	}
+	// with a time limit or scopes, an existing role can be granted again to limit or extend it
+	if user.HasRole(req.Role) && req.ExpiresAt == 0 && len(req.Scopes) == 0 {
+		return models.RoleGrant{}, user, status.Error(codes.InvalidArgument, "role already added")
+	}
+
+	grant := models.RoleGrant{
+		UserID:      user.ID.Hex(),
+		AccountID:   user.Account.AccountID,
+		Role:        req.Role,
+		Status:      models.ROLE_GRANT_STATUS_PENDING,
+		RequestedBy: req.Token.Id,
+		RequestedAt: time.Now().Unix(),
+		ExpiresAt:   req.ExpiresAt,
+		Scopes:      req.Scopes,
+	}
+	if !requiresApproval {
+		grant, err = s.applyRoleGrant(instanceID, grant, "")
+		if err != nil {
+			return grant, user, status.Error(codes.Internal, err.Error())
+		}
+		user, err = s.userDBservice.GetUserByID(instanceID, grant.UserID)
+		if err != nil {
+			return grant, user, status.Error(codes.Internal, err.Error())
+		}
+		return grant, user, nil
+	}
+
+	pending, err := s.userDBservice.FindRoleGrants(instanceID, grant.UserID, grant.Role, models.ROLE_GRANT_STATUS_PENDING)
+	if err != nil {
+		return grant, user, status.Error(codes.Internal, err.Error())
+	}
+	if len(pending) > 0 {
+		return grant, user, status.Error(codes.InvalidArgument, "grant already pending")
+	}
+	grant, err = s.userDBservice.AddRoleGrant(instanceID, grant)
+	if err != nil {
+		return grant, user, status.Error(codes.Internal, err.Error())
+	}
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRoleGrantRequested, grant.AccountID+"("+grant.UserID+") + "+grant.Role)
+	return grant, user, nil
+}
+
+// problems of roles given to a new account
+var (
+	errRoleNotAssignable    = errors.New("role not assignable")
+	errRoleNotAllowed       = errors.New("permission denied to assign role")
+	errRoleRequiresApproval = errors.New("role requires approval, grant it with GrantRole")
+)
+
+// checkRolesOfNewUser checks the roles an account is created with by the token's user. Roles that require approval
+// can't be given this way, so that creating an account doesn't bypass the approval of a second admin.
+func (s *userManagementServer) checkRolesOfNewUser(t *api_types.TokenInfos, roles []string, defs []models.RoleDefinition) error {
+	for _, role := range roles {
+		if !authz.IsAssignableRole(role, defs) {
+			return fmt.Errorf("%w: %s", errRoleNotAssignable, role)
+		}
+		if !s.canAssignRole(t, role) {
+			return fmt.Errorf("%w: %s", errRoleNotAllowed, role)
+		}
+		if authz.RequiresApproval(role, defs) {
+			return fmt.Errorf("%w: %s", errRoleRequiresApproval, role)
+		}
+	}
+	return nil
+}
+
+// roleErrorStatus converts a problem of checkRolesOfNewUser to the status returned by the endpoints
+func roleErrorStatus(err error) error {
+	if errors.Is(err, errRoleNotAssignable) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.PermissionDenied, err.Error())
+}
+
+// applyRoleGrant approves the grant (stored if new) and adds the role to the user. Previous grants of the role are
+// replaced, so that their time limit doesn't apply anymore. Scopes of the grant replace the ones of the role.
+func (s *userManagementServer) applyRoleGrant(instanceID string, grant models.RoleGrant, approvedBy string) (models.RoleGrant, error) {
+	if err := s.userDBservice.EndRoleGrants(instanceID, grant.UserID, grant.Role, models.ROLE_GRANT_STATUS_REVOKED); err != nil {
+		return grant, err
+	}
+
+	var err error
+	if grant.ID.IsZero() {
+		grant.Status = models.ROLE_GRANT_STATUS_APPROVED
+		grant, err = s.userDBservice.AddRoleGrant(instanceID, grant)
+	} else {
+		grant, err = s.userDBservice.UpdateRoleGrantStatus(instanceID, grant.ID.Hex(), models.ROLE_GRANT_STATUS_PENDING, models.ROLE_GRANT_STATUS_APPROVED, approvedBy)
+	}
+	if err != nil {
+		return grant, err
+	}
+
+	user, err := s.userDBservice.GetUserByID(instanceID, grant.UserID)
+	if err != nil {
+		return grant, err
+	}
+	changed := false
+	if !user.HasRole(grant.Role) {
+		if err := user.AddRole(grant.Role); err != nil {
+			return grant, err
+		}
+		changed = true
+	}
+	if len(grant.Scopes) > 0 {
+		if user.RoleScopes == nil {
+			user.RoleScopes = map[string][]string{}
+		}
+		user.RoleScopes[grant.Role] = grant.Scopes
+		changed = true
+	}
+	if changed {
+		if _, err := s.userDBservice.UpdateUser(instanceID, user); err != nil {
+			return grant, err
+		}
+	}
+
+	by := grant.RequestedBy
+	if approvedBy != "" {
+		by = approvedBy
+	}
+	s.SaveLogEvent(instanceID, by, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, user.Account.AccountID+"("+user.ID.Hex()+") + "+grant.Role)
+	return grant, nil
+}
+
+// getPendingRoleGrant checks a decision about a grant
+func (s *userManagementServer) getPendingRoleGrant(req *api.RoleGrantDecisionMsg) (models.RoleGrant, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.GrantId == "" {
+		return models.RoleGrant{}, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
+		return models.RoleGrant{}, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	grant, err := s.userDBservice.GetRoleGrant(req.Token.InstanceId, req.GrantId)
+	if err != nil {
+		return grant, status.Error(codes.NotFound, "grant not found")
+	}
+	if grant.Status != models.ROLE_GRANT_STATUS_PENDING {
+		return grant, status.Error(codes.FailedPrecondition, "grant not pending")
+	}
+	if !s.canAssignRole(req.Token, grant.Role) {
+		return grant, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return grant, nil
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestRoleGrantEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{Type: "email", AccountID: "test_role_grant_1@test.com"},
+			Roles:   []string{"PARTICIPANT"},
+		},
+		{
+			Account: models.Account{Type: "email", AccountID: "test_role_grant_2@test.com"},
+			Roles:   []string{"PARTICIPANT"},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	adminToken := func(id string) *api_types.TokenInfos {
+		return &api_types.TokenInfos{
+			Id:         id,
+			InstanceId: testInstanceID,
+			Payload: map[string]string{
+				"roles": "PARTICIPANT,ADMIN",
+			},
+		}
+	}
+
+	t.Run("grant super admin role as admin", func(t *testing.T) {
+		_, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "SUPER_ADMIN",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	var grantID string
+	t.Run("grant role requiring approval", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		grant, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "ADMIN",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if grant.Status != models.ROLE_GRANT_STATUS_PENDING || grant.RequestedBy != "admin_1" {
+			t.Errorf("unexpected grant: %v", grant)
+		}
+		grantID = grant.Id
+
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if user.HasRole("ADMIN") {
+			t.Error("role should not be added before approval")
+		}
+	})
+
+	t.Run("request again", func(t *testing.T) {
+		_, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "ADMIN",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "grant already pending")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("approve own request", func(t *testing.T) {
+		_, err := s.ApproveRoleGrant(context.Background(), &api.RoleGrantDecisionMsg{Token: adminToken("admin_1"), GrantId: grantID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "grant has to be approved by another admin")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("list pending grants", func(t *testing.T) {
+		resp, err := s.GetRoleGrants(context.Background(), &api.GetRoleGrantsReq{Token: adminToken("admin_2"), Status: models.ROLE_GRANT_STATUS_PENDING})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(resp.Grants) != 1 || resp.Grants[0].Id != grantID {
+			t.Errorf("unexpected grants: %v", resp.Grants)
+		}
+	})
+
+	t.Run("approve by second admin", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		grant, err := s.ApproveRoleGrant(context.Background(), &api.RoleGrantDecisionMsg{Token: adminToken("admin_2"), GrantId: grantID})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if grant.Status != models.ROLE_GRANT_STATUS_APPROVED || grant.DecidedBy != "admin_2" {
+			t.Errorf("unexpected grant: %v", grant)
+		}
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if !user.HasRole("ADMIN") {
+			t.Error("role should be added")
+		}
+	})
+
+	t.Run("decide twice", func(t *testing.T) {
+		_, err := s.RejectRoleGrant(context.Background(), &api.RoleGrantDecisionMsg{Token: adminToken("admin_2"), GrantId: grantID})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "grant not pending")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		grant, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[1].Account.AccountID,
+			Role:      "ADMIN",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		grant, err = s.RejectRoleGrant(context.Background(), &api.RoleGrantDecisionMsg{Token: adminToken("admin_2"), GrantId: grant.Id})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if grant.Status != models.ROLE_GRANT_STATUS_REJECTED {
+			t.Errorf("unexpected grant: %v", grant)
+		}
+	})
+
+	t.Run("time limited role without approval", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		expiresAt := time.Now().Unix() + 3600
+		grant, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[1].Account.AccountID,
+			Role:      "RESEARCHER",
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if grant.Status != models.ROLE_GRANT_STATUS_APPROVED || grant.ExpiresAt != expiresAt {
+			t.Errorf("unexpected grant: %v", grant)
+		}
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[1].ID.Hex())
+		if !user.HasRole("RESEARCHER") {
+			t.Error("role should be added")
+		}
+	})
+
+	t.Run("expiration in the past", func(t *testing.T) {
+		_, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken("admin_1"),
+			AccountId: testUsers[1].Account.AccountID,
+			Role:      "RESEARCHER",
+			ExpiresAt: time.Now().Unix() - 10,
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "expiration time in the past")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) AddRoleScope(ctx context.Context, req *api.RoleScopeMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.Role == "" || req.Scope == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.userDBservice.GetUserByAccountID(req.Token.InstanceId, req.AccountId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err := user.AddRoleScope(req.Role, req.Scope); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err = s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRoleScopeAdded, user.Account.AccountID+"("+user.ID.Hex()+") "+req.Role+" + "+req.Scope)
+	return user.ToAPI(), nil
+}
+
+func (s *userManagementServer) RemoveRoleScope(ctx context.Context, req *api.RoleScopeMsg) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.Role == "" || req.Scope == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.userDBservice.GetUserByAccountID(req.Token.InstanceId, req.AccountId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err := user.RemoveRoleScope(req.Role, req.Scope); err == models.ErrLastRoleScope {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	user, err = s.userDBservice.UpdateUser(req.Token.InstanceId, user)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, logEventRoleScopeRemoved, user.Account.AccountID+"("+user.ID.Hex()+") "+req.Role+" - "+req.Scope)
+	return user.ToAPI(), nil
+}
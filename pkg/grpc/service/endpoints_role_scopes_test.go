@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestRoleScopeEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{Type: "email", AccountID: "test_role_scope_1@test.com"},
+			Roles:   []string{"PARTICIPANT", "RESEARCHER"},
+		},
+		{
+			Account: models.Account{Type: "email", AccountID: "test_role_scope_2@test.com"},
+			Roles:   []string{"PARTICIPANT"},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("missing scope", func(t *testing.T) {
+		_, err := s.AddRoleScope(context.Background(), &api.RoleScopeMsg{
+			Token:     adminToken,
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "RESEARCHER",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("user without role", func(t *testing.T) {
+		_, err := s.AddRoleScope(context.Background(), &api.RoleScopeMsg{
+			Token:     adminToken,
+			AccountId: testUsers[1].Account.AccountID,
+			Role:      "RESEARCHER",
+			Scope:     "study1",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role not found")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("add scopes", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		for _, scope := range []string{"study1", "study2"} {
+			_, err := s.AddRoleScope(context.Background(), &api.RoleScopeMsg{
+				Token:     adminToken,
+				AccountId: testUsers[0].Account.AccountID,
+				Role:      "RESEARCHER",
+				Scope:     scope,
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+		}
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		scopes := user.ScopesForRoles([]string{"PARTICIPANT", "RESEARCHER"})
+		if len(scopes) != 1 || len(scopes["RESEARCHER"]) != 2 {
+			t.Errorf("unexpected scopes: %v", scopes)
+		}
+	})
+
+	t.Run("add scope twice", func(t *testing.T) {
+		_, err := s.AddRoleScope(context.Background(), &api.RoleScopeMsg{
+			Token:     adminToken,
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "RESEARCHER",
+			Scope:     "study1",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "scope already added")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("remove scope", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		user, err := s.RemoveRoleScope(context.Background(), &api.RoleScopeMsg{
+			Token:     adminToken,
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "RESEARCHER",
+			Scope:     "study1",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(user.RoleScopes) != 1 || len(user.RoleScopes[0].Scopes) != 1 || user.RoleScopes[0].Scopes[0] != "study2" {
+			t.Errorf("unexpected scopes: %v", user.RoleScopes)
+		}
+	})
+
+	t.Run("remove last scope", func(t *testing.T) {
+		_, err := s.RemoveRoleScope(context.Background(), &api.RoleScopeMsg{
+			Token:     adminToken,
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "RESEARCHER",
+			Scope:     "study2",
+		})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "remove the role instead")
+		if !ok {
+			t.Error(msg)
+		}
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if scopes := user.RoleScopes["RESEARCHER"]; len(scopes) != 1 || scopes[0] != "study2" {
+			t.Errorf("unexpected scopes: %v", user.RoleScopes)
+		}
+	})
+
+	t.Run("grant role with scopes", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		grant, err := s.GrantRole(context.Background(), &api.RoleMsg{
+			Token:     adminToken,
+			AccountId: testUsers[1].Account.AccountID,
+			Role:      "RESEARCHER",
+			Scopes:    []string{"study3"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(grant.Scopes) != 1 {
+			t.Errorf("unexpected grant: %v", grant)
+		}
+		user, _ := testUserDBService.GetUserByID(testInstanceID, testUsers[1].ID.Hex())
+		if !user.HasRole("RESEARCHER") || len(user.RoleScopes["RESEARCHER"]) != 1 || user.RoleScopes["RESEARCHER"][0] != "study3" {
+			t.Errorf("unexpected user: %v", user)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/influenzanet/go-utils/pkg/constants"
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) CreateServiceAccount(ctx context.Context, req *api.CreateServiceAccountReq) (*api.User, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_CREATE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	// keeps service accounts apart from the email accounts, which can log in with password or reset it
+	if strings.Contains(req.Name, "@") {
+		return nil, status.Error(codes.InvalidArgument, "name must not be an email address")
+	}
+
+	now := time.Now().Unix()
+	newUser := models.User{
+		Account: models.Account{
+			Type:               models.ACCOUNT_TYPE_SERVICE,
+			AccountID:          req.Name,
+			AccountConfirmedAt: now,
+			PreferredLanguage:  req.PreferredLanguage,
+		},
+		Roles: []string{constants.USER_ROLE_SERVICE_ACCOUNT},
+		Timestamps: models.Timestamps{
+			CreatedAt: now,
+		},
+	}
+
+	instanceID := req.Token.InstanceId
+	id, err := s.userDBservice.AddUser(instanceID, newUser)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	newUser.ID, _ = primitive.ObjectIDFromHex(id)
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_LOG, logEventServiceAccountCreated, newUser.ID.Hex()+" - "+newUser.Account.AccountID)
+	return newUser.ToAPI(), nil
+}
+
+func (s *userManagementServer) CreateAPIKey(ctx context.Context, req *api.CreateAPIKeyReq) (*api.CreateAPIKeyResponse, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_UPDATE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	for _, p := range req.Scopes {
+		if !authz.IsKnownPermission(p) {
+			return nil, status.Error(codes.InvalidArgument, "unknown permission: "+p)
+		}
+	}
+	now := time.Now().Unix()
+	if req.ExpiresAt != 0 && req.ExpiresAt < now {
+		return nil, status.Error(codes.InvalidArgument, "expiration time in the past")
+	}
+
+	instanceID := req.Token.InstanceId
+	if _, err := s.getServiceAccount(instanceID, req.UserId); err != nil {
+		return nil, err
+	}
+
+	key, hash, err := tokens.GenerateAPIKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	apiKey, err := s.userDBservice.AddAPIKey(instanceID, models.APIKey{
+		UserID:    req.UserId,
+		Name:      req.Name,
+		KeyHash:   hash,
+		Scopes:    req.Scopes,
+		CreatedBy: req.Token.Id,
+		CreatedAt: now,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(instanceID, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventAPIKeyCreated, req.UserId+" - "+req.Name)
+	return &api.CreateAPIKeyResponse{
+		ApiKey: apiKey.ToAPI(),
+		Key:    key,
+	}, nil
+}
+
+func (s *userManagementServer) GetAPIKeys(ctx context.Context, req *api.GetAPIKeysReq) (*api.APIKeyList, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_READ) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	keys, err := s.userDBservice.FindAPIKeys(req.Token.InstanceId, req.UserId, req.IncludeRevoked)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &api.APIKeyList{
+		ApiKeys: make([]*api.APIKey, len(keys)),
+	}
+	for i, k := range keys {
+		resp.ApiKeys[i] = k.ToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) RevokeAPIKey(ctx context.Context, req *api.APIKeyMsg) (*api.APIKey, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.UserId == "" || req.KeyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_UPDATE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	key, err := s.userDBservice.RevokeAPIKey(req.Token.InstanceId, req.UserId, req.KeyId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "key not found")
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_SECURITY, logEventAPIKeyRevoked, req.UserId+" - "+key.Name)
+	return key.ToAPI(), nil
+}
+
+// LoginWithAPIKey exchanges the key of a service account for an access token. There is no refresh token, the key is
+// used again once the token expired.
+func (s *userManagementServer) LoginWithAPIKey(ctx context.Context, req *api.LoginWithAPIKeyMsg) (*api.TokenResponse, error) {
+	if req == nil || req.InstanceId == "" || req.ApiKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument(s)")
+	}
+	instanceID := req.InstanceId
+	if err := s.checkInstanceActive(instanceID); err != nil {
+		return nil, err
+	}
+
+	key, err := s.userDBservice.FindAPIKeyByHash(instanceID, tokens.HashSecret(req.ApiKey))
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid api key")
+	}
+	if !key.IsActive(time.Now().Unix()) {
+		s.SaveLogEvent(instanceID, key.UserID, loggingAPI.LogEventType_SECURITY, logEventAPIKeyRejected, "revoked or expired key: "+key.Name)
+		return nil, status.Error(codes.PermissionDenied, "invalid api key")
+	}
+	user, err := s.getServiceAccount(instanceID, key.UserID)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, "invalid api key")
+	}
+
+	permissions, err := s.permissionsForRoles(instanceID, user.Roles)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	tokenLifetime := s.instanceConfig(instanceID).Intervals.TokenExpiryInterval
+	token, err := tokens.GenerateNewToken(
+		user.ID.Hex(),
+		true,
+		"",
+		user.Roles,
+		authz.RestrictPermissions(permissions, key.Scopes),
+		user.ScopesForRoles(user.Roles),
+		instanceID,
+		tokenLifetime,
+		user.Account.AccountID,
+		nil,
+		[]string{},
+	)
+	if err != nil {
+		log.Printf("LoginWithAPIKey: unexpected error during token generation -> %v", err)
+		return nil, status.Error(codes.Internal, "token generation error")
+	}
+
+	if err := s.userDBservice.SaveAPIKeyUsed(instanceID, key.ID); err != nil {
+		log.Printf("LoginWithAPIKey: %v", err)
+	}
+	s.SaveLogEvent(instanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, constants.LOG_EVENT_LOGIN_SUCCESS, "api key: "+key.Name)
+
+	return &api.TokenResponse{
+		AccessToken:       token,
+		ExpiresIn:         int32(tokenLifetime / time.Minute),
+		PreferredLanguage: user.Account.PreferredLanguage,
+	}, nil
+}
+
+func (s *userManagementServer) getServiceAccount(instanceID string, userID string) (models.User, error) {
+	user, err := s.userDBservice.GetUserByID(instanceID, userID)
+	if err != nil {
+		return user, status.Error(codes.NotFound, "user not found")
+	}
+	if user.Account.Type != models.ACCOUNT_TYPE_SERVICE {
+		return user, status.Error(codes.FailedPrecondition, "not a service account")
+	}
+	return user, nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+)
+
+func TestServiceAccountEndpoints(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Minute * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			LoggingService: mockLoggingClient,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("email as name", func(t *testing.T) {
+		_, err := s.CreateServiceAccount(context.Background(), &api.CreateServiceAccountReq{Token: adminToken, Name: "exporter@test.com"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "name must not be an email address")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	var accountID string
+	t.Run("create service account", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		user, err := s.CreateServiceAccount(context.Background(), &api.CreateServiceAccountReq{Token: adminToken, Name: "test-exporter"})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if user.Account.Type != models.ACCOUNT_TYPE_SERVICE || len(user.Roles) != 1 || user.Roles[0] != "SERVICE" {
+			t.Errorf("unexpected user: %v", user)
+		}
+		accountID = user.Id
+	})
+
+	t.Run("key with unknown scope", func(t *testing.T) {
+		_, err := s.CreateAPIKey(context.Background(), &api.CreateAPIKeyReq{Token: adminToken, UserId: accountID, Name: "main", Scopes: []string{"users.everything"}})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown permission: users.everything")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	var key string
+	var keyID string
+	t.Run("create key", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		resp, err := s.CreateAPIKey(context.Background(), &api.CreateAPIKeyReq{Token: adminToken, UserId: accountID, Name: "main", Scopes: []string{authz.PERMISSION_USERS_READ}})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.Key == "" || resp.ApiKey.Name != "main" {
+			t.Errorf("unexpected response: %v", resp)
+		}
+		key = resp.Key
+		keyID = resp.ApiKey.Id
+	})
+
+	t.Run("login with wrong key", func(t *testing.T) {
+		_, err := s.LoginWithAPIKey(context.Background(), &api.LoginWithAPIKeyMsg{InstanceId: testInstanceID, ApiKey: key + "x"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid api key")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("login with key", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		resp, err := s.LoginWithAPIKey(context.Background(), &api.LoginWithAPIKeyMsg{InstanceId: testInstanceID, ApiKey: key})
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if resp.AccessToken == "" || resp.RefreshToken != "" {
+			t.Errorf("unexpected response: %v", resp)
+			return
+		}
+		infos, err := s.ValidateJWT(context.Background(), &api.JWTRequest{Token: resp.AccessToken})
+		if err != nil || infos.Id != accountID {
+			t.Errorf("unexpected token infos: %v, %v", infos, err)
+			return
+		}
+		// SERVICE has no role definition, so the key's scope grants nothing either
+		if p, ok := infos.Payload["permissions"]; !ok || p != "" {
+			t.Errorf("unexpected permissions: %v", infos.Payload)
+		}
+
+		keys, err := s.GetAPIKeys(context.Background(), &api.GetAPIKeysReq{Token: adminToken, UserId: accountID})
+		if err != nil || len(keys.ApiKeys) != 1 || keys.ApiKeys[0].LastUsedAt == 0 {
+			t.Errorf("unexpected keys: %v, %v", keys, err)
+		}
+	})
+
+	t.Run("revoke key", func(t *testing.T) {
+		mockLoggingClient.EXPECT().SaveLogEvent(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+
+		revoked, err := s.RevokeAPIKey(context.Background(), &api.APIKeyMsg{Token: adminToken, UserId: accountID, KeyId: keyID})
+		if err != nil || revoked.RevokedAt == 0 {
+			t.Errorf("unexpected result: %v, %v", revoked, err)
+			return
+		}
+		_, err = s.LoginWithAPIKey(context.Background(), &api.LoginWithAPIKeyMsg{InstanceId: testInstanceID, ApiKey: key})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid api key")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+}
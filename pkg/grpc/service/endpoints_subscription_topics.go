@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
+	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+	"github.com/influenzanet/user-management-service/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *userManagementServer) GetSubscriptionTopics(ctx context.Context, req *api.GetSubscriptionTopicsReq) (*api.SubscriptionTopicList, error) {
+	if req == nil || req.InstanceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+
+	topics, err := s.globalDBService.GetSubscriptionTopics(req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &api.SubscriptionTopicList{
+		Topics: make([]*api.SubscriptionTopic, len(topics)),
+	}
+	for i, t := range topics {
+		resp.Topics[i] = t.ToAPI()
+	}
+	return resp, nil
+}
+
+func (s *userManagementServer) SaveSubscriptionTopic(ctx context.Context, req *api.SubscriptionTopicMsg) (*api.SubscriptionTopic, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Topic == nil || req.Topic.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_TOPICS_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if isBuiltInSubscriptionTopic(req.Topic.Key) {
+		return nil, status.Error(codes.InvalidArgument, "topic key reserved")
+	}
+
+	topic := models.SubscriptionTopicFromAPI(req.Topic)
+	topic.InstanceID = req.Token.InstanceId
+	topic, err := s.globalDBService.SaveSubscriptionTopic(topic)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return topic.ToAPI(), nil
+}
+
+func (s *userManagementServer) RemoveSubscriptionTopic(ctx context.Context, req *api.SubscriptionTopicMsg) (*api.ServiceStatus, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) || req.Topic == nil || req.Topic.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_TOPICS_MANAGE) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	if isBuiltInSubscriptionTopic(req.Topic.Key) {
+		return nil, status.Error(codes.InvalidArgument, "topic key reserved")
+	}
+
+	if err := s.globalDBService.DeleteSubscriptionTopic(req.Token.InstanceId, req.Topic.Key); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "topic removed",
+	}, nil
+}
+
+func isBuiltInSubscriptionTopic(key string) bool {
+	return key == models.SUBSCRIPTION_TOPIC_NEWSLETTER || key == models.SUBSCRIPTION_TOPIC_WEEKLY
+}
+
+func (s *userManagementServer) isSubscriptionTopicDefined(instanceID string, key string) (bool, error) {
+	if isBuiltInSubscriptionTopic(key) {
+		return true, nil
+	}
+	topics, err := s.globalDBService.GetSubscriptionTopics(instanceID)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range topics {
+		if t.Key == key {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// mergeContactPreferences applies the requested preferences onto the stored ones, so that consent
+// timestamps are set by the server. Topic subscriptions of the request take precedence over the legacy flags.
+// Returns the topics for which a double opt-in confirmation has to be sent.
+func (s *userManagementServer) mergeContactPreferences(instanceID string, user models.User, req models.ContactPreferences) (models.ContactPreferences, []string, error) {
+	prefs := user.ContactPreferences
+	prefs.ReceiveWeeklyMessageDayOfWeek = req.ReceiveWeeklyMessageDayOfWeek
+	prefs.SendVerificationCodeTo = req.SendVerificationCodeTo
+
+	pendingTopics := []string{}
+	applyChange := func(topic string, subscribed bool, keepPending bool, sendTo []string) {
+		switch {
+		case !subscribed && !keepPending && prefs.IsSubscriptionPending(topic):
+			// withdraw before confirmation
+			prefs.SetSubscription(topic, false, sendTo)
+		case subscribed == prefs.IsSubscribedTo(topic):
+			prefs.SetSubscriptionChannel(topic, sendTo)
+		case subscribed && s.requiresDoubleOptIn(instanceID, topic):
+			if prefs.RequestSubscription(topic, sendTo, subscriptionConfirmationResendCooldown) {
+				pendingTopics = append(pendingTopics, topic)
+			}
+		default:
+			prefs.SetSubscription(topic, subscribed, sendTo)
+		}
+	}
+
+	sendNewsletterTo := req.SendNewsletterTo
+	if sendNewsletterTo == nil {
+		sendNewsletterTo = []string{}
+	}
+	inRequest := map[string]bool{}
+	for _, sub := range req.Subscriptions {
+		inRequest[sub.Topic] = true
+	}
+	// legacy flags can't express a pending state, so they never cancel one
+	if !inRequest[models.SUBSCRIPTION_TOPIC_NEWSLETTER] {
+		applyChange(models.SUBSCRIPTION_TOPIC_NEWSLETTER, req.SubscribedToNewsletter, true, sendNewsletterTo)
+	}
+	if !inRequest[models.SUBSCRIPTION_TOPIC_WEEKLY] {
+		applyChange(models.SUBSCRIPTION_TOPIC_WEEKLY, req.SubscribedToWeekly, true, nil)
+	}
+
+	for _, sub := range req.Subscriptions {
+		known, err := s.isSubscriptionTopicDefined(instanceID, sub.Topic)
+		if err != nil {
+			return prefs, pendingTopics, status.Error(codes.Internal, err.Error())
+		}
+		if !known {
+			return prefs, pendingTopics, status.Error(codes.InvalidArgument, "unknown subscription topic: "+sub.Topic)
+		}
+		for _, ref := range sub.SendTo {
+			if _, found := user.FindContactInfoById(ref); !found {
+				return prefs, pendingTopics, status.Error(codes.InvalidArgument, "contact not found")
+			}
+		}
+		applyChange(sub.Topic, sub.Subscribed, sub.Pending, sub.SendTo)
+	}
+	return prefs, pendingTopics, nil
+}
+
+// requiresDoubleOptIn checks if subscribing to the topic has to be confirmed through an email link
+func (s *userManagementServer) requiresDoubleOptIn(instanceID string, topic string) bool {
+	if topic != models.SUBSCRIPTION_TOPIC_NEWSLETTER {
+		return false
+	}
+	for _, id := range s.doubleOptInInstances {
+		if id == instanceID {
+			return true
+		}
+	}
+	return false
+}
+
+// sendSubscriptionConfirmation creates a confirmation token for a pending subscription and sends it by email
+func (s *userManagementServer) sendSubscriptionConfirmation(instanceID string, user models.User, topic string) error {
+	tempToken, err := s.globalDBService.AddTempToken(models.TempToken{
+		UserID:     user.ID.Hex(),
+		InstanceID: instanceID,
+		Purpose:    models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION,
+		Info: map[string]string{
+			models.TEMPTOKEN_INFO_KEY_TOPIC: topic,
+		},
+		Expiration: tokens.GetExpirationTime(time.Second * subscriptionConfirmationLifetime),
+	})
+	if err != nil {
+		return err
+	}
+
+	// ---> Trigger message sending
+	if s.clients == nil || s.clients.MessagingService == nil {
+		return nil
+	}
+	go func(to []string, preferredLang string) {
+		_, err := s.clients.MessagingService.SendInstantEmail(context.TODO(), &messageAPI.SendEmailReq{
+			InstanceId:  instanceID,
+			To:          to,
+			MessageType: models.EMAIL_TYPE_SUBSCRIPTION_CONFIRMATION,
+			ContentInfos: map[string]string{
+				"token": tempToken,
+				"topic": topic,
+			},
+			PreferredLanguage: preferredLang,
+		})
+		if err != nil {
+			log.Printf("sendSubscriptionConfirmation: %s", err.Error())
+		}
+	}(getSubscriptionEmails(user, topic), user.Account.PreferredLanguage)
+	// <---
+	return nil
+}
+
+// getSubscriptionEmails resolves the email addresses selected for a topic, the account ID is used if none
+func getSubscriptionEmails(user models.User, topic string) []string {
+	emails := []string{}
+	for _, sub := range user.ContactPreferences.Subscriptions {
+		if sub.Topic != topic {
+			continue
+		}
+		for _, ref := range sub.SendTo {
+			ci, found := user.FindContactInfoById(ref)
+			if found && ci.Type == models.CONTACT_TYPE_EMAIL {
+				emails = append(emails, ci.Email)
+			}
+		}
+	}
+	if len(emails) == 0 {
+		emails = append(emails, user.Account.AccountID)
+	}
+	return emails
+}
+
+func (s *userManagementServer) ConfirmSubscription(ctx context.Context, req *api.TempToken) (*api.ServiceStatus, error) {
+	if req == nil || req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	tokenInfos, err := s.ValidateTempToken(req.Token, []string{models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION})
+	if err != nil {
+		log.Printf("ConfirmSubscription: %s", err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.userDBservice.GetUserByID(tokenInfos.InstanceID, tokenInfos.UserID)
+	if err != nil {
+		log.Printf("ConfirmSubscription: %s", err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	topic := tokenInfos.Info[models.TEMPTOKEN_INFO_KEY_TOPIC]
+	if err := user.ContactPreferences.ConfirmSubscription(topic); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = s.userDBservice.UpdateContactPreferences(tokenInfos.InstanceID, user.ID.Hex(), user.ContactPreferences)
+	if err != nil {
+		log.Printf("ConfirmSubscription: %s", err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.globalDBService.DeleteTempToken(req.Token); err != nil {
+		log.Printf("ConfirmSubscription: %s", err.Error())
+	}
+
+	s.SaveLogEvent(tokenInfos.InstanceID, user.ID.Hex(), loggingAPI.LogEventType_LOG, logEventSubscriptionConfirmed, topic)
+	return &api.ServiceStatus{
+		Status: api.ServiceStatus_NORMAL,
+		Msg:    "subscription confirmed",
+	}, nil
+}
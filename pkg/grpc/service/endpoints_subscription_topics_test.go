@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
+	messageMock "github.com/influenzanet/user-management-service/test/mocks/messaging_service"
+)
+
+func TestSaveSubscriptionTopicEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "admin-id",
+		InstanceId: testInstanceID,
+		Payload:    map[string]string{"roles": "PARTICIPANT,ADMIN"},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.SaveSubscriptionTopic(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without admin role", func(t *testing.T) {
+		req := &api.SubscriptionTopicMsg{
+			Token: &api_types.TokenInfos{
+				Id:         "user-id",
+				InstanceId: testInstanceID,
+				Payload:    map[string]string{"roles": "PARTICIPANT"},
+			},
+			Topic: &api.SubscriptionTopic{Key: "seasonal-kick-off"},
+		}
+		_, err := s.SaveSubscriptionTopic(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with built-in topic", func(t *testing.T) {
+		req := &api.SubscriptionTopicMsg{
+			Token: adminToken,
+			Topic: &api.SubscriptionTopic{Key: models.SUBSCRIPTION_TOPIC_NEWSLETTER},
+		}
+		_, err := s.SaveSubscriptionTopic(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "topic key reserved")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with new topic", func(t *testing.T) {
+		req := &api.SubscriptionTopicMsg{
+			Token: adminToken,
+			Topic: &api.SubscriptionTopic{Key: "seasonal-kick-off", Description: "start of the season"},
+		}
+		resp, err := s.SaveSubscriptionTopic(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Key != "seasonal-kick-off" {
+			t.Errorf("unexpected response: %s", resp)
+		}
+
+		topics, err := s.GetSubscriptionTopics(context.Background(), &api.GetSubscriptionTopicsReq{InstanceId: testInstanceID})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		found := false
+		for _, topic := range topics.Topics {
+			if topic.Key == "seasonal-kick-off" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("topic not listed: %s", topics)
+		}
+	})
+
+	t.Run("remove topic", func(t *testing.T) {
+		req := &api.SubscriptionTopicMsg{
+			Token: adminToken,
+			Topic: &api.SubscriptionTopic{Key: "seasonal-kick-off"},
+		}
+		_, err := s.RemoveSubscriptionTopic(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		_, err = s.RemoveSubscriptionTopic(context.Background(), req)
+		if err == nil {
+			t.Error("should fail for missing topic")
+		}
+	})
+}
+
+func TestConfirmSubscriptionEndpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockMessagingClient := messageMock.NewMockMessagingServiceApiClient(mockCtrl)
+	mockLoggingClient := loggingMock.NewMockLoggingServiceApiClient(mockCtrl)
+
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+		clients: &models.APIClients{
+			MessagingService: mockMessagingClient,
+			LoggingService:   mockLoggingClient,
+		},
+		doubleOptInInstances: []string{testInstanceID},
+	}
+
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_confirm_subscription@test.com",
+			},
+		},
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_confirm_subscription_cooldown@test.com",
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	token := &api_types.TokenInfos{
+		Id:         testUsers[0].ID.Hex(),
+		InstanceId: testInstanceID,
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.ConfirmSubscription(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with wrong token", func(t *testing.T) {
+		_, err := s.ConfirmSubscription(context.Background(), &api.TempToken{Token: "wrong"})
+		ok, msg := shouldHaveGrpcErrorStatus(err, "wrong token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("subscription stays pending until confirmed", func(t *testing.T) {
+		mockMessagingClient.EXPECT().SendInstantEmail(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		resp, err := s.UpdateContactPreferences(context.Background(), &api.ContactPreferencesMsg{
+			Token: token,
+			ContactPreferences: &api.ContactPreferences{
+				SubscribedToNewsletter: true,
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.ContactPreferences.SubscribedToNewsletter {
+			t.Error("subscription should not be active yet")
+		}
+
+		tokens, err := s.globalDBService.GetTempTokenForUser(testInstanceID, testUsers[0].ID.Hex(), models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION)
+		if err != nil || len(tokens) != 1 {
+			t.Errorf("confirmation token not found: %v", err)
+			return
+		}
+
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err = s.ConfirmSubscription(context.Background(), &api.TempToken{Token: tokens[0].Token})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		user, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !user.ContactPreferences.SubscribedToNewsletter {
+			t.Error("subscription should be active")
+		}
+		if len(user.ContactPreferences.Subscriptions) < 1 || user.ContactPreferences.Subscriptions[0].ConfirmedAt <= 0 {
+			t.Errorf("confirmation time should be stored: %v", user.ContactPreferences)
+		}
+	})
+
+	t.Run("unchanged confirmed subscription stays confirmed", func(t *testing.T) {
+		before, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil || len(before.ContactPreferences.Subscriptions) < 1 {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		// no SendInstantEmail expected
+		resp, err := s.UpdateContactPreferences(context.Background(), &api.ContactPreferencesMsg{
+			Token: token,
+			ContactPreferences: &api.ContactPreferences{
+				SubscribedToNewsletter: true,
+				Subscriptions: []*api.TopicSubscription{
+					{Topic: models.SUBSCRIPTION_TOPIC_NEWSLETTER, Subscribed: true},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !resp.ContactPreferences.SubscribedToNewsletter {
+			t.Error("subscription should stay active")
+		}
+
+		user, err := s.userDBservice.GetUserByID(testInstanceID, testUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		sub := user.ContactPreferences.Subscriptions[0]
+		if sub.Pending || sub.ConfirmedAt != before.ContactPreferences.Subscriptions[0].ConfirmedAt {
+			t.Errorf("subscription should stay confirmed: %v", sub)
+		}
+
+		tokens, err := s.globalDBService.GetTempTokenForUser(testInstanceID, testUsers[0].ID.Hex(), models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION)
+		if err != nil || len(tokens) != 0 {
+			t.Errorf("no new confirmation token expected: %d, %v", len(tokens), err)
+		}
+	})
+
+	t.Run("confirmation not sent again within cooldown", func(t *testing.T) {
+		otherToken := &api_types.TokenInfos{
+			Id:         testUsers[1].ID.Hex(),
+			InstanceId: testInstanceID,
+		}
+		// expected only once for both requests
+		mockMessagingClient.EXPECT().SendInstantEmail(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+
+		for i := 0; i < 2; i++ {
+			_, err := s.UpdateContactPreferences(context.Background(), &api.ContactPreferencesMsg{
+				Token: otherToken,
+				ContactPreferences: &api.ContactPreferences{
+					SubscribedToNewsletter: true,
+				},
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+		}
+
+		tokens, err := s.globalDBService.GetTempTokenForUser(testInstanceID, testUsers[1].ID.Hex(), models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION)
+		if err != nil || len(tokens) != 1 {
+			t.Errorf("expected one confirmation token: %d, %v", len(tokens), err)
+		}
+	})
+}
+
+func TestUpdateContactPreferencesWithTopics(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	subscribedAt := time.Now().Unix() - 3600
+	testUsers, err := addTestUsers([]models.User{
+		{
+			Account: models.Account{
+				Type:      "email",
+				AccountID: "test_for_topics_only@test.com",
+			},
+			ContactPreferences: models.ContactPreferences{
+				SubscribedToNewsletter: true,
+				SubscribedToWeekly:     true,
+				Subscriptions: []models.TopicSubscription{
+					{Topic: models.SUBSCRIPTION_TOPIC_NEWSLETTER, Subscribed: true, SubscribedAt: subscribedAt},
+					{Topic: models.SUBSCRIPTION_TOPIC_WEEKLY, Subscribed: true, SubscribedAt: subscribedAt},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	t.Run("topics only keep consent timestamps", func(t *testing.T) {
+		resp, err := s.UpdateContactPreferences(context.Background(), &api.ContactPreferencesMsg{
+			Token: &api_types.TokenInfos{
+				Id:         testUsers[0].ID.Hex(),
+				InstanceId: testInstanceID,
+			},
+			ContactPreferences: &api.ContactPreferences{
+				Subscriptions: []*api.TopicSubscription{
+					{Topic: models.SUBSCRIPTION_TOPIC_NEWSLETTER, Subscribed: true},
+					{Topic: models.SUBSCRIPTION_TOPIC_WEEKLY, Subscribed: true},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if !resp.ContactPreferences.SubscribedToNewsletter || !resp.ContactPreferences.SubscribedToWeekly {
+			t.Errorf("subscriptions should stay active: %v", resp.ContactPreferences)
+		}
+		for _, sub := range resp.ContactPreferences.Subscriptions {
+			if sub.SubscribedAt != subscribedAt || sub.UnsubscribedAt != 0 {
+				t.Errorf("consent timestamps changed: %v", sub)
+			}
+		}
+	})
+}
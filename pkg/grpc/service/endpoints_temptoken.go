@@ -5,6 +5,7 @@ import (
 	"time"
 
 	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/tokens"
@@ -118,3 +119,68 @@ func (s *userManagementServer) PurgeUserTempTokens(ctx context.Context, t *api_t
 		Version: apiVersion,
 	}, nil
 }
+
+// GetOrCreateUnsubscribeTokens returns an unsubscribe token for the topic for each listed user, used by the messaging service for bulk messages
+func (s *userManagementServer) GetOrCreateUnsubscribeTokens(ctx context.Context, req *api.UnsubscribeTokensReq) (*api.UnsubscribeTokens, error) {
+	if req == nil || req.InstanceId == "" || req.Topic == "" || len(req.UserIds) < 1 {
+		return nil, status.Error(codes.InvalidArgument, "missing argument")
+	}
+	known, err := s.isSubscriptionTopicDefined(req.InstanceId, req.Topic)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !known {
+		return nil, status.Error(codes.InvalidArgument, "unknown subscription topic: "+req.Topic)
+	}
+	go s.CleanExpiredTemptokens(3600)
+
+	// reuse tokens only if they stay valid long enough for the links in the message
+	minExpiration := time.Now().Unix() + unsubscribeTokenLifetime/2
+	existing, err := s.globalDBService.GetTempTokensForUsers(req.InstanceId, constants.TOKEN_PURPOSE_UNSUBSCRIBE_NEWSLETTER, req.UserIds, minExpiration)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &api.UnsubscribeTokens{
+		Tokens: map[string]string{},
+	}
+	for _, t := range existing {
+		if getUnsubscribeTopic(t) == req.Topic {
+			resp.Tokens[t.UserID] = t.Token
+		}
+	}
+
+	newTokens := []models.TempToken{}
+	for _, userID := range req.UserIds {
+		if _, ok := resp.Tokens[userID]; ok {
+			continue
+		}
+		resp.Tokens[userID] = ""
+		newTokens = append(newTokens, models.TempToken{
+			UserID:     userID,
+			InstanceID: req.InstanceId,
+			Purpose:    constants.TOKEN_PURPOSE_UNSUBSCRIBE_NEWSLETTER,
+			Info: map[string]string{
+				models.TEMPTOKEN_INFO_KEY_TOPIC: req.Topic,
+			},
+			Expiration: tokens.GetExpirationTime(time.Second * unsubscribeTokenLifetime),
+		})
+	}
+
+	tokenStrings, err := s.globalDBService.AddTempTokens(newTokens)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for i, t := range newTokens {
+		resp.Tokens[t.UserID] = tokenStrings[i]
+	}
+	return resp, nil
+}
+
+// getUnsubscribeTopic returns the topic of an unsubscribe token, tokens without topic info are for the newsletter
+func getUnsubscribeTopic(t models.TempToken) string {
+	if topic, ok := t.Info[models.TEMPTOKEN_INFO_KEY_TOPIC]; ok && topic != "" {
+		return topic
+	}
+	return models.SUBSCRIPTION_TOPIC_NEWSLETTER
+}
@@ -409,3 +409,95 @@ func TestPurgeUserTempTokensEndpoint(t *testing.T) {
 		}
 	})
 }
+
+func TestGetOrCreateUnsubscribeTokensEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.GetOrCreateUnsubscribeTokens(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing argument")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown topic", func(t *testing.T) {
+		req := &api.UnsubscribeTokensReq{
+			InstanceId: testInstanceID,
+			Topic:      "not-defined",
+			UserIds:    []string{"unsub_user_1"},
+		}
+		_, err := s.GetOrCreateUnsubscribeTokens(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown subscription topic: not-defined")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	var firstTokens map[string]string
+	t.Run("create new tokens", func(t *testing.T) {
+		req := &api.UnsubscribeTokensReq{
+			InstanceId: testInstanceID,
+			Topic:      models.SUBSCRIPTION_TOPIC_WEEKLY,
+			UserIds:    []string{"unsub_user_1", "unsub_user_2", "unsub_user_2"},
+		}
+		resp, err := s.GetOrCreateUnsubscribeTokens(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Tokens) != 2 || resp.Tokens["unsub_user_1"] == "" || resp.Tokens["unsub_user_2"] == "" {
+			t.Errorf("unexpected tokens: %v", resp.Tokens)
+			return
+		}
+		firstTokens = resp.Tokens
+
+		tokenInfos, err := s.ValidateTempToken(resp.Tokens["unsub_user_1"], nil)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if tokenInfos.Info["topic"] != models.SUBSCRIPTION_TOPIC_WEEKLY {
+			t.Errorf("unexpected token infos: %v", tokenInfos)
+		}
+	})
+
+	t.Run("reuse existing tokens", func(t *testing.T) {
+		req := &api.UnsubscribeTokensReq{
+			InstanceId: testInstanceID,
+			Topic:      models.SUBSCRIPTION_TOPIC_WEEKLY,
+			UserIds:    []string{"unsub_user_1", "unsub_user_3"},
+		}
+		resp, err := s.GetOrCreateUnsubscribeTokens(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Tokens["unsub_user_1"] != firstTokens["unsub_user_1"] || resp.Tokens["unsub_user_3"] == "" {
+			t.Errorf("unexpected tokens: %v", resp.Tokens)
+		}
+	})
+
+	t.Run("other topic gets other token", func(t *testing.T) {
+		req := &api.UnsubscribeTokensReq{
+			InstanceId: testInstanceID,
+			Topic:      models.SUBSCRIPTION_TOPIC_NEWSLETTER,
+			UserIds:    []string{"unsub_user_1"},
+		}
+		resp, err := s.GetOrCreateUnsubscribeTokens(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.Tokens["unsub_user_1"] == firstTokens["unsub_user_1"] {
+			t.Error("token should be topic specific")
+		}
+	})
+}
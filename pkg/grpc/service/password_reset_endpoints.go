@@ -23,7 +23,10 @@ func (s *userManagementServer) InitiatePasswordReset(ctx context.Context, req *a
 	}
 
 	if req.InstanceId == "" {
-		req.InstanceId = "default"
+		req.InstanceId = defaultInstanceID
+	}
+	if err := s.checkInstanceActive(req.InstanceId); err != nil {
+		return nil, err
 	}
 	req.AccountId = utils.SanitizeEmail(req.AccountId)
 
@@ -125,7 +128,7 @@ func (s *userManagementServer) ResetPassword(ctx context.Context, req *api.Reset
 		return nil, status.Error(codes.InvalidArgument, "wrong token")
 	}
 
-	if !utils.CheckPasswordFormat(req.NewPassword) {
+	if !s.checkPassword(tokenInfos.InstanceID, req.NewPassword) {
 		return nil, status.Error(codes.InvalidArgument, "password too weak")
 	}
 
@@ -146,12 +149,24 @@ func (s *userManagementServer) ResetPassword(ctx context.Context, req *api.Reset
 	}
 
 	if tokenInfos.Purpose == constants.TOKEN_PURPOSE_INVITATION {
+		if err := s.userDBservice.SaveInvitationAccepted(tokenInfos.InstanceID, tokenInfos.UserID); err != nil {
+			log.Printf("unexpected error when saving invitation acceptance: %v", err)
+		}
 		newContactPrefs := user.ContactPreferences
-		newContactPrefs.SubscribedToNewsletter = true
-		newContactPrefs.SubscribedToWeekly = true
-		_, err = s.userDBservice.UpdateContactPreferences(tokenInfos.InstanceID, tokenInfos.UserID, newContactPrefs)
+		sendConfirmation := false
+		if s.requiresDoubleOptIn(tokenInfos.InstanceID, models.SUBSCRIPTION_TOPIC_NEWSLETTER) {
+			sendConfirmation = newContactPrefs.RequestSubscription(models.SUBSCRIPTION_TOPIC_NEWSLETTER, nil, subscriptionConfirmationResendCooldown)
+		} else {
+			newContactPrefs.SetSubscription(models.SUBSCRIPTION_TOPIC_NEWSLETTER, true, nil)
+		}
+		newContactPrefs.SetSubscription(models.SUBSCRIPTION_TOPIC_WEEKLY, true, nil)
+		updatedUser, err := s.userDBservice.UpdateContactPreferences(tokenInfos.InstanceID, tokenInfos.UserID, newContactPrefs)
 		if err != nil {
 			log.Printf("unexpected error when updating contact preferences: %v", err)
+		} else if sendConfirmation {
+			if err := s.sendSubscriptionConfirmation(tokenInfos.InstanceID, updatedUser, models.SUBSCRIPTION_TOPIC_NEWSLETTER); err != nil {
+				log.Printf("ResetPassword: %s", err.Error())
+			}
 		}
 	}
 
@@ -227,6 +227,7 @@ func TestResetPasswordEndpoint(t *testing.T) {
 			MessagingService: mockMessagingClient,
 			LoggingService:   mockLoggingClient,
 		},
+		doubleOptInInstances: []string{testInstanceID},
 	}
 
 	testUsers, err := addTestUsers([]models.User{
@@ -344,4 +345,64 @@ func TestResetPasswordEndpoint(t *testing.T) {
 			return
 		}
 	})
+
+	t.Run("accepting invitation keeps newsletter pending", func(t *testing.T) {
+		invitedUsers, err := addTestUsers([]models.User{
+			{
+				Account: models.Account{
+					Type:      "email",
+					AccountID: "test_for_invitation_accept@test.com",
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("failed to create testusers: %s", err.Error())
+			return
+		}
+		invitationToken, err := testGlobalDBService.AddTempToken(models.TempToken{
+			UserID:     invitedUsers[0].ID.Hex(),
+			InstanceID: testInstanceID,
+			Purpose:    constants.TOKEN_PURPOSE_INVITATION,
+			Expiration: tokens.GetExpirationTime(10 * time.Second),
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		// password changed and subscription confirmation
+		mockMessagingClient.EXPECT().SendInstantEmail(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil).Times(2)
+		mockLoggingClient.EXPECT().SaveLogEvent(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, nil)
+		_, err = s.ResetPassword(context.Background(), &api.ResetPasswordMsg{
+			Token:       invitationToken,
+			NewPassword: "tokmefn4n2p3rnp32mne-sd",
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+
+		user, err := s.userDBservice.GetUserByID(testInstanceID, invitedUsers[0].ID.Hex())
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		prefs := user.ContactPreferences
+		if prefs.SubscribedToNewsletter || !prefs.IsSubscriptionPending(models.SUBSCRIPTION_TOPIC_NEWSLETTER) {
+			t.Errorf("newsletter subscription should be pending: %v", prefs)
+		}
+		if !prefs.SubscribedToWeekly {
+			t.Errorf("weekly subscription should be active: %v", prefs)
+		}
+		confirmTokens, err := s.globalDBService.GetTempTokenForUser(testInstanceID, invitedUsers[0].ID.Hex(), models.TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION)
+		if err != nil || len(confirmTokens) != 1 {
+			t.Errorf("confirmation token not found: %v", err)
+		}
+	})
 }
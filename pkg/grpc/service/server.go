@@ -10,6 +10,7 @@ import (
 	"github.com/influenzanet/user-management-service/pkg/api"
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"google.golang.org/grpc"
 )
@@ -20,11 +21,13 @@ const (
 )
 
 type userManagementServer struct {
-	clients           *models.APIClients
-	userDBservice     *userdb.UserDBService
-	globalDBService   *globaldb.GlobalDBService
-	Intervals         models.Intervals
-	newUserCountLimit int64
+	clients              *models.APIClients
+	userDBservice        *userdb.UserDBService
+	globalDBService      *globaldb.GlobalDBService
+	instanceConfigs      *instanceconfig.Provider
+	Intervals            models.Intervals // defaults, used as they are if there is no instance config provider
+	newUserCountLimit    int64
+	doubleOptInInstances []string
 }
 
 // NewUserManagementServer creates a new service instance
@@ -32,16 +35,23 @@ func NewUserManagementServer(
 	clients *models.APIClients,
 	userDBservice *userdb.UserDBService,
 	globalDBservice *globaldb.GlobalDBService,
-	intervals models.Intervals,
-	newUserCountLimit int64,
+	instanceConfigs *instanceconfig.Provider,
+	doubleOptInInstances []string,
 ) api.UserManagementApiServer {
-	return &userManagementServer{
-		clients:           clients,
-		userDBservice:     userDBservice,
-		globalDBService:   globalDBservice,
-		Intervals:         intervals,
-		newUserCountLimit: newUserCountLimit,
+	s := &userManagementServer{
+		clients:              clients,
+		userDBservice:        userDBservice,
+		globalDBService:      globalDBservice,
+		instanceConfigs:      instanceConfigs,
+		doubleOptInInstances: doubleOptInInstances,
 	}
+	// the provider is optional, see instanceConfig
+	if instanceConfigs != nil {
+		defaults := instanceConfigs.Defaults()
+		s.Intervals = defaults.Intervals
+		s.newUserCountLimit = defaults.NewUserCountLimit
+	}
+	return s
 }
 
 // RunServer runs gRPC service to publish ToDo service
@@ -49,8 +59,9 @@ func RunServer(ctx context.Context, port string,
 	clients *models.APIClients,
 	userDBservice *userdb.UserDBService,
 	globalDBservice *globaldb.GlobalDBService,
-	intervals models.Intervals,
-	newUserCountLimit int64,
+	instanceConfigs *instanceconfig.Provider,
+	doubleOptInInstances []string,
+	requireAppToken bool,
 ) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
@@ -58,13 +69,17 @@ func RunServer(ctx context.Context, port string,
 	}
 
 	// register service
-	server := grpc.NewServer()
+	opts := []grpc.ServerOption{}
+	if requireAppToken {
+		opts = append(opts, grpc.UnaryInterceptor(appTokenInterceptor(globalDBservice)))
+	}
+	server := grpc.NewServer(opts...)
 	api.RegisterUserManagementApiServer(server, NewUserManagementServer(
 		clients,
 		userDBservice,
 		globalDBservice,
-		intervals,
-		newUserCountLimit,
+		instanceConfigs,
+		doubleOptInInstances,
 	))
 
 	// graceful shutdown
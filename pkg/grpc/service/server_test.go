@@ -11,6 +11,7 @@ import (
 
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/status"
@@ -148,3 +149,23 @@ func addTestUsers(userDefs []models.User) (users []models.User, err error) {
 	}
 	return
 }
+
+func TestNewUserManagementServer(t *testing.T) {
+	t.Run("without instance config provider", func(t *testing.T) {
+		s := NewUserManagementServer(nil, testUserDBService, testGlobalDBService, nil, nil).(*userManagementServer)
+		if s.instanceConfigs != nil || s.newUserCountLimit != 0 {
+			t.Errorf("unexpected server: %v", s)
+		}
+	})
+
+	t.Run("with instance config provider", func(t *testing.T) {
+		provider := instanceconfig.NewProvider(testGlobalDBService, instanceconfig.Config{
+			Intervals:         models.Intervals{VerificationCodeLifetime: 60},
+			NewUserCountLimit: 10,
+		})
+		s := NewUserManagementServer(nil, testUserDBService, testGlobalDBService, provider, nil).(*userManagementServer)
+		if s.Intervals.VerificationCodeLifetime != 60 || s.newUserCountLimit != 10 {
+			t.Errorf("defaults not applied: %v, %d", s.Intervals, s.newUserCountLimit)
+		}
+	})
+}
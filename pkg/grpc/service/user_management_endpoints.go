@@ -2,15 +2,16 @@ package service
 
 import (
 	"context"
-	"errors"
 	"log"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/influenzanet/go-utils/pkg/constants"
 	loggingAPI "github.com/influenzanet/logging-service/pkg/api"
 	messageAPI "github.com/influenzanet/messaging-service/pkg/api/messaging_service"
 	"github.com/influenzanet/user-management-service/pkg/api"
+	"github.com/influenzanet/user-management-service/pkg/authz"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
 	"github.com/influenzanet/user-management-service/pkg/models"
 	"github.com/influenzanet/user-management-service/pkg/pwhash"
@@ -25,7 +26,7 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.InitialPassword == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing arguments")
 	}
-	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_CREATE) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -33,10 +34,18 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 	if !utils.CheckEmailFormat(req.AccountId) {
 		return nil, status.Error(codes.InvalidArgument, "account id not a valid email")
 	}
-	if !utils.CheckPasswordFormat(req.InitialPassword) {
+	if !s.checkPassword(req.Token.InstanceId, req.InitialPassword) {
 		return nil, status.Error(codes.InvalidArgument, "password too weak")
 	}
 
+	defs, err := s.globalDBService.GetRoleDefinitions(req.Token.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.checkRolesOfNewUser(req.Token, req.Roles, defs); err != nil {
+		return nil, roleErrorStatus(err)
+	}
+
 	password, err := pwhash.HashPassword(req.InitialPassword)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -48,32 +57,55 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 	}
 
 	// Create user DB object from request:
+	newUser := newUserCreatedByAdmin(req.AccountId, password, req.PreferredLanguage, req.Roles, req.ProfileNames, accountCreatedAt, req.AccountConfirmedAt)
+	if req.Use_2Fa {
+		newUser.Account.AuthType = "2FA"
+	}
+
+	instanceID := req.Token.InstanceId
+	id, err := s.userDBservice.AddUser(instanceID, newUser)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	newUser.ID, _ = primitive.ObjectIDFromHex(id)
+
+	if err := s.sendInvitation(ctx, instanceID, inviteeOf(newUser)); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "by admin - "+newUser.ID.Hex()+" - "+newUser.Account.AccountID)
+
+	return newUser.ToAPI(), nil
+}
+
+// newUserCreatedByAdmin prepares a user with an unconfirmed email contact and the given profiles (first one is the main profile, default profile if none)
+func newUserCreatedByAdmin(accountID string, password string, lang string, roles []string, profileNames []string, createdAt int64, confirmedAt int64) models.User {
 	newUser := models.User{
 		Account: models.Account{
 			Type:               "email",
-			AccountID:          req.AccountId,
-			AccountConfirmedAt: req.AccountConfirmedAt,
+			AccountID:          accountID,
+			AccountConfirmedAt: confirmedAt,
 			Password:           password,
-			PreferredLanguage:  req.PreferredLanguage,
+			PreferredLanguage:  lang,
 		},
-		Roles:    req.Roles,
+		Roles:    roles,
 		Profiles: []models.Profile{},
 		Timestamps: models.Timestamps{
-			CreatedAt: accountCreatedAt,
+			CreatedAt: createdAt,
 		},
 	}
 
 	// Init profiles:
-	if len(req.ProfileNames) < 1 {
+	if len(profileNames) < 1 {
 		newUser.Profiles = append(newUser.Profiles, models.Profile{
 			ID:                 primitive.NewObjectID(),
-			Alias:              utils.BlurEmailAddress(req.AccountId),
+			Alias:              utils.BlurEmailAddress(accountID),
 			AvatarID:           "default",
 			ConsentConfirmedAt: time.Now().Unix(),
 			MainProfile:        true,
 		})
 	} else {
-		for i, pn := range req.ProfileNames {
+		for i, pn := range profileNames {
 			newUser.Profiles = append(newUser.Profiles, models.Profile{
 				ID:                 primitive.NewObjectID(),
 				Alias:              pn,
@@ -84,81 +116,81 @@ func (s *userManagementServer) CreateUser(ctx context.Context, req *api.CreateUs
 		}
 	}
 
-	newUser.AddNewEmail(req.AccountId, false)
-	if req.Use_2Fa {
-		newUser.Account.AuthType = "2FA"
-	}
+	newUser.AddNewEmail(accountID, false)
 	newUser.ContactPreferences.SubscribedToNewsletter = false
 	newUser.ContactPreferences.SendNewsletterTo = []string{newUser.ContactInfos[0].ID.Hex()}
 	newUser.ContactPreferences.SubscribedToWeekly = false
 	newUser.ContactPreferences.ReceiveWeeklyMessageDayOfWeek = int32(rand.Intn(7))
+	return newUser
+}
 
-	instanceID := req.Token.InstanceId
-	id, err := s.userDBservice.AddUser(instanceID, newUser)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+// invitee is the part of a user needed to send the invitation
+type invitee struct {
+	UserID            string
+	Email             string
+	PreferredLanguage string
+}
+
+func inviteeOf(user models.User) invitee {
+	return invitee{
+		UserID:            user.ID.Hex(),
+		Email:             user.Account.AccountID,
+		PreferredLanguage: user.Account.PreferredLanguage,
 	}
-	newUser.ID, _ = primitive.ObjectIDFromHex(id)
+}
 
+// sendInvitation creates the invitation token and sends the invitation email
+func (s *userManagementServer) sendInvitation(ctx context.Context, instanceID string, user invitee) error {
 	// TempToken for contact verification:
 	tempTokenInfos := models.TempToken{
-		UserID:     id,
+		UserID:     user.UserID,
 		InstanceID: instanceID,
 		Purpose:    constants.TOKEN_PURPOSE_INVITATION,
 		Info: map[string]string{
 			"type":  "email",
-			"email": newUser.Account.AccountID,
+			"email": user.Email,
 		},
-		Expiration: tokens.GetExpirationTime(time.Hour * 24 * 7),
+		Expiration: tokens.GetExpirationTime(s.instanceConfig(instanceID).Intervals.InvitationLifetime),
 	}
 	tempToken, err := s.globalDBService.AddTempToken(tempTokenInfos)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return err
+	}
+	if err := s.userDBservice.SaveInvitationSent(instanceID, user.UserID, time.Now().Unix(), tempTokenInfos.Expiration); err != nil {
+		log.Printf("sendInvitation: %s", err.Error())
 	}
 
 	// ---> Trigger message sending
 	_, err = s.clients.MessagingService.SendInstantEmail(ctx, &messageAPI.SendEmailReq{
 		InstanceId:  instanceID,
-		To:          []string{newUser.Account.AccountID},
+		To:          []string{user.Email},
 		MessageType: constants.EMAIL_TYPE_INVITATION,
 		ContentInfos: map[string]string{
 			"token": tempToken,
 		},
-		PreferredLanguage: newUser.Account.PreferredLanguage,
+		PreferredLanguage: user.PreferredLanguage,
 		UseLowPrio:        true,
 	})
 	if err != nil {
-		log.Printf("CreateUser: %s", err.Error())
+		log.Printf("sendInvitation: %s", err.Error())
 	}
 	// <---
-
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_CREATED, "by admin - "+newUser.ID.Hex()+" - "+newUser.Account.AccountID)
-
-	return newUser.ToAPI(), nil
+	return nil
 }
 
 func (s *userManagementServer) AddRoleForUser(ctx context.Context, req *api.RoleMsg) (*api.User, error) {
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.Role == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing arguments")
 	}
-	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
-	user, err := s.userDBservice.GetUserByAccountID(req.Token.InstanceId, req.AccountId)
+	// roles that require approval have to be requested with GrantRole
+	_, user, err := s.requestRoleGrant(req, false)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	if err := user.AddRole(req.Role); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	user, err = s.userDBservice.UpdateUser(req.Token.InstanceId, user)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_ADDED, user.Account.AccountID+"("+user.ID.Hex()+") + "+req.Role)
-
 	return user.ToAPI(), nil
 }
 
@@ -166,7 +198,7 @@ func (s *userManagementServer) RemoveRoleForUser(ctx context.Context, req *api.R
 	if req == nil || utils.IsTokenEmpty(req.Token) || req.AccountId == "" || req.Role == "" {
 		return nil, status.Error(codes.InvalidArgument, "missing arguments")
 	}
-	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+	if !s.authorize(req.Token, authz.PERMISSION_ROLES_ASSIGN) || !s.canAssignRole(req.Token, req.Role) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 	user, err := s.userDBservice.GetUserByAccountID(req.Token.InstanceId, req.AccountId)
@@ -180,6 +212,9 @@ func (s *userManagementServer) RemoveRoleForUser(ctx context.Context, req *api.R
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err := s.userDBservice.EndRoleGrants(req.Token.InstanceId, user.ID.Hex(), req.Role, models.ROLE_GRANT_STATUS_REVOKED); err != nil {
+		log.Printf("RemoveRoleForUser: %v", err)
+	}
 
 	s.SaveLogEvent(req.Token.InstanceId, req.Token.Id, loggingAPI.LogEventType_LOG, constants.LOG_EVENT_ACCOUNT_ROLE_REMOVED, user.Account.AccountID+"("+user.ID.Hex()+") - "+req.Role)
 	return user.ToAPI(), nil
@@ -189,7 +224,7 @@ func (s *userManagementServer) FindNonParticipantUsers(ctx context.Context, req
 	if req == nil || utils.IsTokenEmpty(req.Token) {
 		return nil, status.Error(codes.InvalidArgument, "missing arguments")
 	}
-	if !utils.CheckRoleInToken(req.Token, constants.USER_ROLE_ADMIN) {
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_READ) {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
 
@@ -206,40 +241,178 @@ func (s *userManagementServer) FindNonParticipantUsers(ctx context.Context, req
 	return &resp, nil
 }
 
+func (s *userManagementServer) SearchUsers(ctx context.Context, req *api.SearchUsersReq) (*api.SearchUsersResponse, error) {
+	if req == nil || utils.IsTokenEmpty(req.Token) {
+		return nil, status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	if !s.authorize(req.Token, authz.PERMISSION_USERS_READ) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	query := userdb.UserSearchQuery{
+		AccountIDPrefix: strings.ToLower(strings.TrimSpace(req.AccountIdPrefix)),
+		Roles:           req.Roles,
+		CreatedAfter:    req.CreatedAfter,
+		CreatedBefore:   req.CreatedBefore,
+		LastLoginAfter:  req.LastLoginAfter,
+		LastLoginBefore: req.LastLoginBefore,
+		SortBy:          req.SortBy,
+		SortDescending:  req.SortDescending,
+		PageSize:        int64(req.PageSize),
+		Cursor:          req.Cursor,
+	}
+	switch req.ConfirmationState {
+	case "":
+	case "confirmed":
+		query.OnlyConfirmed = true
+	case "unconfirmed":
+		query.OnlyUnconfirmed = true
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown confirmation state")
+	}
+	if _, ok := userdb.SearchSortFields[query.SortBy]; query.SortBy != "" && !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown sort field")
+	}
+	if query.PageSize < 1 {
+		query.PageSize = defaultSearchPageSize
+	} else if query.PageSize > maximumSearchPageSize {
+		query.PageSize = maximumSearchPageSize
+	}
+
+	users, totalCount, nextCursor, err := s.userDBservice.SearchUsers(req.Token.InstanceId, query)
+	if err == userdb.ErrInvalidCursor {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &api.SearchUsersResponse{
+		Users:      make([]*api.User, len(users)),
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
+	}
+	for i, u := range users {
+		resp.Users[i] = u.ToAPI()
+	}
+	return resp, nil
+}
+
 func (s *userManagementServer) StreamUsers(req *api.StreamUsersMsg, stream api.UserManagementApi_StreamUsersServer) error {
 	if req == nil || stream == nil || req.InstanceId == "" {
 		return status.Error(codes.InvalidArgument, "missing arguments")
 	}
+	query, err := parseStreamUsersQuery(req)
+	if err != nil {
+		return err
+	}
 
-	sendUserOverGrpc := func(instanceID string, user models.User, args ...interface{}) error {
-		if len(args) != 1 {
-			return errors.New("StreamUsers callback: unexpected number of args")
-		}
-		stream, ok := args[0].(api.UserManagementApi_StreamUsersServer)
-		if !ok {
-			return errors.New(("StreamUsers callback: can't parse stream"))
-		}
+	err = s.userDBservice.PerfomActionForUserBatches(req.InstanceId, query.filter, query.fields, query.startAfter, query.batchSize,
+		func(instanceID string, users []models.User, lastID primitive.ObjectID) error {
+			for _, u := range prepareStreamedUsers(req, users) {
+				if err := stream.Send(u); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
 
-		if err := stream.Send(user.ToAPI()); err != nil {
-			return err
-		}
-		return nil
+func (s *userManagementServer) StreamUserBatches(req *api.StreamUsersMsg, stream api.UserManagementApi_StreamUserBatchesServer) error {
+	if req == nil || stream == nil || req.InstanceId == "" {
+		return status.Error(codes.InvalidArgument, "missing arguments")
+	}
+	query, err := parseStreamUsersQuery(req)
+	if err != nil {
+		return err
+	}
+
+	err = s.userDBservice.PerfomActionForUserBatches(req.InstanceId, query.filter, query.fields, query.startAfter, query.batchSize,
+		func(instanceID string, users []models.User, lastID primitive.ObjectID) error {
+			// the resume token also covers users skipped by the delivery filter or not decodable
+			return stream.Send(&api.UserBatch{
+				Users:       prepareStreamedUsers(req, users),
+				ResumeToken: lastID.Hex(),
+			})
+		},
+	)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
 	}
+	return nil
+}
 
-	filter := userdb.UserFilter{
+type streamUsersQuery struct {
+	filter     userdb.UserFilter
+	fields     []string
+	startAfter primitive.ObjectID
+	batchSize  int64
+}
+
+func parseStreamUsersQuery(req *api.StreamUsersMsg) (query streamUsersQuery, err error) {
+	query.filter = userdb.UserFilter{
 		OnlyConfirmed:   false,
 		ReminderWeekDay: -1,
 	}
 	if req.Filters != nil {
-		filter.OnlyConfirmed = req.Filters.OnlyConfirmedAccounts
+		query.filter.OnlyConfirmed = req.Filters.OnlyConfirmedAccounts
 		if req.Filters.UseReminderWeekdayFilter {
-			filter.ReminderWeekDay = req.Filters.ReminderWeekday
+			query.filter.ReminderWeekDay = req.Filters.ReminderWeekday
 		}
+		query.filter.SubscribedToTopic = req.Filters.SubscribedToTopic
+		query.filter.SubscribedToAnyTopic = req.Filters.SubscribedToAnyTopic
+		query.filter.Roles = req.Filters.Roles
+		query.filter.PreferredLanguages = req.Filters.PreferredLanguages
+		query.filter.AccountType = req.Filters.AccountType
+		query.filter.CreatedAfter = req.Filters.CreatedAfter
+		query.filter.CreatedBefore = req.Filters.CreatedBefore
+		query.filter.LastLoginAfter = req.Filters.LastLoginAfter
+		query.filter.LastLoginBefore = req.Filters.LastLoginBefore
+		query.filter.MinProfileCount = req.Filters.MinProfileCount
+		query.filter.MaxProfileCount = req.Filters.MaxProfileCount
 	}
 
-	err := s.userDBservice.PerfomActionForUsers(req.InstanceId, filter, sendUserOverGrpc, stream)
-	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+	query.fields = []string{}
+	for _, f := range req.Fields {
+		if _, ok := userdb.UserProjectionFields[f]; !ok {
+			return query, status.Error(codes.InvalidArgument, "unknown field: "+f)
+		}
+		query.fields = append(query.fields, f)
 	}
-	return nil
+	if len(query.fields) > 0 && req.Filters != nil && req.Filters.OnlyDeliverableContacts {
+		// needed to check the delivery status
+		query.fields = append(query.fields, "account", "contact_infos")
+	}
+
+	if req.StartAfter != "" {
+		query.startAfter, err = primitive.ObjectIDFromHex(req.StartAfter)
+		if err != nil {
+			return query, status.Error(codes.InvalidArgument, "invalid resume token")
+		}
+	}
+
+	query.batchSize = int64(req.BatchSize)
+	if query.batchSize < 1 {
+		query.batchSize = defaultStreamBatchSize
+	} else if query.batchSize > maximumStreamBatchSize {
+		query.batchSize = maximumStreamBatchSize
+	}
+	return query, nil
+}
+
+func prepareStreamedUsers(req *api.StreamUsersMsg, users []models.User) []*api.User {
+	result := []*api.User{}
+	for _, u := range users {
+		if req.Filters != nil && req.Filters.OnlyDeliverableContacts {
+			if !u.RemoveUndeliverableContactInfos() {
+				continue
+			}
+		}
+		result = append(result, u.ToAPI())
+	}
+	return result
 }
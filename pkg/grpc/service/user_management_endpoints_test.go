@@ -11,6 +11,7 @@ import (
 	"github.com/influenzanet/user-management-service/pkg/models"
 	loggingMock "github.com/influenzanet/user-management-service/test/mocks/logging_service"
 	messageMock "github.com/influenzanet/user-management-service/test/mocks/messaging_service"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc"
 )
 
@@ -69,6 +70,69 @@ func TestCreateUserEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("with role requiring approval", func(t *testing.T) {
+		req := &api.CreateUserReq{
+			Token: &api_types.TokenInfos{
+				Id:         "testuserid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			AccountId:       "test_created_admin@email.test",
+			InitialPassword: "initPW543",
+			Roles:           []string{"PARTICIPANT", "ADMIN"},
+		}
+		_, err := s.CreateUser(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role requires approval, grant it with GrantRole: ADMIN")
+		if !ok {
+			t.Error(msg)
+		}
+		if _, err := s.userDBservice.GetUserByAccountID(testInstanceID, req.AccountId); err == nil {
+			t.Error("user should not be created")
+		}
+	})
+
+	t.Run("with super admin role", func(t *testing.T) {
+		req := &api.CreateUserReq{
+			Token: &api_types.TokenInfos{
+				Id:         "testuserid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			AccountId:       "test_created_admin@email.test",
+			InitialPassword: "initPW543",
+			Roles:           []string{"SUPER_ADMIN"},
+		}
+		_, err := s.CreateUser(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied to assign role: SUPER_ADMIN")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown role", func(t *testing.T) {
+		req := &api.CreateUserReq{
+			Token: &api_types.TokenInfos{
+				Id:         "testuserid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			AccountId:       "test_created_admin@email.test",
+			InitialPassword: "initPW543",
+			Roles:           []string{"NOT_DEFINED"},
+		}
+		_, err := s.CreateUser(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role not assignable: NOT_DEFINED")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
 	t.Run("with valid arguments", func(t *testing.T) {
 		mockMessagingClient.EXPECT().SendInstantEmail(
 			gomock.Any(),
@@ -90,7 +154,7 @@ func TestCreateUserEndpoint(t *testing.T) {
 			AccountId:         "test_created_user@email.test",
 			InitialPassword:   "initPW543",
 			PreferredLanguage: "en",
-			Roles:             []string{"PARTICIPANT", "ADMIN"},
+			Roles:             []string{"PARTICIPANT", "RESEARCHER"},
 			ProfileNames:      []string{"main", "secondary"},
 		}
 		resp, err := s.CreateUser(context.Background(), req)
@@ -196,6 +260,44 @@ func TestAddRoleForUserEndpoint(t *testing.T) {
 		}
 	})
 
+	t.Run("with role requiring approval", func(t *testing.T) {
+		req := &api.RoleMsg{
+			Token: &api_types.TokenInfos{
+				Id:         "testuserid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "ADMIN",
+		}
+		_, err := s.AddRoleForUser(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role requires approval")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown role", func(t *testing.T) {
+		req := &api.RoleMsg{
+			Token: &api_types.TokenInfos{
+				Id:         "testuserid",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT,ADMIN",
+				},
+			},
+			AccountId: testUsers[0].Account.AccountID,
+			Role:      "SUPERUSER",
+		}
+		_, err := s.AddRoleForUser(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "role not assignable")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
 	t.Run("with valid arguments", func(t *testing.T) {
 		mockLoggingClient.EXPECT().SaveLogEvent(
 			gomock.Any(),
@@ -211,14 +313,14 @@ func TestAddRoleForUserEndpoint(t *testing.T) {
 				},
 			},
 			AccountId: testUsers[0].Account.AccountID,
-			Role:      "ADMIN",
+			Role:      "RESEARCHER",
 		}
 		resp, err := s.AddRoleForUser(context.Background(), req)
 		if err != nil {
 			t.Errorf("unexpected error: %s", err.Error())
 			return
 		}
-		if len(resp.Roles) != 2 || resp.Roles[1] != "ADMIN" {
+		if len(resp.Roles) != 2 || resp.Roles[1] != "RESEARCHER" {
 			t.Errorf("unexpected response: %s", resp)
 			return
 		}
@@ -234,7 +336,7 @@ func TestAddRoleForUserEndpoint(t *testing.T) {
 				},
 			},
 			AccountId: testUsers[0].Account.AccountID,
-			Role:      "ADMIN",
+			Role:      "RESEARCHER",
 		}
 		_, err := s.AddRoleForUser(context.Background(), req)
 		ok, msg := shouldHaveGrpcErrorStatus(err, "role already added")
@@ -465,6 +567,121 @@ func (_m *UserManagementServiceAPI_GetUsers) Send(user *api.User) error {
 	return nil
 }
 
+type UserManagementServiceAPI_StreamUserBatches struct {
+	grpc.ServerStream
+	Results []*api.UserBatch
+}
+
+func (_m *UserManagementServiceAPI_StreamUserBatches) Send(batch *api.UserBatch) error {
+	_m.Results = append(_m.Results, batch)
+	return nil
+}
+
+func TestSearchUsersEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	_, err := addTestUsers([]models.User{
+		{Account: models.Account{Type: "email", AccountID: "test_for_searchusers_1@test.com"}, Roles: []string{"PARTICIPANT"}},
+		{Account: models.Account{Type: "email", AccountID: "test_for_searchusers_2@test.com"}, Roles: []string{"PARTICIPANT"}},
+		{Account: models.Account{Type: "email", AccountID: "test_for_searchusers_3@test.com"}, Roles: []string{"PARTICIPANT"}},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	adminToken := &api_types.TokenInfos{
+		Id:         "testadmin",
+		InstanceId: testInstanceID,
+		Payload: map[string]string{
+			"roles": "PARTICIPANT,ADMIN",
+		},
+	}
+
+	t.Run("without payload", func(t *testing.T) {
+		_, err := s.SearchUsers(context.Background(), nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("without admin role", func(t *testing.T) {
+		req := &api.SearchUsersReq{
+			Token: &api_types.TokenInfos{
+				Id:         "testuser",
+				InstanceId: testInstanceID,
+				Payload: map[string]string{
+					"roles": "PARTICIPANT",
+				},
+			},
+		}
+		_, err := s.SearchUsers(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "permission denied")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with unknown sort field", func(t *testing.T) {
+		req := &api.SearchUsersReq{
+			Token:  adminToken,
+			SortBy: "password",
+		}
+		_, err := s.SearchUsers(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown sort field")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid cursor", func(t *testing.T) {
+		req := &api.SearchUsersReq{
+			Token:  adminToken,
+			Cursor: "wrong",
+		}
+		_, err := s.SearchUsers(context.Background(), req)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid cursor")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("page through results", func(t *testing.T) {
+		req := &api.SearchUsersReq{
+			Token:           adminToken,
+			AccountIdPrefix: "Test_For_SearchUsers_",
+			PageSize:        2,
+		}
+		resp, err := s.SearchUsers(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if resp.TotalCount != 3 || len(resp.Users) != 2 || resp.NextCursor == "" {
+			t.Errorf("unexpected response: %s", resp)
+			return
+		}
+
+		req.Cursor = resp.NextCursor
+		resp, err = s.SearchUsers(context.Background(), req)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resp.Users) != 1 || resp.NextCursor != "" {
+			t.Errorf("unexpected response: %s", resp)
+		}
+	})
+}
+
 func TestStreamUsersEndpoint(t *testing.T) {
 	s := userManagementServer{
 		userDBservice:   testUserDBService,
@@ -545,4 +762,186 @@ func TestStreamUsersEndpoint(t *testing.T) {
 			return
 		}
 	})
+
+	t.Run("with only deliverable contacts", func(t *testing.T) {
+		_, err := addTestUsers([]models.User{
+			{
+				Account: models.Account{
+					Type:      "email",
+					AccountID: "test_for_streamusers_bounced@test.com",
+				},
+				ContactInfos: []models.ContactInfo{
+					{
+						ID:             primitive.NewObjectID(),
+						Type:           "email",
+						Email:          "test_for_streamusers_bounced@test.com",
+						DeliveryStatus: &models.DeliveryStatus{Undeliverable: true},
+					},
+				},
+			},
+		})
+		if err != nil {
+			t.Errorf("failed to create testusers: %s", err.Error())
+			return
+		}
+
+		mock := &UserManagementServiceAPI_GetUsers{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			Filters: &api.StreamUsersMsg_Filters{
+				OnlyDeliverableContacts: true,
+			},
+		}
+		err = s.StreamUsers(req, mock)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		for _, u := range mock.Results {
+			if u.Account.AccountId == "test_for_streamusers_bounced@test.com" {
+				t.Error("user with undeliverable address should be skipped")
+			}
+		}
+	})
+
+	t.Run("with unknown field", func(t *testing.T) {
+		mock := &UserManagementServiceAPI_GetUsers{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			Fields:     []string{"password"},
+		}
+		err := s.StreamUsers(req, mock)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "unknown field: password")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with role filter and projection", func(t *testing.T) {
+		mock := &UserManagementServiceAPI_GetUsers{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			Filters: &api.StreamUsersMsg_Filters{
+				Roles: []string{"RESEARCHER"},
+			},
+			Fields: []string{"account"},
+		}
+		err := s.StreamUsers(req, mock)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		found := false
+		for _, u := range mock.Results {
+			if u.Account.AccountId == "test_for_streamusers_2@test.com" {
+				t.Error("user without role should be filtered out")
+			}
+			if u.Account.AccountId == "test_for_streamusers_1@test.com" {
+				found = true
+			}
+			if len(u.Roles) > 0 {
+				t.Errorf("roles should not be loaded: %v", u.Roles)
+			}
+		}
+		if !found {
+			t.Error("user with role not found")
+		}
+	})
+}
+
+func TestStreamUserBatchesEndpoint(t *testing.T) {
+	s := userManagementServer{
+		userDBservice:   testUserDBService,
+		globalDBService: testGlobalDBService,
+		Intervals: models.Intervals{
+			TokenExpiryInterval:      time.Second * 2,
+			VerificationCodeLifetime: 60,
+		},
+	}
+
+	_, err := addTestUsers([]models.User{
+		{Account: models.Account{Type: "email", AccountID: "test_for_streambatches_1@test.com"}, Roles: []string{"STREAMBATCHES"}},
+		{Account: models.Account{Type: "email", AccountID: "test_for_streambatches_2@test.com"}, Roles: []string{"STREAMBATCHES"}},
+		{Account: models.Account{Type: "email", AccountID: "test_for_streambatches_3@test.com"}, Roles: []string{"STREAMBATCHES"}},
+	})
+	if err != nil {
+		t.Errorf("failed to create testusers: %s", err.Error())
+		return
+	}
+
+	filters := &api.StreamUsersMsg_Filters{Roles: []string{"STREAMBATCHES"}}
+
+	t.Run("without payload", func(t *testing.T) {
+		err := s.StreamUserBatches(nil, nil)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "missing arguments")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("with invalid resume token", func(t *testing.T) {
+		mock := &UserManagementServiceAPI_StreamUserBatches{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			StartAfter: "wrong",
+		}
+		err := s.StreamUserBatches(req, mock)
+		ok, msg := shouldHaveGrpcErrorStatus(err, "invalid resume token")
+		if !ok {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("resume after first batch", func(t *testing.T) {
+		mock := &UserManagementServiceAPI_StreamUserBatches{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			Filters:    filters,
+			BatchSize:  2,
+		}
+		err := s.StreamUserBatches(req, mock)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(mock.Results) != 2 || len(mock.Results[0].Users) != 2 || len(mock.Results[1].Users) != 1 {
+			t.Errorf("unexpected batches: %v", mock.Results)
+			return
+		}
+		if mock.Results[0].ResumeToken != mock.Results[0].Users[1].Id {
+			t.Errorf("unexpected resume token: %s", mock.Results[0].ResumeToken)
+		}
+
+		resumed := &UserManagementServiceAPI_StreamUserBatches{}
+		req.StartAfter = mock.Results[0].ResumeToken
+		err = s.StreamUserBatches(req, resumed)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(resumed.Results) != 1 || len(resumed.Results[0].Users) != 1 ||
+			resumed.Results[0].Users[0].Id != mock.Results[1].Users[0].Id {
+			t.Errorf("unexpected batches: %v", resumed.Results)
+		}
+	})
+
+	t.Run("resume single user stream", func(t *testing.T) {
+		mock := &UserManagementServiceAPI_GetUsers{}
+		req := &api.StreamUsersMsg{
+			InstanceId: testInstanceID,
+			Filters:    filters,
+		}
+		err := s.StreamUsers(req, mock)
+		if err != nil || len(mock.Results) != 3 {
+			t.Errorf("unexpected result: %v, %v", mock.Results, err)
+			return
+		}
+
+		resumed := &UserManagementServiceAPI_GetUsers{}
+		req.StartAfter = mock.Results[0].Id
+		err = s.StreamUsers(req, resumed)
+		if err != nil || len(resumed.Results) != 2 || resumed.Results[0].Id != mock.Results[1].Id {
+			t.Errorf("unexpected result: %v, %v", resumed.Results, err)
+		}
+	})
 }
@@ -0,0 +1,143 @@
+// Package instanceconfig resolves the configuration of each instance from the defaults of the service (env) and the
+// settings stored with the instance in the global DB. The settings are cached and reloaded periodically, so that changes
+// apply without restarting the service.
+package instanceconfig
+
+import (
+	"context"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// InstanceSource reads the entries of the instances in use, implemented by the global DB service
+type InstanceSource interface {
+	GetInstances(includeArchived bool) ([]models.Instance, error)
+	GetInstance(instanceID string) (models.Instance, error)
+}
+
+// Config holds the values the service uses for an instance
+type Config struct {
+	Settings                    models.InstanceSettings
+	Intervals                   models.Intervals
+	NewUserCountLimit           int64
+	CleanUpUnverifiedUsersAfter int64 // in seconds
+}
+
+// Resolve applies the settings of an instance on the defaults, zero values keep the default
+func Resolve(defaults Config, settings models.InstanceSettings) Config {
+	conf := defaults
+	conf.Settings = settings
+	if settings.TokenLifetimes.AccessToken > 0 {
+		conf.Intervals.TokenExpiryInterval = time.Duration(settings.TokenLifetimes.AccessToken) * time.Minute
+	}
+	if settings.TokenLifetimes.VerificationCode > 0 {
+		conf.Intervals.VerificationCodeLifetime = settings.TokenLifetimes.VerificationCode
+	}
+	if settings.TokenLifetimes.Invitation > 0 {
+		conf.Intervals.InvitationLifetime = time.Duration(settings.TokenLifetimes.Invitation) * time.Second
+	}
+	if settings.NewUserCountLimit > 0 {
+		conf.NewUserCountLimit = settings.NewUserCountLimit
+	}
+	if settings.CleanUpUnverifiedUsersAfter > 0 {
+		conf.CleanUpUnverifiedUsersAfter = settings.CleanUpUnverifiedUsersAfter
+	}
+	return conf
+}
+
+// Provider returns the config of the instances from its cache, which is replaced on each reload
+type Provider struct {
+	source   InstanceSource
+	defaults Config
+
+	mu       sync.RWMutex
+	settings map[string]models.InstanceSettings
+	loaded   bool // false until the first successful reload
+}
+
+func NewProvider(source InstanceSource, defaults Config) *Provider {
+	return &Provider{
+		source:   source,
+		defaults: defaults,
+		settings: map[string]models.InstanceSettings{},
+	}
+}
+
+// Defaults returns the config of instances without settings
+func (p *Provider) Defaults() Config {
+	return p.defaults
+}
+
+// Get returns the config of the instance, the defaults if it had no entry at the last reload
+func (p *Provider) Get(instanceID string) Config {
+	p.mu.RLock()
+	settings, ok := p.settings[instanceID]
+	p.mu.RUnlock()
+	if !ok {
+		return p.defaults
+	}
+	return Resolve(p.defaults, settings)
+}
+
+// IsActive checks if the instance has an entry that is not archived. Instances missing in the cache are looked up,
+// they might have been created after the last reload. Until the settings could be loaded once, all instances count
+// as active.
+func (p *Provider) IsActive(instanceID string) bool {
+	p.mu.RLock()
+	_, ok := p.settings[instanceID]
+	loaded := p.loaded
+	p.mu.RUnlock()
+	if ok || !loaded {
+		return true
+	}
+	instance, err := p.source.GetInstance(instanceID)
+	return err == nil && instance.ArchivedAt == 0
+}
+
+// Reload reads the settings of all instances again and logs the instances whose settings changed. The previous
+// settings are kept if they can't be read.
+func (p *Provider) Reload() error {
+	instances, err := p.source.GetInstances(false)
+	if err != nil {
+		return err
+	}
+	settings := make(map[string]models.InstanceSettings, len(instances))
+	for _, instance := range instances {
+		settings[instance.InstanceID] = instance.Settings
+	}
+
+	p.mu.Lock()
+	previous := p.settings
+	p.settings = settings
+	p.loaded = true
+	p.mu.Unlock()
+
+	for id, s := range settings {
+		if old, ok := previous[id]; ok && !reflect.DeepEqual(old, s) {
+			log.Printf("settings of instance %s changed", id)
+		}
+	}
+	return nil
+}
+
+// Run reloads the settings in the background every interval until the context is done
+func (p *Provider) Run(ctx context.Context, interval time.Duration) {
+	go p.startReloadThread(ctx, interval)
+}
+
+func (p *Provider) startReloadThread(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-time.After(interval):
+			if err := p.Reload(); err != nil {
+				log.Printf("instance settings could not be reloaded: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package instanceconfig
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+type testSource struct {
+	instances []models.Instance
+	err       error
+}
+
+func (s *testSource) GetInstances(includeArchived bool) ([]models.Instance, error) {
+	return s.instances, s.err
+}
+
+func (s *testSource) GetInstance(instanceID string) (models.Instance, error) {
+	if s.err != nil {
+		return models.Instance{}, s.err
+	}
+	for _, i := range s.instances {
+		if i.InstanceID == instanceID {
+			return i, nil
+		}
+	}
+	return models.Instance{}, errors.New("instance not found")
+}
+
+var testDefaults = Config{
+	Intervals: models.Intervals{
+		TokenExpiryInterval:      55 * time.Minute,
+		VerificationCodeLifetime: 900,
+		InvitationLifetime:       7 * 24 * time.Hour,
+	},
+	NewUserCountLimit:           100,
+	CleanUpUnverifiedUsersAfter: 3600,
+}
+
+func TestResolve(t *testing.T) {
+	t.Run("without settings", func(t *testing.T) {
+		conf := Resolve(testDefaults, models.InstanceSettings{})
+		if conf.Intervals != testDefaults.Intervals || conf.NewUserCountLimit != 100 || conf.CleanUpUnverifiedUsersAfter != 3600 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+	})
+
+	t.Run("with overrides", func(t *testing.T) {
+		conf := Resolve(testDefaults, models.InstanceSettings{
+			TokenLifetimes: models.TokenLifetimes{
+				AccessToken:      10,
+				VerificationCode: 300,
+				Invitation:       3600,
+			},
+			NewUserCountLimit:           5000,
+			CleanUpUnverifiedUsersAfter: 60,
+		})
+		if conf.Intervals.TokenExpiryInterval != 10*time.Minute || conf.Intervals.VerificationCodeLifetime != 300 || conf.Intervals.InvitationLifetime != time.Hour {
+			t.Errorf("unexpected intervals: %v", conf.Intervals)
+		}
+		if conf.NewUserCountLimit != 5000 || conf.CleanUpUnverifiedUsersAfter != 60 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+	})
+}
+
+func TestProvider(t *testing.T) {
+	source := &testSource{instances: []models.Instance{
+		{InstanceID: "small", Settings: models.InstanceSettings{NewUserCountLimit: 10}},
+		{InstanceID: "large"},
+	}}
+	p := NewProvider(source, testDefaults)
+
+	t.Run("before first reload", func(t *testing.T) {
+		if conf := p.Get("small"); conf.NewUserCountLimit != 100 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+		if !p.IsActive("small") || !p.IsActive("unknown") {
+			t.Error("all instances should be active before the first reload")
+		}
+	})
+
+	t.Run("after reload", func(t *testing.T) {
+		if err := p.Reload(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if conf := p.Get("small"); conf.NewUserCountLimit != 10 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+		if conf := p.Get("large"); conf.NewUserCountLimit != 100 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+		if conf := p.Get("unknown"); conf.NewUserCountLimit != 100 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+		if !p.IsActive("large") || p.IsActive("unknown") {
+			t.Error("unexpected active instances")
+		}
+	})
+
+	t.Run("changed settings", func(t *testing.T) {
+		source.instances = []models.Instance{
+			{InstanceID: "small", Settings: models.InstanceSettings{NewUserCountLimit: 20}},
+		}
+		if err := p.Reload(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if conf := p.Get("small"); conf.NewUserCountLimit != 20 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+		if p.IsActive("large") {
+			t.Error("removed instance should not be active")
+		}
+	})
+
+	t.Run("instance created after reload", func(t *testing.T) {
+		source.instances = append(source.instances,
+			models.Instance{InstanceID: "new"},
+			models.Instance{InstanceID: "archived", ArchivedAt: 1},
+		)
+		if !p.IsActive("new") {
+			t.Error("new instance should be active")
+		}
+		if p.IsActive("archived") {
+			t.Error("archived instance should not be active")
+		}
+	})
+
+	t.Run("failed reload keeps settings", func(t *testing.T) {
+		source.err = errors.New("db not reachable")
+		if err := p.Reload(); err == nil {
+			t.Error("error expected")
+		}
+		if conf := p.Get("small"); conf.NewUserCountLimit != 20 {
+			t.Errorf("unexpected config: %v", conf)
+		}
+	})
+}
+
+func TestProviderInitialReloadFails(t *testing.T) {
+	source := &testSource{err: errors.New("db not reachable")}
+	p := NewProvider(source, testDefaults)
+
+	if err := p.Reload(); err == nil {
+		t.Error("error expected")
+	}
+	if !p.IsActive("small") {
+		t.Error("instances should be active until the settings could be loaded")
+	}
+	if conf := p.Get("small"); conf.NewUserCountLimit != 100 {
+		t.Errorf("unexpected config: %v", conf)
+	}
+
+	source.err = nil
+	source.instances = []models.Instance{{InstanceID: "small"}}
+	if err := p.Reload(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !p.IsActive("small") || p.IsActive("unknown") {
+		t.Error("unexpected active instances")
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"errors"
+	"time"
+
 	"github.com/influenzanet/user-management-service/pkg/api"
 )
 
@@ -15,6 +18,9 @@ type Account struct {
 	RefreshTokens      []string         `bson:"refreshTokens"`
 	PreferredLanguage  string           `bson:"preferredLanguage"`
 
+	// Identities of external identity providers that can be used to log in
+	LinkedIdentities []LinkedIdentity `bson:"linkedIdentities,omitempty"`
+
 	// Rate limiting
 	FailedLoginAttempts   []int64 `bson:"failedLoginAttempts"`
 	PasswordResetTriggers []int64 `bson:"passwordResetTriggers"`
@@ -40,12 +46,83 @@ func AccountFromAPI(a *api.User_Account) Account {
 	}
 }
 
+// LinkedIdentity is the user's identity at an external identity provider
+type LinkedIdentity struct {
+	IDP        string `bson:"idp"`
+	Subject    string `bson:"subject"`
+	LinkedAt   int64  `bson:"linkedAt"`
+	LastUsedAt int64  `bson:"lastUsedAt"`
+}
+
+// ToAPI converts the object from DB to API format
+func (li LinkedIdentity) ToAPI() *api.LinkedIdentity {
+	return &api.LinkedIdentity{
+		Idp:        li.IDP,
+		Subject:    li.Subject,
+		LinkedAt:   li.LinkedAt,
+		LastUsedAt: li.LastUsedAt,
+	}
+}
+
 // ToAPI converts the object from DB to API format
 func (a Account) ToAPI() *api.User_Account {
+	identities := make([]*api.LinkedIdentity, len(a.LinkedIdentities))
+	for i, li := range a.LinkedIdentities {
+		identities[i] = li.ToAPI()
+	}
 	return &api.User_Account{
 		Type:               a.Type,
 		AccountId:          a.AccountID,
 		AccountConfirmedAt: a.AccountConfirmedAt,
 		PreferredLanguage:  a.PreferredLanguage,
+		LinkedIdentities:   identities,
+	}
+}
+
+// HasLinkedIdentity checks if the identity of the IdP is linked to the account
+func (a Account) HasLinkedIdentity(idp string, subject string) bool {
+	for _, li := range a.LinkedIdentities {
+		if li.IDP == idp && li.Subject == subject {
+			return true
+		}
+	}
+	return false
+}
+
+// LinkIdentity adds an identity of an external IdP
+func (a *Account) LinkIdentity(idp string, subject string) error {
+	if a.HasLinkedIdentity(idp, subject) {
+		return errors.New("identity already linked")
+	}
+	a.LinkedIdentities = append(a.LinkedIdentities, LinkedIdentity{
+		IDP:      idp,
+		Subject:  subject,
+		LinkedAt: time.Now().Unix(),
+	})
+	return nil
+}
+
+// UnlinkIdentity removes an identity of an external IdP
+func (a *Account) UnlinkIdentity(idp string, subject string) error {
+	identities := []LinkedIdentity{}
+	for _, li := range a.LinkedIdentities {
+		if li.IDP == idp && li.Subject == subject {
+			continue
+		}
+		identities = append(identities, li)
+	}
+	if len(identities) == len(a.LinkedIdentities) {
+		return errors.New("identity not linked")
+	}
+	a.LinkedIdentities = identities
+	return nil
+}
+
+// SetLinkedIdentityUsed updates the last use of the identity
+func (a *Account) SetLinkedIdentityUsed(idp string, subject string) {
+	for i, li := range a.LinkedIdentities {
+		if li.IDP == idp && li.Subject == subject {
+			a.LinkedIdentities[i].LastUsedAt = time.Now().Unix()
+		}
 	}
 }
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// APIKey lets a service account obtain access tokens without password. Only the hash of the key is stored, the key
+// itself is returned once when created.
+type APIKey struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	UserID     string             `bson:"userID"`
+	Name       string             `bson:"name"`
+	KeyHash    string             `bson:"keyHash"`
+	Scopes     []string           `bson:"scopes,omitempty"` // permissions the tokens are limited to, all of the account's if empty
+	CreatedBy  string             `bson:"createdBy"`
+	CreatedAt  int64              `bson:"createdAt"`
+	ExpiresAt  int64              `bson:"expiresAt,omitempty"` // 0 for no limit
+	LastUsedAt int64              `bson:"lastUsedAt,omitempty"`
+	RevokedAt  int64              `bson:"revokedAt,omitempty"`
+}
+
+// IsActive checks if the key can be used at the given time
+func (k APIKey) IsActive(now int64) bool {
+	return k.RevokedAt == 0 && (k.ExpiresAt == 0 || k.ExpiresAt > now)
+}
+
+func (k APIKey) ToAPI() *api.APIKey {
+	return &api.APIKey{
+		Id:         k.ID.Hex(),
+		UserId:     k.UserID,
+		Name:       k.Name,
+		Scopes:     k.Scopes,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
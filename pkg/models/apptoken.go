@@ -1,13 +1,38 @@
 package models
 
 import (
+	"github.com/influenzanet/user-management-service/pkg/api"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 // AppToken is a database entry for a app token
 type AppToken struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
-	AppName   string             `bson:"appName"`
-	Tokens    []string           `bson:"tokens"`
-	Instances []string           `bson:"instances"`
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	AppName    string             `bson:"appName"`
+	Tokens     []string           `bson:"tokens,omitempty"`    // plain tokens of entries added by hand, still accepted until migrated
+	TokenHash  string             `bson:"tokenHash,omitempty"` // only the hash of generated tokens is stored
+	Instances  []string           `bson:"instances"`
+	CreatedAt  int64              `bson:"createdAt,omitempty"`
+	ExpiresAt  int64              `bson:"expiresAt,omitempty"` // 0 for no limit
+	LastUsedAt int64              `bson:"lastUsedAt,omitempty"`
+	RotatedAt  int64              `bson:"rotatedAt,omitempty"`
+	RevokedAt  int64              `bson:"revokedAt,omitempty"`
+}
+
+// IsActive checks if the token can be used at the given time
+func (t AppToken) IsActive(now int64) bool {
+	return t.RevokedAt == 0 && (t.ExpiresAt == 0 || t.ExpiresAt > now)
+}
+
+func (t AppToken) ToAPI() *api.AppToken {
+	return &api.AppToken{
+		Id:         t.ID.Hex(),
+		AppName:    t.AppName,
+		Instances:  t.Instances,
+		CreatedAt:  t.CreatedAt,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		RotatedAt:  t.RotatedAt,
+		RevokedAt:  t.RevokedAt,
+	}
 }
@@ -14,4 +14,5 @@ type DBConfig struct {
 type Intervals struct {
 	TokenExpiryInterval      time.Duration // interpreted in minutes later
 	VerificationCodeLifetime int64         // in seconds
+	InvitationLifetime       time.Duration // validity of invitations sent to users created by an admin
 }
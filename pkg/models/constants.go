@@ -3,4 +3,51 @@ package models
 const (
 	ACCOUNT_TYPE_EMAIL    = "email"
 	ACCOUNT_TYPE_EXTERNAL = "external"
+	ACCOUNT_TYPE_SERVICE  = "service"
+)
+
+const (
+	CONTACT_TYPE_EMAIL = "email"
+	CONTACT_TYPE_PHONE = "phone"
+)
+
+// Built-in subscription topics, mirrored by the legacy contact preference flags
+const (
+	SUBSCRIPTION_TOPIC_NEWSLETTER = "newsletter"
+	SUBSCRIPTION_TOPIC_WEEKLY     = "weekly"
+)
+
+// Keys used in the info map of temp tokens
+const (
+	TEMPTOKEN_INFO_KEY_TOPIC = "topic"
+)
+
+// Token purposes and message types not defined in go-utils
+const (
+	TOKEN_PURPOSE_CONFIRM_SUBSCRIPTION   = "confirm-subscription"
+	EMAIL_TYPE_SUBSCRIPTION_CONFIRMATION = "subscription-confirmation"
+)
+
+// Delivery events reported by the messaging provider
+const (
+	DELIVERY_EVENT_HARD_BOUNCE = "hard-bounce"
+	DELIVERY_EVENT_SOFT_BOUNCE = "soft-bounce"
+	DELIVERY_EVENT_COMPLAINT   = "complaint"
+)
+
+// States of the invitation of a user created by an admin
+const (
+	INVITATION_STATUS_PENDING  = "pending"
+	INVITATION_STATUS_EXPIRED  = "expired"
+	INVITATION_STATUS_ACCEPTED = "accepted"
+	INVITATION_STATUS_REVOKED  = "revoked"
+)
+
+// States of a role grant
+const (
+	ROLE_GRANT_STATUS_PENDING  = "pending"
+	ROLE_GRANT_STATUS_APPROVED = "approved"
+	ROLE_GRANT_STATUS_REJECTED = "rejected"
+	ROLE_GRANT_STATUS_EXPIRED  = "expired"
+	ROLE_GRANT_STATUS_REVOKED  = "revoked"
 )
@@ -15,6 +15,22 @@ type ContactInfo struct {
 	ConfirmationLinkSentAt int64              `bson:"confirmationLinkSentAt"`
 	Email                  string             `bson:"email,omitempty"`
 	Phone                  string             `bson:"phone,omitempty"`
+	VerificationCode       *VerificationCode  `bson:"verificationCode,omitempty"` // used to confirm phone numbers
+	DeliveryStatus         *DeliveryStatus    `bson:"deliveryStatus,omitempty"`
+}
+
+// DeliveryStatus collects problems reported by the messaging provider for an address
+type DeliveryStatus struct {
+	Undeliverable bool   `bson:"undeliverable"`
+	Reason        string `bson:"reason"` // last reported event
+	Details       string `bson:"details,omitempty"`
+	SoftBounces   int32  `bson:"softBounces"`
+	UpdatedAt     int64  `bson:"updatedAt"`
+}
+
+// IsUndeliverable checks if messages to this contact should not be sent anymore
+func (obj ContactInfo) IsUndeliverable() bool {
+	return obj.DeliveryStatus != nil && obj.DeliveryStatus.Undeliverable
 }
 
 func ContactInfoFromAPI(obj *api.ContactInfo) ContactInfo {
@@ -53,5 +69,12 @@ func (obj ContactInfo) ToAPI() *api.ContactInfo {
 	} else if len(obj.Phone) > 0 {
 		res.Address = &api.ContactInfo_Phone{Phone: obj.Phone}
 	}
+	if obj.DeliveryStatus != nil {
+		res.DeliveryStatus = &api.DeliveryStatus{
+			Undeliverable: obj.DeliveryStatus.Undeliverable,
+			Reason:        obj.DeliveryStatus.Reason,
+			UpdatedAt:     obj.DeliveryStatus.UpdatedAt,
+		}
+	}
 	return res
 }
@@ -1,13 +1,32 @@
 package models
 
-import "github.com/influenzanet/user-management-service/pkg/api"
+import (
+	"errors"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+)
 
 // ContactPreferences defines how to reach out to the user for what purpose
 type ContactPreferences struct {
-	SubscribedToNewsletter        bool     `bson:"subscribedToNewsletter"`
-	SendNewsletterTo              []string `bson:"sendNewsletterTo"`
-	SubscribedToWeekly            bool     `bson:"subscribedToWeekly"`
-	ReceiveWeeklyMessageDayOfWeek int32    `bson:"receiveWeeklyMessageDayOfWeek"`
+	SubscribedToNewsletter        bool                `bson:"subscribedToNewsletter"`
+	SendNewsletterTo              []string            `bson:"sendNewsletterTo"`
+	SubscribedToWeekly            bool                `bson:"subscribedToWeekly"`
+	ReceiveWeeklyMessageDayOfWeek int32               `bson:"receiveWeeklyMessageDayOfWeek"`
+	SendVerificationCodeTo        string              `bson:"sendVerificationCodeTo,omitempty"` // contact info id, if empty account id is used
+	Subscriptions                 []TopicSubscription `bson:"subscriptions,omitempty"`
+}
+
+// TopicSubscription stores the user's consent for one subscription topic
+type TopicSubscription struct {
+	Topic              string   `bson:"topic"`
+	Subscribed         bool     `bson:"subscribed"`
+	SendTo             []string `bson:"sendTo,omitempty"` // contact info ids, if empty account id is used
+	SubscribedAt       int64    `bson:"subscribedAt,omitempty"`
+	UnsubscribedAt     int64    `bson:"unsubscribedAt,omitempty"`
+	Pending            bool     `bson:"pending,omitempty"`            // waiting for double opt-in confirmation
+	ConfirmedAt        int64    `bson:"confirmedAt,omitempty"`        // double opt-in confirmation, kept as evidence of consent
+	ConfirmationSentAt int64    `bson:"confirmationSentAt,omitempty"` // last confirmation email, to limit resends
 }
 
 func ContactPreferencesFromAPI(obj *api.ContactPreferences) ContactPreferences {
@@ -20,16 +39,178 @@ func ContactPreferencesFromAPI(obj *api.ContactPreferences) ContactPreferences {
 		SendNewsletterTo:              obj.SendNewsletterTo,
 		SubscribedToWeekly:            obj.SubscribedToWeekly,
 		ReceiveWeeklyMessageDayOfWeek: obj.ReceiveWeeklyMessageDayOfWeek,
+		SendVerificationCodeTo:        obj.SendVerificationCodeTo,
+	}
+	for _, sub := range obj.Subscriptions {
+		res.Subscriptions = append(res.Subscriptions, TopicSubscriptionFromAPI(sub))
 	}
 	return res
 }
 
 // ToAPI converts a person from DB format into the API format
 func (obj ContactPreferences) ToAPI() *api.ContactPreferences {
+	subs := make([]*api.TopicSubscription, len(obj.Subscriptions))
+	for i, sub := range obj.Subscriptions {
+		subs[i] = sub.ToAPI()
+	}
 	return &api.ContactPreferences{
 		SubscribedToNewsletter:        obj.SubscribedToNewsletter,
 		SendNewsletterTo:              obj.SendNewsletterTo,
 		SubscribedToWeekly:            obj.SubscribedToWeekly,
 		ReceiveWeeklyMessageDayOfWeek: obj.ReceiveWeeklyMessageDayOfWeek,
+		SendVerificationCodeTo:        obj.SendVerificationCodeTo,
+		Subscriptions:                 subs,
+	}
+}
+
+func TopicSubscriptionFromAPI(obj *api.TopicSubscription) TopicSubscription {
+	if obj == nil {
+		return TopicSubscription{}
+	}
+	return TopicSubscription{
+		Topic:          obj.Topic,
+		Subscribed:     obj.Subscribed,
+		SendTo:         obj.SendTo,
+		SubscribedAt:   obj.SubscribedAt,
+		UnsubscribedAt: obj.UnsubscribedAt,
+		Pending:        obj.Pending,
+		ConfirmedAt:    obj.ConfirmedAt,
+	}
+}
+
+func (obj TopicSubscription) ToAPI() *api.TopicSubscription {
+	return &api.TopicSubscription{
+		Topic:          obj.Topic,
+		Subscribed:     obj.Subscribed,
+		SendTo:         obj.SendTo,
+		SubscribedAt:   obj.SubscribedAt,
+		UnsubscribedAt: obj.UnsubscribedAt,
+		Pending:        obj.Pending,
+		ConfirmedAt:    obj.ConfirmedAt,
+	}
+}
+
+// IsSubscribedTo checks the opt-in state of a topic, built-in topics fall back to the legacy flags
+func (cp ContactPreferences) IsSubscribedTo(topic string) bool {
+	if i := cp.findSubscription(topic); i >= 0 {
+		return cp.Subscriptions[i].Subscribed
+	}
+	switch topic {
+	case SUBSCRIPTION_TOPIC_NEWSLETTER:
+		return cp.SubscribedToNewsletter
+	case SUBSCRIPTION_TOPIC_WEEKLY:
+		return cp.SubscribedToWeekly
+	}
+	return false
+}
+
+func (cp ContactPreferences) findSubscription(topic string) int {
+	for i, sub := range cp.Subscriptions {
+		if sub.Topic == topic {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsSubscriptionPending checks if the topic waits for a double opt-in confirmation
+func (cp ContactPreferences) IsSubscriptionPending(topic string) bool {
+	i := cp.findSubscription(topic)
+	return i >= 0 && cp.Subscriptions[i].Pending
+}
+
+// SetSubscription updates the opt-in state of a topic and records the time of consent or withdrawal.
+// If sendTo is nil, the current channel is kept. A pending subscription is resolved by this call.
+func (cp *ContactPreferences) SetSubscription(topic string, subscribed bool, sendTo []string) {
+	wasSubscribed := cp.IsSubscribedTo(topic)
+
+	index := cp.findSubscription(topic)
+	if index < 0 {
+		cp.Subscriptions = append(cp.Subscriptions, TopicSubscription{
+			Topic:      topic,
+			Subscribed: wasSubscribed,
+		})
+		index = len(cp.Subscriptions) - 1
+		if topic == SUBSCRIPTION_TOPIC_NEWSLETTER {
+			cp.Subscriptions[index].SendTo = cp.SendNewsletterTo
+		}
+	}
+
+	sub := &cp.Subscriptions[index]
+	if subscribed != wasSubscribed {
+		if subscribed {
+			sub.SubscribedAt = time.Now().Unix()
+		} else {
+			sub.UnsubscribedAt = time.Now().Unix()
+		}
+	}
+	sub.Subscribed = subscribed
+	sub.Pending = false
+	if sendTo != nil {
+		sub.SendTo = sendTo
+	}
+
+	// keep legacy flags in sync for services still reading them
+	switch topic {
+	case SUBSCRIPTION_TOPIC_NEWSLETTER:
+		cp.SubscribedToNewsletter = subscribed
+		cp.SendNewsletterTo = sub.SendTo
+	case SUBSCRIPTION_TOPIC_WEEKLY:
+		cp.SubscribedToWeekly = subscribed
+	}
+}
+
+// SetSubscriptionChannel changes the contact infos used for a topic without touching the opt-in state
+func (cp *ContactPreferences) SetSubscriptionChannel(topic string, sendTo []string) {
+	if sendTo == nil {
+		return
+	}
+	if index := cp.findSubscription(topic); index >= 0 {
+		cp.Subscriptions[index].SendTo = sendTo
+	} else if topic != SUBSCRIPTION_TOPIC_NEWSLETTER {
+		cp.Subscriptions = append(cp.Subscriptions, TopicSubscription{
+			Topic:      topic,
+			Subscribed: cp.IsSubscribedTo(topic),
+			SendTo:     sendTo,
+		})
+	}
+	if topic == SUBSCRIPTION_TOPIC_NEWSLETTER {
+		cp.SendNewsletterTo = sendTo
+	}
+}
+
+// SetPendingSubscription registers the wish to subscribe to a topic, which is only active after ConfirmSubscription
+func (cp *ContactPreferences) SetPendingSubscription(topic string, sendTo []string) {
+	if cp.IsSubscribedTo(topic) {
+		cp.SetSubscriptionChannel(topic, sendTo)
+		return
+	}
+	cp.SetSubscription(topic, false, sendTo)
+	cp.Subscriptions[cp.findSubscription(topic)].Pending = true
+}
+
+// RequestSubscription sets the topic pending like SetPendingSubscription and reports if a confirmation
+// should be sent. While the subscription stays pending, this is true at most once within cooldown seconds.
+func (cp *ContactPreferences) RequestSubscription(topic string, sendTo []string, cooldown int64) bool {
+	cp.SetPendingSubscription(topic, sendTo)
+	index := cp.findSubscription(topic)
+	if index < 0 || !cp.Subscriptions[index].Pending {
+		return false
+	}
+	now := time.Now().Unix()
+	if cp.Subscriptions[index].ConfirmationSentAt > now-cooldown {
+		return false
+	}
+	cp.Subscriptions[index].ConfirmationSentAt = now
+	return true
+}
+
+// ConfirmSubscription activates a pending subscription and records the confirmation time
+func (cp *ContactPreferences) ConfirmSubscription(topic string) error {
+	if !cp.IsSubscriptionPending(topic) {
+		return errors.New("no pending subscription")
 	}
+	cp.SetSubscription(topic, true, nil)
+	cp.Subscriptions[cp.findSubscription(topic)].ConfirmedAt = time.Now().Unix()
+	return nil
 }
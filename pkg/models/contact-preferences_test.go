@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestContactPreferencesRequestSubscription(t *testing.T) {
+	t.Run("first request sends confirmation", func(t *testing.T) {
+		cp := ContactPreferences{}
+		if !cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600) {
+			t.Error("confirmation should be sent")
+		}
+		if !cp.IsSubscriptionPending(SUBSCRIPTION_TOPIC_NEWSLETTER) || cp.IsSubscribedTo(SUBSCRIPTION_TOPIC_NEWSLETTER) {
+			t.Errorf("subscription should be pending: %v", cp)
+		}
+	})
+
+	t.Run("repeated request within cooldown", func(t *testing.T) {
+		cp := ContactPreferences{}
+		cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600)
+		if cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600) {
+			t.Error("confirmation should not be sent again")
+		}
+	})
+
+	t.Run("repeated request after cooldown", func(t *testing.T) {
+		cp := ContactPreferences{}
+		cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600)
+		cp.Subscriptions[0].ConfirmationSentAt = time.Now().Unix() - 601
+		if !cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600) {
+			t.Error("confirmation should be sent again")
+		}
+	})
+
+	t.Run("already subscribed", func(t *testing.T) {
+		cp := ContactPreferences{}
+		cp.SetSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, true, nil)
+		if cp.RequestSubscription(SUBSCRIPTION_TOPIC_NEWSLETTER, nil, 600) {
+			t.Error("no confirmation needed")
+		}
+		if !cp.IsSubscribedTo(SUBSCRIPTION_TOPIC_NEWSLETTER) {
+			t.Errorf("subscription should stay active: %v", cp)
+		}
+	})
+}
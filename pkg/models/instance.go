@@ -0,0 +1,122 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+)
+
+const (
+	defaultPasswordMinLength      = 8
+	defaultPasswordMinCharClasses = 3
+)
+
+// Instance is the database entry of an instance with its settings, entries added by hand may only have an instance ID
+type Instance struct {
+	InstanceID string           `bson:"instanceID"`
+	Name       string           `bson:"name,omitempty"`
+	Settings   InstanceSettings `bson:"settings"`
+	CreatedAt  int64            `bson:"createdAt,omitempty"`
+	ArchivedAt int64            `bson:"archivedAt,omitempty"`
+}
+
+// InstanceSettings overrides the defaults of the service for an instance, zero values keep the default
+type InstanceSettings struct {
+	Languages      []string       `bson:"languages,omitempty"`
+	SignupClosed   bool           `bson:"signupClosed"`
+	PasswordPolicy PasswordPolicy `bson:"passwordPolicy"`
+	TokenLifetimes TokenLifetimes `bson:"tokenLifetimes"`
+
+	NewUserCountLimit           int64 `bson:"newUserCountLimit,omitempty"`           // signups allowed within the rate limit window
+	CleanUpUnverifiedUsersAfter int64 `bson:"cleanUpUnverifiedUsersAfter,omitempty"` // in seconds
+}
+
+// PasswordPolicy can only make the default requirements stricter
+type PasswordPolicy struct {
+	MinLength      int `bson:"minLength,omitempty"`
+	MinCharClasses int `bson:"minCharClasses,omitempty"` // lowercase, uppercase, digits, symbols
+}
+
+type TokenLifetimes struct {
+	AccessToken      int64 `bson:"accessToken,omitempty"`      // in minutes
+	VerificationCode int64 `bson:"verificationCode,omitempty"` // in seconds
+	Invitation       int64 `bson:"invitation,omitempty"`       // in seconds
+}
+
+// AllowsLanguage checks if the language is one of the instance's, any language is allowed if none is set
+func (s InstanceSettings) AllowsLanguage(lang string) bool {
+	if len(s.Languages) == 0 {
+		return true
+	}
+	for _, l := range s.Languages {
+		if l == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// Requirements returns the minimum length and number of character classes a password needs
+func (p PasswordPolicy) Requirements() (minLength int, minCharClasses int) {
+	minLength = defaultPasswordMinLength
+	if p.MinLength > minLength {
+		minLength = p.MinLength
+	}
+	minCharClasses = defaultPasswordMinCharClasses
+	if p.MinCharClasses > minCharClasses {
+		minCharClasses = p.MinCharClasses
+	}
+	return
+}
+
+func InstanceSettingsFromAPI(obj *api.InstanceSettings) InstanceSettings {
+	if obj == nil {
+		return InstanceSettings{}
+	}
+	settings := InstanceSettings{
+		Languages:                   obj.Languages,
+		SignupClosed:                obj.SignupClosed,
+		NewUserCountLimit:           obj.NewUserCountLimit,
+		CleanUpUnverifiedUsersAfter: obj.CleanUpUnverifiedUsersAfter,
+	}
+	if obj.PasswordPolicy != nil {
+		settings.PasswordPolicy = PasswordPolicy{
+			MinLength:      int(obj.PasswordPolicy.MinLength),
+			MinCharClasses: int(obj.PasswordPolicy.MinCharClasses),
+		}
+	}
+	if obj.TokenLifetimes != nil {
+		settings.TokenLifetimes = TokenLifetimes{
+			AccessToken:      obj.TokenLifetimes.AccessToken,
+			VerificationCode: obj.TokenLifetimes.VerificationCode,
+			Invitation:       obj.TokenLifetimes.Invitation,
+		}
+	}
+	return settings
+}
+
+func (s InstanceSettings) ToAPI() *api.InstanceSettings {
+	return &api.InstanceSettings{
+		Languages:    s.Languages,
+		SignupClosed: s.SignupClosed,
+		PasswordPolicy: &api.PasswordPolicy{
+			MinLength:      int32(s.PasswordPolicy.MinLength),
+			MinCharClasses: int32(s.PasswordPolicy.MinCharClasses),
+		},
+		TokenLifetimes: &api.TokenLifetimes{
+			AccessToken:      s.TokenLifetimes.AccessToken,
+			VerificationCode: s.TokenLifetimes.VerificationCode,
+			Invitation:       s.TokenLifetimes.Invitation,
+		},
+		NewUserCountLimit:           s.NewUserCountLimit,
+		CleanUpUnverifiedUsersAfter: s.CleanUpUnverifiedUsersAfter,
+	}
+}
+
+func (i Instance) ToAPI() *api.Instance {
+	return &api.Instance{
+		InstanceId: i.InstanceID,
+		Name:       i.Name,
+		Settings:   i.Settings.ToAPI(),
+		CreatedAt:  i.CreatedAt,
+		ArchivedAt: i.ArchivedAt,
+	}
+}
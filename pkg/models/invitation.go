@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/api"
+)
+
+// Invitation keeps track of the invitation sent to a user created by an admin
+type Invitation struct {
+	FirstSentAt int64 `bson:"firstSentAt,omitempty"` // not set while an imported user waits for the invitation
+	LastSentAt  int64 `bson:"lastSentAt,omitempty"`
+	SendCount   int32 `bson:"sendCount,omitempty"`
+	ExpiresAt   int64 `bson:"expiresAt"`
+	AcceptedAt  int64 `bson:"acceptedAt,omitempty"`
+	RevokedAt   int64 `bson:"revokedAt,omitempty"`
+}
+
+// Status of the invitation at the current time
+func (inv Invitation) Status() string {
+	switch {
+	case inv.AcceptedAt > 0:
+		return INVITATION_STATUS_ACCEPTED
+	case inv.RevokedAt > 0:
+		return INVITATION_STATUS_REVOKED
+	case inv.ExpiresAt < time.Now().Unix():
+		return INVITATION_STATUS_EXPIRED
+	default:
+		return INVITATION_STATUS_PENDING
+	}
+}
+
+// InvitationToAPI converts the invitation of the user to API format, nil if the user was not invited
+func (u User) InvitationToAPI() *api.Invitation {
+	if u.Invitation == nil {
+		return nil
+	}
+	inv := u.Invitation
+	return &api.Invitation{
+		UserId:      u.ID.Hex(),
+		AccountId:   u.Account.AccountID,
+		Status:      inv.Status(),
+		FirstSentAt: inv.FirstSentAt,
+		LastSentAt:  inv.LastSentAt,
+		SendCount:   inv.SendCount,
+		ExpiresAt:   inv.ExpiresAt,
+		AcceptedAt:  inv.AcceptedAt,
+		RevokedAt:   inv.RevokedAt,
+	}
+}
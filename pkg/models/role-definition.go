@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RoleDefinition maps a role of an instance to the permissions it grants
+type RoleDefinition struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty"`
+	InstanceID       string             `bson:"instanceID"`
+	Role             string             `bson:"role"`
+	Description      string             `bson:"description"`
+	Permissions      []string           `bson:"permissions"`
+	RequiresApproval bool               `bson:"requiresApproval"` // granting the role has to be approved by a second admin
+	BuiltIn          bool               `bson:"-"`
+}
+
+func RoleDefinitionFromAPI(obj *api.RoleDefinition) RoleDefinition {
+	if obj == nil {
+		return RoleDefinition{}
+	}
+	return RoleDefinition{
+		Role:             obj.Role,
+		Description:      obj.Description,
+		Permissions:      obj.Permissions,
+		RequiresApproval: obj.RequiresApproval,
+	}
+}
+
+func (obj RoleDefinition) ToAPI() *api.RoleDefinition {
+	return &api.RoleDefinition{
+		Role:             obj.Role,
+		Description:      obj.Description,
+		Permissions:      obj.Permissions,
+		BuiltIn:          obj.BuiltIn,
+		RequiresApproval: obj.RequiresApproval,
+	}
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RoleGrant records that a role was given to a user. Grants of roles that require approval are pending until a
+// second admin decides, the role is only added to the user once approved.
+type RoleGrant struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserID      string             `bson:"userID"`
+	AccountID   string             `bson:"accountID"`
+	Role        string             `bson:"role"`
+	Status      string             `bson:"status"`
+	RequestedBy string             `bson:"requestedBy"`
+	RequestedAt int64              `bson:"requestedAt"`
+	DecidedBy   string             `bson:"decidedBy,omitempty"`
+	DecidedAt   int64              `bson:"decidedAt,omitempty"`
+	ExpiresAt   int64              `bson:"expiresAt,omitempty"` // the role is removed again after this time, 0 for no limit
+	Scopes      []string           `bson:"scopes,omitempty"`    // replace the scopes of the role once approved
+}
+
+func (obj RoleGrant) ToAPI() *api.RoleGrant {
+	return &api.RoleGrant{
+		Id:          obj.ID.Hex(),
+		UserId:      obj.UserID,
+		AccountId:   obj.AccountID,
+		Role:        obj.Role,
+		Status:      obj.Status,
+		RequestedBy: obj.RequestedBy,
+		RequestedAt: obj.RequestedAt,
+		DecidedBy:   obj.DecidedBy,
+		DecidedAt:   obj.DecidedAt,
+		ExpiresAt:   obj.ExpiresAt,
+		Scopes:      obj.Scopes,
+	}
+}
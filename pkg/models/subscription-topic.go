@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SubscriptionTopic is a communication type defined for an instance, users can opt in or out per topic
+type SubscriptionTopic struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	InstanceID  string             `bson:"instanceID"`
+	Key         string             `bson:"key"`
+	Description string             `bson:"description"`
+}
+
+func SubscriptionTopicFromAPI(obj *api.SubscriptionTopic) SubscriptionTopic {
+	if obj == nil {
+		return SubscriptionTopic{}
+	}
+	return SubscriptionTopic{
+		Key:         obj.Key,
+		Description: obj.Description,
+	}
+}
+
+func (obj SubscriptionTopic) ToAPI() *api.SubscriptionTopic {
+	return &api.SubscriptionTopic{
+		Key:         obj.Key,
+		Description: obj.Description,
+	}
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/influenzanet/user-management-service/pkg/api"
@@ -10,13 +11,15 @@ import (
 
 // User describes the user as saved in the DB
 type User struct {
-	ID                 primitive.ObjectID `bson:"_id,omitempty" json:"user_id,omitempty"`
-	Account            Account            `bson:"account"`
-	Roles              []string           `bson:"roles" json:"roles"`
-	Timestamps         Timestamps         `bson:"timestamps"`
-	Profiles           []Profile          `bson:"profiles"`
-	ContactPreferences ContactPreferences `bson:"contactPreferences"`
-	ContactInfos       []ContactInfo      `bson:"contactInfos"`
+	ID                 primitive.ObjectID  `bson:"_id,omitempty" json:"user_id,omitempty"`
+	Account            Account             `bson:"account"`
+	Roles              []string            `bson:"roles" json:"roles"`
+	Timestamps         Timestamps          `bson:"timestamps"`
+	Profiles           []Profile           `bson:"profiles"`
+	ContactPreferences ContactPreferences  `bson:"contactPreferences"`
+	ContactInfos       []ContactInfo       `bson:"contactInfos"`
+	Invitation         *Invitation         `bson:"invitation,omitempty"`
+	RoleScopes         map[string][]string `bson:"roleScopes,omitempty"` // restricts a role to e.g. some studies, no entry means unrestricted
 }
 
 // ToAPI converts the object from DB to API format
@@ -37,9 +40,23 @@ func (u User) ToAPI() *api.User {
 		Profiles:           profiles,
 		ContactPreferences: u.ContactPreferences.ToAPI(),
 		ContactInfos:       contactInfos,
+		RoleScopes:         roleScopesToAPI(u.RoleScopes),
 	}
 }
 
+func roleScopesToAPI(roleScopes map[string][]string) []*api.RoleScope {
+	roles := make([]string, 0, len(roleScopes))
+	for r := range roleScopes {
+		roles = append(roles, r)
+	}
+	sort.Strings(roles)
+	res := make([]*api.RoleScope, len(roles))
+	for i, r := range roles {
+		res[i] = &api.RoleScope{Role: r, Scopes: roleScopes[r]}
+	}
+	return res
+}
+
 // HasRole checks whether the user has a specified role
 func (u User) HasRole(role string) bool {
 	for _, v := range u.Roles {
@@ -66,12 +83,64 @@ func (u *User) RemoveRole(role string) error {
 	for i, ci := range u.Roles {
 		if ci == role {
 			u.Roles = append(u.Roles[:i], u.Roles[i+1:]...)
+			delete(u.RoleScopes, role)
 			return nil
 		}
 	}
 	return errors.New("role not found")
 }
 
+// AddRoleScope restricts the role of the user to the scope (additionally to the scopes already added)
+func (u *User) AddRoleScope(role string, scope string) error {
+	if !u.HasRole(role) {
+		return errors.New("role not found")
+	}
+	for _, v := range u.RoleScopes[role] {
+		if v == scope {
+			return errors.New("scope already added")
+		}
+	}
+	if u.RoleScopes == nil {
+		u.RoleScopes = map[string][]string{}
+	}
+	u.RoleScopes[role] = append(u.RoleScopes[role], scope)
+	return nil
+}
+
+// ErrLastRoleScope is returned when removing the last scope would make the role unrestricted
+var ErrLastRoleScope = errors.New("remove the role instead")
+
+// RemoveRoleScope removes the scope from the role of the user. The last scope can't be removed,
+// as a role without scopes is unrestricted.
+func (u *User) RemoveRoleScope(role string, scope string) error {
+	for i, v := range u.RoleScopes[role] {
+		if v == scope {
+			if len(u.RoleScopes[role]) == 1 {
+				return ErrLastRoleScope
+			}
+			u.RoleScopes[role] = append(u.RoleScopes[role][:i], u.RoleScopes[role][i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("scope not found")
+}
+
+// ScopesForRoles returns the scopes of the given roles (e.g. the roles of a token)
+func (u User) ScopesForRoles(roles []string) map[string][]string {
+	var res map[string][]string
+	for _, r := range roles {
+		scopes, ok := u.RoleScopes[r]
+		if !ok || len(scopes) == 0 {
+			continue
+		}
+		if res == nil {
+			res = map[string][]string{}
+		}
+		res[r] = scopes
+	}
+	return res
+}
+
 // Add a new email address
 func (u *User) AddNewEmail(addr string, confirmed bool) {
 	contactInfo := ContactInfo{
@@ -86,13 +155,42 @@ func (u *User) AddNewEmail(addr string, confirmed bool) {
 	u.ContactInfos = append(u.ContactInfos, contactInfo)
 }
 
+// AddNewPhone adds a new phone number to the contact infos
+func (u *User) AddNewPhone(number string, confirmed bool) {
+	contactInfo := ContactInfo{
+		ID:          primitive.NewObjectID(),
+		Type:        CONTACT_TYPE_PHONE,
+		ConfirmedAt: 0,
+		Phone:       number,
+	}
+	if confirmed {
+		contactInfo.ConfirmedAt = time.Now().Unix()
+	}
+	u.ContactInfos = append(u.ContactInfos, contactInfo)
+}
+
+// SetContactInfoVerificationCode stores the code the user has to enter to confirm the contact info
+func (u *User) SetContactInfoVerificationCode(id string, code VerificationCode) error {
+	for i, ci := range u.ContactInfos {
+		if ci.ID.Hex() == id {
+			u.ContactInfos[i].VerificationCode = &code
+			u.ContactInfos[i].ConfirmationLinkSentAt = code.CreatedAt
+			return nil
+		}
+	}
+	return errors.New("contact not found")
+}
+
 func (u *User) ConfirmContactInfo(t string, addr string) error {
 	for i, ci := range u.ContactInfos {
 		if t == "email" && ci.Email == addr {
 			u.ContactInfos[i].ConfirmedAt = time.Now().Unix()
+			// the confirmation link was received, so earlier delivery problems are resolved
+			u.ContactInfos[i].DeliveryStatus = nil
 			return nil
 		} else if t == "phone" && ci.Phone == addr {
 			u.ContactInfos[i].ConfirmedAt = time.Now().Unix()
+			u.ContactInfos[i].VerificationCode = nil
 			return nil
 		}
 	}
@@ -111,6 +209,69 @@ func (u *User) SetContactInfoVerificationSent(t string, addr string) {
 	}
 }
 
+// ReportDeliveryEvent updates the delivery status of the email contact. Soft bounces only make the
+// address undeliverable once softBounceLimit is reached. Returns true if the address became undeliverable.
+func (u *User) ReportDeliveryEvent(email string, event string, details string, softBounceLimit int32) (bool, error) {
+	for i, ci := range u.ContactInfos {
+		if ci.Type != CONTACT_TYPE_EMAIL || ci.Email != email {
+			continue
+		}
+		ds := ci.DeliveryStatus
+		if ds == nil {
+			ds = &DeliveryStatus{}
+		}
+		wasUndeliverable := ds.Undeliverable
+
+		switch event {
+		case DELIVERY_EVENT_HARD_BOUNCE, DELIVERY_EVENT_COMPLAINT:
+			ds.Undeliverable = true
+		case DELIVERY_EVENT_SOFT_BOUNCE:
+			ds.SoftBounces += 1
+			if ds.SoftBounces >= softBounceLimit {
+				ds.Undeliverable = true
+			}
+		default:
+			return false, errors.New("unknown delivery event")
+		}
+		ds.Reason = event
+		ds.Details = details
+		ds.UpdatedAt = time.Now().Unix()
+		u.ContactInfos[i].DeliveryStatus = ds
+		return ds.Undeliverable && !wasUndeliverable, nil
+	}
+	return false, errors.New("contact not found")
+}
+
+// UndeliverableContactInfoIDs lists the contact infos the user should fix or replace
+func (u User) UndeliverableContactInfoIDs() []string {
+	ids := []string{}
+	for _, ci := range u.ContactInfos {
+		if ci.IsUndeliverable() {
+			ids = append(ids, ci.ID.Hex())
+		}
+	}
+	return ids
+}
+
+// RemoveUndeliverableContactInfos drops undeliverable contact infos and their references, e.g. before
+// handing the user to the messaging service. Returns false if the account ID itself is undeliverable.
+func (u *User) RemoveUndeliverableContactInfos() bool {
+	accountDeliverable := true
+	contactInfos := []ContactInfo{}
+	for _, ci := range u.ContactInfos {
+		if !ci.IsUndeliverable() {
+			contactInfos = append(contactInfos, ci)
+			continue
+		}
+		if u.Account.Type == ACCOUNT_TYPE_EMAIL && ci.Email == u.Account.AccountID {
+			accountDeliverable = false
+		}
+		u.RemoveContactInfoFromContactPreferences(ci.ID.Hex())
+	}
+	u.ContactInfos = contactInfos
+	return accountDeliverable
+}
+
 func (u User) FindContactInfoByTypeAndAddr(t string, addr string) (ContactInfo, bool) {
 	for _, ci := range u.ContactInfos {
 		if t == "email" && ci.Email == addr {
@@ -140,32 +301,67 @@ func (u *User) RemoveContactInfo(id string) error {
 			}
 
 			u.ContactInfos = append(u.ContactInfos[:i], u.ContactInfos[i+1:]...)
+			u.RemoveContactInfoFromContactPreferences(id)
 			return nil
 		}
 	}
-	u.RemoveContactInfoFromContactPreferences(id)
 	return errors.New("contact not found")
 }
 
 // RemoveContactInfoFromContactPreferences should delete all references to a contact info object
 func (u *User) RemoveContactInfoFromContactPreferences(id string) {
+	if u.ContactPreferences.SendVerificationCodeTo == id {
+		u.ContactPreferences.SendVerificationCodeTo = ""
+	}
 	// remove address from contact preferences
-	for i, addrRef := range u.ContactPreferences.SendNewsletterTo {
-		if addrRef == id {
-			u.ContactPreferences.SendNewsletterTo = append(u.ContactPreferences.SendNewsletterTo[:i], u.ContactPreferences.SendNewsletterTo[i+1:]...)
-			return
-		}
+	u.ContactPreferences.SendNewsletterTo = removeContactRef(u.ContactPreferences.SendNewsletterTo, id)
+	for i := range u.ContactPreferences.Subscriptions {
+		u.ContactPreferences.Subscriptions[i].SendTo = removeContactRef(u.ContactPreferences.Subscriptions[i].SendTo, id)
 	}
 }
 
 // ReplaceContactInfoInContactPreferences to use if a new contact reference should replace to old one
 func (u *User) ReplaceContactInfoInContactPreferences(oldId string, newId string) {
-	// replace address from contact preferences
-	for i, addrRef := range u.ContactPreferences.SendNewsletterTo {
+	if oldId == newId {
+		return
+	}
+	u.ContactPreferences.SendNewsletterTo = replaceContactRef(u.ContactPreferences.SendNewsletterTo, oldId, newId)
+	for i := range u.ContactPreferences.Subscriptions {
+		u.ContactPreferences.Subscriptions[i].SendTo = replaceContactRef(u.ContactPreferences.Subscriptions[i].SendTo, oldId, newId)
+	}
+}
+
+func removeContactRef(refs []string, id string) []string {
+	if refs == nil {
+		return nil
+	}
+	res := []string{}
+	for _, addrRef := range refs {
+		if addrRef != id {
+			res = append(res, addrRef)
+		}
+	}
+	return res
+}
+
+func replaceContactRef(refs []string, oldId string, newId string) []string {
+	// if the new address is already referenced, only drop the old one to avoid duplicates
+	for _, addrRef := range refs {
+		if addrRef == newId {
+			return removeContactRef(refs, oldId)
+		}
+	}
+	if refs == nil {
+		return nil
+	}
+	res := make([]string, len(refs))
+	for i, addrRef := range refs {
 		if addrRef == oldId {
-			u.ContactPreferences.SendNewsletterTo[i] = newId
+			addrRef = newId
 		}
+		res[i] = addrRef
 	}
+	return res
 }
 
 // AddProfile generates unique ID and adds profile to the user's array
@@ -211,6 +407,93 @@ func (u *User) RemoveProfile(id string) error {
 	return errors.New("profile with given ID not found")
 }
 
+// MergeFrom adds profiles, contact infos, linked identities and roles of another account of the same person.
+// The other main profile is merged into this main profile, other profiles keep their IDs. If this account has no main
+// profile, the other one's is taken over, so that the result has exactly one. Roles for which canMergeRole is false are
+// not added. Returns the old -> new profile IDs and the roles not merged.
+func (u *User) MergeFrom(other User, maxProfiles int, canMergeRole func(role string) bool) (map[string]string, []string, error) {
+	mainProfileID := ""
+	profiles := append([]Profile{}, u.Profiles...)
+	for i := range profiles {
+		if !profiles[i].MainProfile {
+			continue
+		}
+		if mainProfileID == "" {
+			mainProfileID = profiles[i].ID.Hex()
+		} else {
+			profiles[i].MainProfile = false
+		}
+	}
+
+	profileMapping := map[string]string{}
+	otherMainMerged := false
+	for _, p := range other.Profiles {
+		if p.MainProfile && !otherMainMerged {
+			otherMainMerged = true
+			if mainProfileID != "" {
+				profileMapping[p.ID.Hex()] = mainProfileID
+				continue
+			}
+			mainProfileID = p.ID.Hex()
+		} else {
+			p.MainProfile = false
+		}
+		profiles = append(profiles, p)
+		profileMapping[p.ID.Hex()] = p.ID.Hex()
+	}
+	if len(profiles) > maxProfiles {
+		return nil, nil, errors.New("too many profiles")
+	}
+	if mainProfileID == "" && len(profiles) > 0 {
+		profiles[0].MainProfile = true
+	}
+	u.Profiles = profiles
+
+	for _, ci := range other.ContactInfos {
+		addr := ci.Email
+		if ci.Type == CONTACT_TYPE_PHONE {
+			addr = ci.Phone
+		}
+		existing, found := u.FindContactInfoByTypeAndAddr(ci.Type, addr)
+		if !found {
+			u.ContactInfos = append(u.ContactInfos, ci)
+			continue
+		}
+		if existing.ConfirmedAt <= 0 && ci.ConfirmedAt > 0 {
+			for i := range u.ContactInfos {
+				if u.ContactInfos[i].ID == existing.ID {
+					u.ContactInfos[i].ConfirmedAt = ci.ConfirmedAt
+				}
+			}
+		}
+	}
+
+	for _, li := range other.Account.LinkedIdentities {
+		if !u.Account.HasLinkedIdentity(li.IDP, li.Subject) {
+			u.Account.LinkedIdentities = append(u.Account.LinkedIdentities, li)
+		}
+	}
+
+	rolesNotMerged := []string{}
+	for _, r := range other.Roles {
+		if u.HasRole(r) {
+			continue
+		}
+		if !canMergeRole(r) {
+			rolesNotMerged = append(rolesNotMerged, r)
+			continue
+		}
+		u.Roles = append(u.Roles, r)
+		if scopes, ok := other.RoleScopes[r]; ok {
+			if u.RoleScopes == nil {
+				u.RoleScopes = map[string][]string{}
+			}
+			u.RoleScopes[r] = scopes
+		}
+	}
+	return profileMapping, rolesNotMerged, nil
+}
+
 // AddRefreshToken add a new refresh token to the user's list
 func (u *User) AddRefreshToken(token string) {
 	u.Account.RefreshTokens = append(u.Account.RefreshTokens, token)
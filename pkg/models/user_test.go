@@ -0,0 +1,139 @@
+package models
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestUserMergeFrom(t *testing.T) {
+	allRoles := func(role string) bool { return true }
+
+	t.Run("roles not allowed to merge", func(t *testing.T) {
+		target := User{Roles: []string{"PARTICIPANT"}}
+		source := User{
+			Roles:      []string{"PARTICIPANT", "RESEARCHER", "ADMIN"},
+			RoleScopes: map[string][]string{"RESEARCHER": {"study1"}},
+		}
+		_, notMerged, err := target.MergeFrom(source, 5, func(role string) bool { return role != "ADMIN" })
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(notMerged) != 1 || notMerged[0] != "ADMIN" {
+			t.Errorf("unexpected roles not merged: %v", notMerged)
+		}
+		if target.HasRole("ADMIN") || !target.HasRole("RESEARCHER") || len(target.RoleScopes["RESEARCHER"]) != 1 {
+			t.Errorf("unexpected roles: %v, %v", target.Roles, target.RoleScopes)
+		}
+	})
+
+	t.Run("linked identities", func(t *testing.T) {
+		target := User{Account: Account{LinkedIdentities: []LinkedIdentity{{IDP: "idp1", Subject: "a"}}}}
+		source := User{Account: Account{LinkedIdentities: []LinkedIdentity{
+			{IDP: "idp1", Subject: "a"},
+			{IDP: "idp2", Subject: "b"},
+		}}}
+		if _, _, err := target.MergeFrom(source, 5, allRoles); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(target.Account.LinkedIdentities) != 2 || !target.Account.HasLinkedIdentity("idp2", "b") {
+			t.Errorf("unexpected linked identities: %v", target.Account.LinkedIdentities)
+		}
+	})
+
+	mainProfiles := func(u User) []Profile {
+		res := []Profile{}
+		for _, p := range u.Profiles {
+			if p.MainProfile {
+				res = append(res, p)
+			}
+		}
+		return res
+	}
+
+	t.Run("main profile of target kept", func(t *testing.T) {
+		targetMain := primitive.NewObjectID()
+		sourceMain := primitive.NewObjectID()
+		target := User{Profiles: []Profile{{ID: targetMain, MainProfile: true}}}
+		source := User{Profiles: []Profile{{ID: sourceMain, MainProfile: true}, {ID: primitive.NewObjectID()}}}
+		mapping, _, err := target.MergeFrom(source, 5, allRoles)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		main := mainProfiles(target)
+		if len(target.Profiles) != 2 || len(main) != 1 || main[0].ID != targetMain || mapping[sourceMain.Hex()] != targetMain.Hex() {
+			t.Errorf("unexpected profiles: %v, %v", target.Profiles, mapping)
+		}
+	})
+
+	t.Run("main profile of source taken over", func(t *testing.T) {
+		sourceMain := primitive.NewObjectID()
+		target := User{Profiles: []Profile{{ID: primitive.NewObjectID()}}}
+		source := User{Profiles: []Profile{{ID: sourceMain, MainProfile: true}}}
+		mapping, _, err := target.MergeFrom(source, 5, allRoles)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		main := mainProfiles(target)
+		if len(main) != 1 || main[0].ID != sourceMain || mapping[sourceMain.Hex()] != sourceMain.Hex() {
+			t.Errorf("unexpected profiles: %v, %v", target.Profiles, mapping)
+		}
+	})
+
+	t.Run("main profile assigned", func(t *testing.T) {
+		first := primitive.NewObjectID()
+		target := User{Profiles: []Profile{{ID: first}}}
+		source := User{Profiles: []Profile{{ID: primitive.NewObjectID()}}}
+		if _, _, err := target.MergeFrom(source, 5, allRoles); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		main := mainProfiles(target)
+		if len(main) != 1 || main[0].ID != first {
+			t.Errorf("unexpected profiles: %v", target.Profiles)
+		}
+	})
+
+	t.Run("several main profiles", func(t *testing.T) {
+		target := User{Profiles: []Profile{{ID: primitive.NewObjectID(), MainProfile: true}, {ID: primitive.NewObjectID(), MainProfile: true}}}
+		source := User{Profiles: []Profile{{ID: primitive.NewObjectID(), MainProfile: true}, {ID: primitive.NewObjectID(), MainProfile: true}}}
+		if _, _, err := target.MergeFrom(source, 5, allRoles); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+		if len(target.Profiles) != 3 || len(mainProfiles(target)) != 1 {
+			t.Errorf("unexpected profiles: %v", target.Profiles)
+		}
+	})
+
+	t.Run("too many profiles", func(t *testing.T) {
+		target := User{Profiles: []Profile{{ID: primitive.NewObjectID(), MainProfile: true}, {ID: primitive.NewObjectID()}}}
+		source := User{Profiles: []Profile{{ID: primitive.NewObjectID()}}}
+		if _, _, err := target.MergeFrom(source, 2, allRoles); err == nil {
+			t.Error("should return an error")
+		}
+		if len(target.Profiles) != 2 {
+			t.Errorf("target should be unchanged: %v", target.Profiles)
+		}
+	})
+}
+
+func TestUserRemoveRoleScope(t *testing.T) {
+	u := User{Roles: []string{"RESEARCHER"}, RoleScopes: map[string][]string{"RESEARCHER": {"study1", "study2"}}}
+	if err := u.RemoveRoleScope("RESEARCHER", "study3"); err == nil {
+		t.Error("should return an error for unknown scope")
+	}
+	if err := u.RemoveRoleScope("RESEARCHER", "study1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := u.RemoveRoleScope("RESEARCHER", "study2"); err != ErrLastRoleScope {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if scopes := u.RoleScopes["RESEARCHER"]; len(scopes) != 1 || scopes[0] != "study2" {
+		t.Errorf("unexpected scopes: %v", u.RoleScopes)
+	}
+}
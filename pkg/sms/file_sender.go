@@ -0,0 +1,29 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// FileSender writes messages as JSON lines instead of sending them - to use for local setups and tests
+type FileSender struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewFileSender(w io.Writer) *FileSender {
+	return &FileSender{w: w}
+}
+
+func (s *FileSender) SendSMS(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
@@ -0,0 +1,88 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileSender(t *testing.T) {
+	t.Run("write message as json line", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		sender := NewFileSender(buf)
+		err := sender.SendSMS(context.Background(), Message{
+			InstanceID:   "test",
+			To:           "+32470123456",
+			MessageType:  SMS_TYPE_CONTACT_VERIFICATION,
+			ContentInfos: map[string]string{"verificationCode": "123456"},
+		})
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		msg := Message{}
+		if err := json.Unmarshal(buf.Bytes(), &msg); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if msg.To != "+32470123456" || msg.ContentInfos["verificationCode"] != "123456" {
+			t.Errorf("unexpected message: %v", msg)
+		}
+	})
+}
+
+func TestNewSenderFromConfig(t *testing.T) {
+	t.Run("without target", func(t *testing.T) {
+		sender, _, err := NewSenderFromConfig("")
+		if err != nil || sender != nil {
+			t.Errorf("unexpected result: %v, %v", sender, err)
+		}
+	})
+
+	t.Run("with unknown target", func(t *testing.T) {
+		_, _, err := NewSenderFromConfig("carrier-pigeon")
+		if err == nil {
+			t.Error("should return an error")
+		}
+	})
+
+	t.Run("with file target without path", func(t *testing.T) {
+		sender, _, err := NewSenderFromConfig("file:")
+		if err == nil || sender != nil {
+			t.Errorf("unexpected result: %v, %v", sender, err)
+		}
+	})
+
+	t.Run("with file target", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "sms.log")
+		sender, close, err := NewSenderFromConfig("file:" + path)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := sender.SendSMS(context.Background(), Message{To: "+32470123456"}); err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+		}
+		close()
+		content, _ := ioutil.ReadFile(path)
+		if len(content) == 0 {
+			t.Error("message not written to file")
+		}
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	for _, target := range []string{"", "stdout", "file:/tmp/sms.log"} {
+		if err := CheckConfig(target); err != nil {
+			t.Errorf("unexpected error for %q: %v", target, err)
+		}
+	}
+	for _, target := range []string{"file:", "carrier-pigeon", "stderr"} {
+		if err := CheckConfig(target); err == nil {
+			t.Errorf("should return an error for %q", target)
+		}
+	}
+}
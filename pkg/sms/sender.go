@@ -0,0 +1,65 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+)
+
+const (
+	SMS_TYPE_CONTACT_VERIFICATION = "phone-verification"
+	SMS_TYPE_AUTH_VERIFICATION    = "verification-code"
+)
+
+// Message contains the infos needed to compose an SMS for a single recipient
+type Message struct {
+	InstanceID        string            `json:"instanceID"`
+	To                string            `json:"to"`
+	MessageType       string            `json:"messageType"`
+	PreferredLanguage string            `json:"preferredLanguage"`
+	ContentInfos      map[string]string `json:"contentInfos,omitempty"`
+}
+
+// Sender is implemented by SMS gateway adapters
+type Sender interface {
+	SendSMS(ctx context.Context, msg Message) error
+}
+
+// CheckConfig checks if NewSenderFromConfig accepts the target, without opening a file
+func CheckConfig(target string) error {
+	switch {
+	case target == "" || target == "stdout":
+		return nil
+	case strings.HasPrefix(target, "file:"):
+		if strings.TrimPrefix(target, "file:") == "" {
+			return errors.New("sms sender file path missing")
+		}
+		return nil
+	default:
+		return errors.New("unknown sms sender: " + target)
+	}
+}
+
+// NewSenderFromConfig creates a sender for the configured target:
+// "" disables SMS sending, "stdout" prints messages, "file:<path>" appends messages to the file.
+func NewSenderFromConfig(target string) (sender Sender, close func() error, err error) {
+	close = func() error { return nil }
+	if err := CheckConfig(target); err != nil {
+		return nil, close, err
+	}
+	switch {
+	case target == "":
+		return nil, close, nil
+	case target == "stdout":
+		return NewFileSender(os.Stdout), close, nil
+	case strings.HasPrefix(target, "file:"):
+		path := strings.TrimPrefix(target, "file:")
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, close, err
+		}
+		return NewFileSender(f), f.Close, nil
+	}
+	return nil, close, nil
+}
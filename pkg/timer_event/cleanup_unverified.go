@@ -12,8 +12,8 @@ func (s *UserManagementTimerService) CleanUpUnverifiedUsers() {
 	if err != nil {
 		log.Printf("unexpected error: %s", err.Error())
 	}
-	deleteUnverifiedUsersAfter := s.CleanUpTimeThreshold
 	for _, instance := range instances {
+		deleteUnverifiedUsersAfter := s.instanceConfigs.Get(instance.InstanceID).CleanUpUnverifiedUsersAfter
 		count, err := s.userDBService.DeleteUnverfiedUsers(instance.InstanceID, time.Now().Unix()-deleteUnverifiedUsersAfter)
 		if err != nil {
 			log.Printf("unexpected error: %s", err.Error())
@@ -0,0 +1,35 @@
+package timer_event
+
+import (
+	"log"
+	"time"
+
+	"github.com/influenzanet/user-management-service/pkg/models"
+)
+
+// RemoveExpiredRoles removes roles that were granted with a time limit once it has passed
+func (s *UserManagementTimerService) RemoveExpiredRoles() {
+	instances, err := s.globalDBService.GetAllInstances()
+	if err != nil {
+		log.Printf("unexpected error: %s", err.Error())
+	}
+	for _, instance := range instances {
+		grants, err := s.userDBService.FindExpiredRoleGrants(instance.InstanceID, time.Now().Unix())
+		if err != nil {
+			log.Printf("unexpected error: %s", err.Error())
+			continue
+		}
+		for _, grant := range grants {
+			if err := s.userDBService.RemoveRoleFromUser(instance.InstanceID, grant.UserID, grant.Role); err != nil {
+				log.Printf("unexpected error: %s", err.Error())
+				continue
+			}
+			if _, err := s.userDBService.UpdateRoleGrantStatus(instance.InstanceID, grant.ID.Hex(), models.ROLE_GRANT_STATUS_APPROVED, models.ROLE_GRANT_STATUS_EXPIRED, ""); err != nil {
+				log.Printf("unexpected error: %s", err.Error())
+			}
+		}
+		if len(grants) > 0 {
+			log.Printf("%s: removed %d expired roles", instance.InstanceID, len(grants))
+		}
+	}
+}
@@ -7,16 +7,17 @@ import (
 
 	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/instanceconfig"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
 // UserManagementTimerService handles background times for user management (cleanup for example).
 type UserManagementTimerService struct {
-	globalDBService      *globaldb.GlobalDBService
-	userDBService        *userdb.UserDBService
-	clients              *models.APIClients
-	TimerEventFrequency  int64 // how often the timer event should be performed (only from one instance of the service) - seconds
-	CleanUpTimeThreshold int64 // if user account not verified, remove user after this many seconds
+	globalDBService     *globaldb.GlobalDBService
+	userDBService       *userdb.UserDBService
+	clients             *models.APIClients
+	instanceConfigs     *instanceconfig.Provider // per instance: remove accounts not verified after this many seconds
+	TimerEventFrequency int64                    // how often the timer event should be performed (only from one instance of the service) - seconds
 }
 
 func NewUserManagmentTimerService(
@@ -24,14 +25,14 @@ func NewUserManagmentTimerService(
 	globalDBService *globaldb.GlobalDBService,
 	userDBService *userdb.UserDBService,
 	clients *models.APIClients,
-	cleanUpTimeThreshold int64,
+	instanceConfigs *instanceconfig.Provider,
 ) *UserManagementTimerService {
 	return &UserManagementTimerService{
-		globalDBService:      globalDBService,
-		userDBService:        userDBService,
-		TimerEventFrequency:  frequency,
-		clients:              clients,
-		CleanUpTimeThreshold: cleanUpTimeThreshold,
+		globalDBService:     globalDBService,
+		userDBService:       userDBService,
+		TimerEventFrequency: frequency,
+		clients:             clients,
+		instanceConfigs:     instanceConfigs,
 	}
 }
 
@@ -45,6 +46,7 @@ func (s *UserManagementTimerService) startTimerThread(ctx context.Context, timeC
 		select {
 		case <-time.After(time.Duration(timeCheckInterval) * time.Second):
 			go s.CleanUpUnverifiedUsers()
+			go s.RemoveExpiredRoles()
 
 		case <-ctx.Done():
 			return
@@ -10,6 +10,7 @@ import (
 	b64 "encoding/base64"
 
 	jwt "github.com/golang-jwt/jwt"
+	"github.com/influenzanet/go-utils/pkg/constants"
 	"github.com/influenzanet/user-management-service/pkg/models"
 )
 
@@ -20,16 +21,24 @@ var (
 
 // UserClaims - Information a token enocodes
 type UserClaims struct {
-	ID               string            `json:"id,omitempty"`
-	InstanceID       string            `json:"instance_id,omitempty"`
-	ProfileID        string            `json:"profile_id,omitempty"`
-	Payload          map[string]string `json:"payload,omitempty"`
-	AccountConfirmed bool              `json:"accountConfirmed,omitempty"`
-	TempTokenInfos   *models.TempToken `json:"temptoken,omitempty"`
-	OtherProfileIDs  []string          `json:"other_profile_ids,omitempty"`
+	ID               string              `json:"id,omitempty"`
+	InstanceID       string              `json:"instance_id,omitempty"`
+	ProfileID        string              `json:"profile_id,omitempty"`
+	Payload          map[string]string   `json:"payload,omitempty"`
+	Permissions      []string            `json:"permissions"` // null if not resolved, checked on each request then
+	Scopes           map[string][]string `json:"scopes,omitempty"`
+	AccountConfirmed bool                `json:"accountConfirmed,omitempty"`
+	TempTokenInfos   *models.TempToken   `json:"temptoken,omitempty"`
+	OtherProfileIDs  []string            `json:"other_profile_ids,omitempty"`
+	Actor            *ActorClaims        `json:"act,omitempty"`
 	jwt.StandardClaims
 }
 
+// ActorClaims identifies the user acting on behalf of the token's user (impersonation)
+type ActorClaims struct {
+	ID string `json:"sub"`
+}
+
 func getSecretKey() (newSecretKey []byte, err error) {
 	newSecretKeyEnc := os.Getenv("JWT_TOKEN_KEY")
 	if secretKeyEnc == newSecretKeyEnc {
@@ -48,7 +57,7 @@ func getSecretKey() (newSecretKey []byte, err error) {
 }
 
 // GenerateNewToken create and signes a new token
-func GenerateNewToken(userID string, accountConfirmed bool, profileID string, userRoles []string, instanceID string, experiresIn time.Duration, username string, tempTokenInfos *models.TempToken, otherProfileIDs []string) (string, error) {
+func GenerateNewToken(userID string, accountConfirmed bool, profileID string, userRoles []string, permissions []string, scopes map[string][]string, instanceID string, experiresIn time.Duration, username string, tempTokenInfos *models.TempToken, otherProfileIDs []string) (string, error) {
 	payload := map[string]string{}
 
 	if len(userRoles) > 0 {
@@ -64,15 +73,40 @@ func GenerateNewToken(userID string, accountConfirmed bool, profileID string, us
 		instanceID,
 		profileID,
 		payload,
+		permissions,
+		scopes,
 		accountConfirmed,
 		tempTokenInfos,
 		otherProfileIDs,
+		nil,
 		jwt.StandardClaims{
 			ExpiresAt: time.Now().Add(experiresIn).Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
 	}
+	return signClaims(claims)
+}
+
+// GenerateImpersonationToken creates a token for the user that names the impersonator in the act claim. It only
+// carries the participant role, whatever other roles the user has.
+func GenerateImpersonationToken(impersonatorID string, userID string, accountConfirmed bool, profileID string, instanceID string, experiresIn time.Duration, otherProfileIDs []string) (string, error) {
+	claims := UserClaims{
+		ID:               userID,
+		InstanceID:       instanceID,
+		ProfileID:        profileID,
+		Payload:          map[string]string{"roles": constants.USER_ROLE_PARTICIPANT},
+		AccountConfirmed: accountConfirmed,
+		OtherProfileIDs:  otherProfileIDs,
+		Actor:            &ActorClaims{ID: impersonatorID},
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(experiresIn).Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+	}
+	return signClaims(claims)
+}
 
+func signClaims(claims UserClaims) (string, error) {
 	// Create the token
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
@@ -0,0 +1,37 @@
+package tokens
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	apiKeyPrefix   = "sk_"
+	appTokenPrefix = "at_"
+)
+
+// GenerateAPIKey creates a new random key for a service account and the hash it is stored with
+func GenerateAPIKey() (key string, hash string, err error) {
+	return generateSecret(apiKeyPrefix)
+}
+
+// GenerateAppToken creates a new random app token and the hash it is stored with
+func GenerateAppToken() (token string, hash string, err error) {
+	return generateSecret(appTokenPrefix)
+}
+
+func generateSecret(prefix string) (secret string, hash string, err error) {
+	t, err := GenerateUniqueTokenString()
+	if err != nil {
+		return "", "", err
+	}
+	secret = prefix + t
+	return secret, HashSecret(secret), nil
+}
+
+// HashSecret returns the hash an API key or app token is stored and looked up with. They are long random strings, so
+// a fast hash is enough (unlike for passwords).
+func HashSecret(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
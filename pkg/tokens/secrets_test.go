@@ -0,0 +1,36 @@
+package tokens
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, hash, err := GenerateAPIKey()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !strings.HasPrefix(key, "sk_") || len(key) < 30 {
+		t.Errorf("unexpected key: %s", key)
+	}
+	if hash == key || hash != HashSecret(key) {
+		t.Errorf("unexpected hash: %s", hash)
+	}
+
+	key2, hash2, _ := GenerateAPIKey()
+	if key2 == key || hash2 == hash {
+		t.Error("keys should differ")
+	}
+}
+
+func TestGenerateAppToken(t *testing.T) {
+	token, hash, err := GenerateAppToken()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !strings.HasPrefix(token, "at_") || hash != HashSecret(token) {
+		t.Errorf("unexpected token: %s, %s", token, hash)
+	}
+}
@@ -0,0 +1,288 @@
+// Package userarchive reads and writes user archives: JSONL files with a header line followed by one user document per line
+// (MongoDB extended JSON, so that IDs and types are kept) and a trailer line with the number of documents. Documents and
+// trailer can be encrypted with AES-256-GCM, the header and the position of the line are authenticated with them.
+package userarchive
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	FORMAT_NAME    = "influenzanet-users"
+	FORMAT_VERSION = 1
+
+	ENCRYPTION_AES_GCM = "AES-256-GCM"
+
+	maxLineLength = 16 * 1024 * 1024
+)
+
+// ErrWrongKey is returned if an encrypted document can't be decrypted with the given key or was changed
+var ErrWrongKey = errors.New("document can't be decrypted, wrong key or modified archive?")
+
+// ErrTruncated is returned if the archive ends without trailer
+var ErrTruncated = errors.New("archive incomplete, trailer missing")
+
+// trailerPrefix can't start a user document, their first field is the ID
+var trailerPrefix = []byte(`{"$trailer"`)
+
+// Header is the first line of an archive
+type Header struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	InstanceID string `json:"instanceId"`
+	ExportedAt int64  `json:"exportedAt"`
+	Encryption string `json:"encryption,omitempty"`
+}
+
+// Trailer is the last line of an archive
+type Trailer struct {
+	Documents int `json:"documents"`
+}
+
+type trailerLine struct {
+	Trailer Trailer `json:"$trailer"`
+}
+
+// ParseKey decodes a base64 encoded 32 bytes key (as generated by the key-generator tool)
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != 32 {
+		return nil, errors.New("key must be 32 bytes long")
+	}
+	return key, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// associatedData binds an encrypted line to the header and its position, so that neither can be changed unnoticed
+func associatedData(header []byte, index int) []byte {
+	ad := make([]byte, len(header)+8)
+	copy(ad, header)
+	binary.BigEndian.PutUint64(ad[len(header):], uint64(index))
+	return ad
+}
+
+// Writer writes an archive, the header is written on creation and the trailer on Close
+type Writer struct {
+	w      *bufio.Writer
+	aead   cipher.AEAD
+	header []byte
+	count  int
+}
+
+// NewWriter starts an archive for the instance, documents are encrypted if key is not nil
+func NewWriter(w io.Writer, instanceID string, key []byte) (*Writer, error) {
+	aw := &Writer{w: bufio.NewWriter(w)}
+	header := Header{
+		Format:     FORMAT_NAME,
+		Version:    FORMAT_VERSION,
+		InstanceID: instanceID,
+		ExportedAt: time.Now().Unix(),
+	}
+	if key != nil {
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		aw.aead = aead
+		header.Encryption = ENCRYPTION_AES_GCM
+	}
+	b, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	aw.header = b
+	if _, err := aw.w.Write(append(b, '\n')); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+func (aw *Writer) writeLine(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := aw.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteDocument adds one document (extended JSON)
+func (aw *Writer) WriteDocument(doc []byte) error {
+	if bytes.HasPrefix(doc, trailerPrefix) {
+		return errors.New("document can't be stored")
+	}
+	if err := aw.writeEntry(doc); err != nil {
+		return err
+	}
+	aw.count++
+	return nil
+}
+
+func (aw *Writer) writeEntry(entry []byte) error {
+	if aw.aead == nil {
+		if _, err := aw.w.Write(append(entry, '\n')); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	nonce := make([]byte, aw.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	sealed := aw.aead.Seal(nonce, nonce, entry, associatedData(aw.header, aw.count))
+	return aw.writeLine(base64.StdEncoding.EncodeToString(sealed))
+}
+
+// Close writes the trailer and flushes the buffered documents
+func (aw *Writer) Close() error {
+	trailer, err := json.Marshal(trailerLine{Trailer: Trailer{Documents: aw.count}})
+	if err != nil {
+		return err
+	}
+	if err := aw.writeEntry(trailer); err != nil {
+		return err
+	}
+	return aw.w.Flush()
+}
+
+// Reader reads an archive, the header is read on creation
+type Reader struct {
+	Header  Header
+	scanner *bufio.Scanner
+	aead    cipher.AEAD
+	header  []byte
+	count   int
+	done    bool
+}
+
+// NewReader checks the header of the archive, key is required for encrypted archives. With a key, unencrypted
+// archives are refused.
+func NewReader(r io.Reader, key []byte) (*Reader, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxLineLength)
+	ar := &Reader{scanner: scanner}
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("missing header")
+	}
+	// the scanner reuses its buffer
+	ar.header = append([]byte{}, scanner.Bytes()...)
+	if err := json.Unmarshal(ar.header, &ar.Header); err != nil {
+		return nil, fmt.Errorf("wrong header: %v", err)
+	}
+	if ar.Header.Format != FORMAT_NAME {
+		return nil, errors.New("not a user archive")
+	}
+	if ar.Header.Version != FORMAT_VERSION {
+		return nil, fmt.Errorf("unsupported archive version: %d", ar.Header.Version)
+	}
+
+	switch ar.Header.Encryption {
+	case "":
+		if key != nil {
+			return nil, errors.New("archive is not encrypted")
+		}
+	case ENCRYPTION_AES_GCM:
+		if key == nil {
+			return nil, errors.New("archive is encrypted, key required")
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		ar.aead = aead
+	default:
+		return nil, fmt.Errorf("unsupported encryption: %s", ar.Header.Encryption)
+	}
+	return ar, nil
+}
+
+// Next returns the next document, io.EOF at the end of the archive. The number of documents is checked against the
+// trailer, ErrTruncated is returned if it is missing.
+func (ar *Reader) Next() ([]byte, error) {
+	if ar.done {
+		return nil, io.EOF
+	}
+	if !ar.scanner.Scan() {
+		if err := ar.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrTruncated
+	}
+	entry, err := ar.readEntry(ar.scanner.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(entry, trailerPrefix) {
+		var t trailerLine
+		if err := json.Unmarshal(entry, &t); err != nil {
+			return nil, fmt.Errorf("wrong trailer: %v", err)
+		}
+		if t.Trailer.Documents != ar.count {
+			return nil, fmt.Errorf("archive incomplete, %d of %d documents", ar.count, t.Trailer.Documents)
+		}
+		if ar.scanner.Scan() {
+			return nil, errors.New("unexpected data after the trailer")
+		}
+		if err := ar.scanner.Err(); err != nil {
+			return nil, err
+		}
+		ar.done = true
+		return nil, io.EOF
+	}
+	ar.count++
+	return entry, nil
+}
+
+func (ar *Reader) readEntry(line []byte) ([]byte, error) {
+	if ar.aead == nil {
+		// the scanner reuses its buffer
+		return append([]byte{}, line...), nil
+	}
+
+	var encoded string
+	if err := json.Unmarshal(line, &encoded); err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := ar.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return nil, ErrWrongKey
+	}
+	entry, err := ar.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], associatedData(ar.header, ar.count))
+	if err != nil {
+		return nil, ErrWrongKey
+	}
+	return entry, nil
+}
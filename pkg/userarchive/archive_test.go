@@ -0,0 +1,218 @@
+package userarchive
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func writeTestArchive(t *testing.T, key []byte, docs []string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "test-instance", key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	for _, d := range docs {
+		if err := w.WriteDocument([]byte(d)); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return buf
+}
+
+func readAll(r *Reader) ([]string, error) {
+	docs := []string{}
+	for {
+		doc, err := r.Next()
+		if err == io.EOF {
+			return docs, nil
+		}
+		if err != nil {
+			return docs, err
+		}
+		docs = append(docs, string(doc))
+	}
+}
+
+func TestArchive(t *testing.T) {
+	docs := []string{
+		`{"_id":{"$oid":"5f1e8b5a8d3e4a1f2c3b4a5d"},"account":{"accountID":"a@test.com"}}`,
+		`{"_id":{"$oid":"5f1e8b5a8d3e4a1f2c3b4a5e"},"account":{"accountID":"b@test.com"}}`,
+	}
+
+	t.Run("plain", func(t *testing.T) {
+		buf := writeTestArchive(t, nil, docs)
+		if !strings.Contains(buf.String(), "a@test.com") {
+			t.Error("documents should be readable")
+		}
+		r, err := NewReader(buf, nil)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if r.Header.InstanceID != "test-instance" || r.Header.Version != FORMAT_VERSION || r.Header.Encryption != "" {
+			t.Errorf("unexpected header: %v", r.Header)
+		}
+		read, err := readAll(r)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(read) != 2 || read[0] != docs[0] || read[1] != docs[1] {
+			t.Errorf("unexpected documents: %v", read)
+		}
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		buf := writeTestArchive(t, testKey, docs)
+		if strings.Contains(buf.String(), "a@test.com") {
+			t.Error("documents should be encrypted")
+		}
+		r, err := NewReader(bytes.NewReader(buf.Bytes()), testKey)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if r.Header.Encryption != ENCRYPTION_AES_GCM {
+			t.Errorf("unexpected header: %v", r.Header)
+		}
+		read, err := readAll(r)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if len(read) != 2 || read[1] != docs[1] {
+			t.Errorf("unexpected documents: %v", read)
+		}
+
+		if _, err := NewReader(bytes.NewReader(buf.Bytes()), nil); err == nil {
+			t.Error("should require a key")
+		}
+
+		r, err = NewReader(bytes.NewReader(buf.Bytes()), []byte("fedcba9876543210fedcba9876543210"))
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := r.Next(); err != ErrWrongKey {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("wrong format", func(t *testing.T) {
+		_, err := NewReader(strings.NewReader(`{"format":"other","version":1}`+"\n"), nil)
+		if err == nil {
+			t.Error("should return an error")
+		}
+		_, err = NewReader(strings.NewReader(`{"format":"influenzanet-users","version":2}`+"\n"), nil)
+		if err == nil || !strings.Contains(err.Error(), "version") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestArchiveIntegrity(t *testing.T) {
+	docs := []string{
+		`{"_id":{"$oid":"5f1e8b5a8d3e4a1f2c3b4a5d"},"account":{"accountID":"a@test.com"}}`,
+		`{"_id":{"$oid":"5f1e8b5a8d3e4a1f2c3b4a5e"},"account":{"accountID":"b@test.com"}}`,
+	}
+	lines := func(buf *bytes.Buffer) []string {
+		return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	}
+	join := func(l []string) io.Reader {
+		return strings.NewReader(strings.Join(l, "\n") + "\n")
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		for _, key := range [][]byte{nil, testKey} {
+			l := lines(writeTestArchive(t, key, docs))
+			r, err := NewReader(join(l[:len(l)-1]), key)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err.Error())
+				return
+			}
+			if _, err := readAll(r); err != ErrTruncated {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+	})
+
+	t.Run("document removed", func(t *testing.T) {
+		l := lines(writeTestArchive(t, nil, docs))
+		r, err := NewReader(join(append([]string{l[0]}, l[2:]...)), nil)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := readAll(r); err == nil || !strings.Contains(err.Error(), "1 of 2") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("encrypted documents reordered", func(t *testing.T) {
+		l := lines(writeTestArchive(t, testKey, docs))
+		l[1], l[2] = l[2], l[1]
+		r, err := NewReader(join(l), testKey)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := readAll(r); err != ErrWrongKey {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("header changed", func(t *testing.T) {
+		l := lines(writeTestArchive(t, testKey, docs))
+		l[0] = strings.Replace(l[0], "test-instance", "other-instance", 1)
+		r, err := NewReader(join(l), testKey)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if _, err := readAll(r); err != ErrWrongKey {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("encryption removed from header", func(t *testing.T) {
+		l := lines(writeTestArchive(t, testKey, docs))
+		l[0] = strings.Replace(l[0], `"encryption":"`+ENCRYPTION_AES_GCM+`"`, `"encryption":""`, 1)
+		if _, err := NewReader(join(l), testKey); err == nil {
+			t.Error("plain archive should be refused with a key")
+		}
+
+		plain := writeTestArchive(t, nil, docs)
+		if _, err := NewReader(plain, testKey); err == nil {
+			t.Error("plain archive should be refused with a key")
+		}
+	})
+
+	t.Run("trailer as document", func(t *testing.T) {
+		w, err := NewWriter(&bytes.Buffer{}, "test-instance", nil)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+		if err := w.WriteDocument([]byte(`{"$trailer":{"documents":0}}`)); err == nil {
+			t.Error("should return an error")
+		}
+	})
+}
+
+func TestParseKey(t *testing.T) {
+	key, err := ParseKey(base64.StdEncoding.EncodeToString(testKey))
+	if err != nil || !bytes.Equal(key, testKey) {
+		t.Errorf("unexpected result: %v, %v", key, err)
+	}
+	if _, err := ParseKey(base64.StdEncoding.EncodeToString([]byte("short"))); err == nil {
+		t.Error("should reject short keys")
+	}
+}
@@ -23,6 +23,22 @@ func CheckEmailFormat(email string) bool {
 	return re.MatchString(email)
 }
 
+// SanitizePhoneNumber normalises a phone number towards E.164 format (e.g. "+32470123456")
+func SanitizePhoneNumber(phone string) string {
+	phone = strings.Trim(phone, " \n\r")
+	phone = strings.NewReplacer(" ", "", "-", "", ".", "", "/", "", "(", "", ")", "").Replace(phone)
+	if strings.HasPrefix(phone, "00") {
+		phone = "+" + phone[2:]
+	}
+	return phone
+}
+
+// CheckPhoneFormat to check if input string is a phone number in E.164 format
+func CheckPhoneFormat(phone string) bool {
+	re := regexp.MustCompile(`^\+[1-9]\d{6,14}$`)
+	return re.MatchString(phone)
+}
+
 // BlurEmailAddress transforms an email address to reduce exposed personal info
 func BlurEmailAddress(email string) string {
 	items := strings.Split(email, "@")
@@ -36,8 +52,14 @@ func BlurEmailAddress(email string) string {
 
 // CheckPasswordFormat to check if password fulfills password rules
 func CheckPasswordFormat(password string) bool {
+	return CheckPasswordRequirements(password, 8, 3)
+}
+
+// CheckPasswordRequirements checks the length of the password (up to 512) and how many character classes (lowercase,
+// uppercase, digits, symbols) it uses
+func CheckPasswordRequirements(password string, minLength int, minCharClasses int) bool {
 	pl := len(password)
-	if pl < 8 || pl > 512 {
+	if pl < minLength || pl > 512 {
 		return false
 	}
 
@@ -60,7 +82,7 @@ func CheckPasswordFormat(password string) bool {
 	if symbol.MatchString(password) {
 		res++
 	}
-	return res > 2
+	return res >= minCharClasses
 }
 
 // CheckLanguageCode checks if a string can be considered as a language code
@@ -69,6 +91,12 @@ func CheckLanguageCode(code string) bool {
 	return codeRule.MatchString(code)
 }
 
+// CheckInstanceID checks if a string can be used as ID of a new instance (it is part of database names)
+func CheckInstanceID(id string) bool {
+	idRule := regexp.MustCompile("^[a-zA-Z0-9_-]{1,32}$")
+	return idRule.MatchString(id)
+}
+
 // IsTokenEmpty check a token from api if it's empty
 func IsTokenEmpty(t *api_types.TokenInfos) bool {
 	if t == nil || t.Id == "" || t.InstanceId == "" {
@@ -78,6 +78,37 @@ func TestCheckPasswordFormat(t *testing.T) {
 	})
 }
 
+func TestCheckPasswordRequirements(t *testing.T) {
+	t.Run("with a too short password", func(t *testing.T) {
+		if CheckPasswordRequirements("1n34T678", 12, 3) {
+			t.Error("should be false")
+		}
+	})
+	t.Run("with too few character classes", func(t *testing.T) {
+		if CheckPasswordRequirements("1n34T678abcd", 12, 4) {
+			t.Error("should be false")
+		}
+	})
+	t.Run("with a good password", func(t *testing.T) {
+		if !CheckPasswordRequirements("1n34T678ab.d", 12, 4) {
+			t.Error("should be true")
+		}
+	})
+}
+
+func TestCheckInstanceID(t *testing.T) {
+	for _, id := range []string{"", "with space", "db.name", "with/slash", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		if CheckInstanceID(id) {
+			t.Errorf("should be false: %s", id)
+		}
+	}
+	for _, id := range []string{"default", "nl", "test_instance-2"} {
+		if !CheckInstanceID(id) {
+			t.Errorf("should be true: %s", id)
+		}
+	}
+}
+
 func TestCheckEmailFormat(t *testing.T) {
 	t.Run("with missing @", func(t *testing.T) {
 		if CheckEmailFormat("t.t.t") {
@@ -112,6 +143,60 @@ func TestCheckEmailFormat(t *testing.T) {
 	})
 }
 
+func TestSanitizePhoneNumber(t *testing.T) {
+	t.Run("with different formats", func(t *testing.T) {
+		phone := SanitizePhoneNumber(" +32 470 12 34 56\n")
+		if phone != "+32470123456" {
+			t.Errorf("unexpected phone: %s", phone)
+		}
+
+		phone = SanitizePhoneNumber("0032 (470) 12-34-56")
+		if phone != "+32470123456" {
+			t.Errorf("unexpected phone: %s", phone)
+		}
+
+		phone = SanitizePhoneNumber("+31.6.1234.5678")
+		if phone != "+31612345678" {
+			t.Errorf("unexpected phone: %s", phone)
+		}
+	})
+}
+
+func TestCheckPhoneFormat(t *testing.T) {
+	t.Run("without country code", func(t *testing.T) {
+		if CheckPhoneFormat("0470123456") {
+			t.Error("should be false")
+		}
+	})
+
+	t.Run("with non-digit characters", func(t *testing.T) {
+		if CheckPhoneFormat("+32470a23456") {
+			t.Error("should be false")
+		}
+	})
+
+	t.Run("with wrong length", func(t *testing.T) {
+		if CheckPhoneFormat("+3247") {
+			t.Error("should be false")
+		}
+		if CheckPhoneFormat("+3247012345678901") {
+			t.Error("should be false")
+		}
+	})
+
+	t.Run("with leading zero after plus", func(t *testing.T) {
+		if CheckPhoneFormat("+0470123456") {
+			t.Error("should be false")
+		}
+	})
+
+	t.Run("with correct format", func(t *testing.T) {
+		if !CheckPhoneFormat("+32470123456") {
+			t.Error("should be true")
+		}
+	})
+}
+
 func TestLanguageCodeFormat(t *testing.T) {
 	t.Run("with t", func(t *testing.T) {
 		if CheckLanguageCode("t") {
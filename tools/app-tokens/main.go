@@ -0,0 +1,165 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/globaldb"
+	"github.com/influenzanet/user-management-service/pkg/models"
+	"github.com/influenzanet/user-management-service/pkg/tokens"
+)
+
+type appTokenRequest struct {
+	mode           string
+	appName        string
+	instances      []string
+	id             string
+	expiresIn      time.Duration
+	includeRevoked bool
+}
+
+func terminate(message string) {
+	fmt.Println(message)
+	os.Exit(1)
+}
+
+func reqFromCLI() appTokenRequest {
+	req := appTokenRequest{}
+	createF := flag.Bool("create", false, "create a token for the app")
+	listF := flag.Bool("list", false, "list the app tokens")
+	rotateF := flag.Bool("rotate", false, "replace the token of the entry by a new one")
+	revokeF := flag.Bool("revoke", false, "revoke the token of the entry")
+	migrateF := flag.Bool("migrate", false, "replace plain tokens added by hand by hashed entries")
+	appF := flag.String("app", "", "name of the app (create)")
+	instancesF := flag.String("instances", "", "comma separated instances the token can be used for (create), instance to list the tokens of (list)")
+	idF := flag.String("id", "", "id of the entry (rotate, revoke)")
+	expiresF := flag.Duration("expires", 0, "validity of the token, e.g. 8760h (create, rotate), no limit by default")
+	allF := flag.Bool("all", false, "also list revoked tokens (list)")
+
+	flag.Parse()
+
+	modes := map[string]bool{"create": *createF, "list": *listF, "rotate": *rotateF, "revoke": *revokeF, "migrate": *migrateF}
+	for mode, set := range modes {
+		if !set {
+			continue
+		}
+		if req.mode != "" {
+			terminate("only one of create, list, rotate, revoke or migrate can be used")
+		}
+		req.mode = mode
+	}
+	if req.mode == "" {
+		terminate("one of create, list, rotate, revoke or migrate must be used")
+	}
+
+	req.appName = *appF
+	if *instancesF != "" {
+		req.instances = strings.Split(*instancesF, ",")
+	}
+	req.id = *idF
+	req.expiresIn = *expiresF
+	req.includeRevoked = *allF
+
+	switch req.mode {
+	case "create":
+		if req.appName == "" || len(req.instances) == 0 {
+			terminate("app and instances must be provided")
+		}
+	case "rotate", "revoke":
+		if req.id == "" {
+			terminate("id must be provided")
+		}
+	}
+	return req
+}
+
+func expiresAt(expiresIn time.Duration) int64 {
+	if expiresIn <= 0 {
+		return 0
+	}
+	return time.Now().Add(expiresIn).Unix()
+}
+
+func formatTime(t int64) string {
+	if t == 0 {
+		return "-"
+	}
+	return time.Unix(t, 0).UTC().Format(time.RFC3339)
+}
+
+func printAppToken(t models.AppToken) {
+	fmt.Printf("%s  %s  instances: %s  created: %s  expires: %s  last used: %s", t.ID.Hex(), t.AppName, strings.Join(t.Instances, ","), formatTime(t.CreatedAt), formatTime(t.ExpiresAt), formatTime(t.LastUsedAt))
+	if len(t.Tokens) > 0 {
+		fmt.Printf("  (%d plain tokens, not migrated)", len(t.Tokens))
+	}
+	if t.RevokedAt > 0 {
+		fmt.Printf("  revoked: %s", formatTime(t.RevokedAt))
+	}
+	fmt.Println()
+}
+
+func main() {
+	req := reqFromCLI()
+
+	conf := config.InitDBConfig()
+	globalDBService := globaldb.NewGlobalDBService(conf.GlobalDBConfig)
+
+	switch req.mode {
+	case "create":
+		secret, hash, err := tokens.GenerateAppToken()
+		if err != nil {
+			terminate(err.Error())
+		}
+		appToken, err := globalDBService.AddAppToken(models.AppToken{
+			AppName:   req.appName,
+			TokenHash: hash,
+			Instances: req.instances,
+			CreatedAt: time.Now().Unix(),
+			ExpiresAt: expiresAt(req.expiresIn),
+		})
+		if err != nil {
+			terminate(err.Error())
+		}
+		printAppToken(appToken)
+		fmt.Printf("token (only shown once): %s\n", secret)
+	case "list":
+		instanceID := ""
+		if len(req.instances) > 0 {
+			instanceID = req.instances[0]
+		}
+		appTokens, err := globalDBService.GetAppTokens(instanceID, req.includeRevoked)
+		if err != nil {
+			terminate(err.Error())
+		}
+		for _, t := range appTokens {
+			printAppToken(t)
+		}
+	case "rotate":
+		secret, hash, err := tokens.GenerateAppToken()
+		if err != nil {
+			terminate(err.Error())
+		}
+		appToken, err := globalDBService.RotateAppToken(req.id, hash, expiresAt(req.expiresIn))
+		if err != nil {
+			terminate("entry not found or revoked")
+		}
+		printAppToken(appToken)
+		fmt.Printf("token (only shown once): %s\n", secret)
+	case "revoke":
+		appToken, err := globalDBService.RevokeAppToken(req.id)
+		if err != nil {
+			terminate("entry not found or already revoked")
+		}
+		printAppToken(appToken)
+	case "migrate":
+		count, err := globalDBService.MigrateLegacyAppTokens()
+		if err != nil {
+			terminate(err.Error())
+		}
+		fmt.Printf("%d plain tokens replaced by hashed entries\n", count)
+	}
+}
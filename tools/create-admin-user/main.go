@@ -17,6 +17,7 @@ import (
 	"github.com/influenzanet/user-management-service/pkg/utils"
 
 	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/authz"
 	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
 )
 
@@ -24,6 +25,7 @@ type UserRequest struct {
 	email      string `yaml:"email"`
 	password   string `yaml:"password"`
 	instanceID string `yaml:"instance"`
+	superAdmin bool
 }
 
 func terminate(message string) {
@@ -36,12 +38,14 @@ func reqfromCLI() (UserRequest, error) {
 	emailF := flag.String("email", "", "email")
 	passwordF := flag.String("password", "", "password")
 	instanceF := flag.String("instance", "", "instance")
+	superF := flag.Bool("super", false, "also give the super admin role, to manage all instances")
 
 	flag.Parse()
 
 	req.email = *emailF
 	req.password = *passwordF
 	req.instanceID = *instanceF
+	req.superAdmin = *superF
 
 	if req.email == "" {
 		terminate("email must be provided")
@@ -65,7 +69,7 @@ func main() {
 		terminate(err.Error())
 	}
 
-	conf := config.InitConfig()
+	conf := config.InitDBConfig()
 
 	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
 
@@ -105,6 +109,9 @@ func main() {
 			CreatedAt: time.Now().Unix(),
 		},
 	}
+	if req.superAdmin {
+		newUser.Roles = append(newUser.Roles, authz.ROLE_SUPER_ADMIN)
+	}
 	newUser.AddNewEmail(req.email, false)
 	newUser.ContactPreferences.SubscribedToNewsletter = false
 	newUser.ContactPreferences.SendNewsletterTo = []string{newUser.ContactInfos[0].ID.Hex()}
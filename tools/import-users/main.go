@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	api_types "github.com/influenzanet/go-utils/pkg/api_types"
+	"github.com/influenzanet/go-utils/pkg/constants"
+	"github.com/influenzanet/user-management-service/pkg/api"
+	"google.golang.org/grpc"
+)
+
+const rowsPerMessage = 100
+
+type importOptions struct {
+	file               string
+	format             string
+	addr               string
+	instanceID         string
+	adminID            string
+	apply              bool
+	sendInvitations    bool
+	invitationInterval int
+}
+
+// jsonRow is one entry of the JSON input (an array of these)
+type jsonRow struct {
+	Email        string          `json:"email"`
+	Language     string          `json:"language"`
+	Roles        []string        `json:"roles"`
+	ProfileNames []string        `json:"profileNames"`
+	CreatedAt    json.RawMessage `json:"createdAt"`
+}
+
+func terminate(message string) {
+	fmt.Println(message)
+	os.Exit(1)
+}
+
+func optionsFromCLI() importOptions {
+	opts := importOptions{}
+	flag.StringVar(&opts.file, "file", "", "CSV or JSON file with the users to import")
+	flag.StringVar(&opts.format, "format", "", "csv or json (default: from file extension)")
+	flag.StringVar(&opts.addr, "addr", "localhost:"+os.Getenv("USER_MANAGEMENT_LISTEN_PORT"), "address of the user management service")
+	flag.StringVar(&opts.instanceID, "instance", "", "instance")
+	flag.StringVar(&opts.adminID, "admin", "import-tool", "ID saved as author of the import in the logs")
+	flag.BoolVar(&opts.apply, "apply", false, "create the users, otherwise only the dry run report is shown")
+	flag.BoolVar(&opts.sendInvitations, "invite", false, "send invitation emails to the created users")
+	flag.IntVar(&opts.invitationInterval, "invitation-interval", 0, "seconds between two invitation emails")
+	flag.Parse()
+
+	if opts.file == "" {
+		terminate("file must be provided")
+	}
+	if opts.instanceID == "" {
+		terminate("instance must be provided")
+	}
+	if opts.format == "" {
+		opts.format = strings.TrimPrefix(strings.ToLower(filepath.Ext(opts.file)), ".")
+	}
+	if opts.format != "csv" && opts.format != "json" {
+		terminate("format must be csv or json")
+	}
+	return opts
+}
+
+// parseCreatedAt accepts unix timestamps, dates (2006-01-02) and RFC3339 times
+func parseCreatedAt(v string) (int64, error) {
+	v = strings.Trim(strings.TrimSpace(v), "\"")
+	if v == "" || v == "null" {
+		return 0, nil
+	}
+	if ts, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return ts, nil
+	}
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t.Unix(), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return 0, fmt.Errorf("unknown time format: %s", v)
+	}
+	return t.Unix(), nil
+}
+
+func splitList(v string) []string {
+	list := []string{}
+	for _, item := range strings.Split(v, ";") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// readCSV expects a header line with the columns email, language, roles, profile_names and created_at (only email is required), lists are separated by ";"
+func readCSV(r io.Reader) ([]*api.ImportUserRow, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 1 {
+		return nil, errors.New("missing header")
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, errors.New("missing column: email")
+	}
+	get := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := []*api.ImportUserRow{}
+	for i, record := range records[1:] {
+		createdAt, err := parseCreatedAt(get(record, "created_at"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+2, err)
+		}
+		rows = append(rows, &api.ImportUserRow{
+			Row:               int32(i + 2),
+			Email:             get(record, "email"),
+			PreferredLanguage: get(record, "language"),
+			Roles:             splitList(get(record, "roles")),
+			ProfileNames:      splitList(get(record, "profile_names")),
+			CreatedAt:         createdAt,
+		})
+	}
+	return rows, nil
+}
+
+func readJSON(r io.Reader) ([]*api.ImportUserRow, error) {
+	entries := []jsonRow{}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	rows := []*api.ImportUserRow{}
+	for i, e := range entries {
+		createdAt, err := parseCreatedAt(string(e.CreatedAt))
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i+1, err)
+		}
+		rows = append(rows, &api.ImportUserRow{
+			Row:               int32(i + 1),
+			Email:             e.Email,
+			PreferredLanguage: e.Language,
+			Roles:             e.Roles,
+			ProfileNames:      e.ProfileNames,
+			CreatedAt:         createdAt,
+		})
+	}
+	return rows, nil
+}
+
+func runImport(client api.UserManagementApiClient, opts importOptions, rows []*api.ImportUserRow, dryRun bool) (*api.ImportUsersReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	stream, err := client.ImportUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	first := &api.ImportUsersMsg{
+		Token: &api_types.TokenInfos{
+			Id:         opts.adminID,
+			InstanceId: opts.instanceID,
+			Payload: map[string]string{
+				"roles": constants.USER_ROLE_ADMIN,
+			},
+		},
+		DryRun:             dryRun,
+		SendInvitations:    opts.sendInvitations,
+		InvitationInterval: int32(opts.invitationInterval),
+	}
+	if err := stream.Send(first); err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(rows); start += rowsPerMessage {
+		end := start + rowsPerMessage
+		if end > len(rows) {
+			end = len(rows)
+		}
+		if err := stream.Send(&api.ImportUsersMsg{Rows: rows[start:end]}); err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func printReport(report *api.ImportUsersReport) {
+	for _, r := range report.Results {
+		if r.Error != "" {
+			fmt.Printf("row %d (%s): %s - %s\n", r.Row, r.Email, r.Status, r.Error)
+		}
+	}
+	fmt.Printf("total: %d, created: %d, existing: %d, invalid: %d, invitations scheduled: %d\n",
+		report.Total, report.Created, report.Existing, report.Invalid, report.InvitationsScheduled)
+}
+
+func main() {
+	opts := optionsFromCLI()
+
+	f, err := os.Open(opts.file)
+	if err != nil {
+		terminate(err.Error())
+	}
+	var rows []*api.ImportUserRow
+	if opts.format == "csv" {
+		rows, err = readCSV(f)
+	} else {
+		rows, err = readJSON(f)
+	}
+	f.Close()
+	if err != nil {
+		terminate(err.Error())
+	}
+
+	conn, err := grpc.Dial(opts.addr, grpc.WithInsecure())
+	if err != nil {
+		terminate(err.Error())
+	}
+	defer conn.Close()
+	client := api.NewUserManagementApiClient(conn)
+
+	// always validate first
+	report, err := runImport(client, opts, rows, true)
+	if err != nil {
+		terminate(err.Error())
+	}
+	fmt.Println("Dry run:")
+	printReport(report)
+	if report.Invalid > 0 {
+		terminate("fix the invalid rows before importing")
+	}
+	if !opts.apply {
+		return
+	}
+
+	report, err = runImport(client, opts, rows, false)
+	if err != nil {
+		terminate(err.Error())
+	}
+	fmt.Println("Import:")
+	printReport(report)
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/influenzanet/user-management-service/internal/config"
+	"github.com/influenzanet/user-management-service/pkg/dbs/userdb"
+	"github.com/influenzanet/user-management-service/pkg/userarchive"
+)
+
+type archiveRequest struct {
+	mode       string
+	file       string
+	instanceID string
+	key        []byte
+}
+
+func terminate(message string) {
+	fmt.Println(message)
+	os.Exit(1)
+}
+
+func reqFromCLI() archiveRequest {
+	req := archiveRequest{}
+	exportF := flag.Bool("export", false, "write the users of the instance to the file")
+	restoreF := flag.Bool("restore", false, "restore the users of the file into the instance")
+	fileF := flag.String("file", "", "archive file")
+	instanceF := flag.String("instance", "", "instance to export from or restore into (restore: default is the instance of the archive)")
+	keyF := flag.String("key", "", "base64 encoded 32 bytes key to encrypt or decrypt the users (see key-generator), default from USER_ARCHIVE_KEY")
+
+	flag.Parse()
+
+	if *exportF == *restoreF {
+		terminate("either export or restore must be used")
+	}
+	req.mode = "export"
+	if *restoreF {
+		req.mode = "restore"
+	}
+
+	req.file = *fileF
+	if req.file == "" {
+		terminate("file must be provided")
+	}
+	req.instanceID = *instanceF
+	if req.mode == "export" && req.instanceID == "" {
+		terminate("instance must be provided")
+	}
+
+	encodedKey := *keyF
+	if encodedKey == "" {
+		encodedKey = os.Getenv("USER_ARCHIVE_KEY")
+	}
+	if encodedKey != "" {
+		key, err := userarchive.ParseKey(encodedKey)
+		if err != nil {
+			terminate("key: " + err.Error())
+		}
+		req.key = key
+	}
+	return req
+}
+
+func exportUsers(userDBService *userdb.UserDBService, req archiveRequest) {
+	// archives contain personal data: only readable by the owner, an existing file is never overwritten
+	f, err := os.OpenFile(req.file, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		terminate(err.Error())
+	}
+	defer f.Close()
+
+	w, err := userarchive.NewWriter(f, req.instanceID, req.key)
+	if err != nil {
+		terminate(err.Error())
+	}
+	count := 0
+	err = userDBService.ForEachUserDocument(req.instanceID, func(doc bson.Raw) error {
+		// canonical extended JSON keeps object ids and number types
+		b, err := bson.MarshalExtJSON(doc, true, false)
+		if err != nil {
+			return err
+		}
+		count++
+		return w.WriteDocument(b)
+	})
+	if err != nil {
+		terminate(err.Error())
+	}
+	if err := w.Close(); err != nil {
+		terminate(err.Error())
+	}
+	fmt.Printf("%d users exported from %s\n", count, req.instanceID)
+}
+
+func restoreUsers(userDBService *userdb.UserDBService, req archiveRequest) {
+	f, err := os.Open(req.file)
+	if err != nil {
+		terminate(err.Error())
+	}
+	defer f.Close()
+
+	r, err := userarchive.NewReader(f, req.key)
+	if err != nil {
+		terminate(err.Error())
+	}
+	instanceID := req.instanceID
+	if instanceID == "" {
+		instanceID = r.Header.InstanceID
+	}
+
+	restored := 0
+	skipped := 0
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			terminate(err.Error())
+		}
+		doc := bson.D{}
+		if err := bson.UnmarshalExtJSON(b, true, &doc); err != nil {
+			terminate(err.Error())
+		}
+		raw, err := bson.Marshal(doc)
+		if err != nil {
+			terminate(err.Error())
+		}
+
+		ok, err := userDBService.RestoreUserDocument(instanceID, raw)
+		if err == userdb.ErrAccountIDTaken {
+			accountID, _ := bson.Raw(raw).Lookup("account", "accountID").StringValueOK()
+			fmt.Printf("skipped %s: %s\n", accountID, err.Error())
+			skipped++
+			continue
+		}
+		if err != nil {
+			terminate(err.Error())
+		}
+		if ok {
+			restored++
+		} else {
+			skipped++
+		}
+	}
+	fmt.Printf("%d users restored into %s, %d skipped\n", restored, instanceID, skipped)
+}
+
+func main() {
+	req := reqFromCLI()
+
+	conf := config.InitDBConfig()
+	userDBService := userdb.NewUserDBService(conf.UserDBConfig)
+
+	if req.mode == "export" {
+		exportUsers(userDBService, req)
+	} else {
+		restoreUsers(userDBService, req)
+	}
+}